@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	"k8s.io/utils/ptr"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 )
 
 const (
@@ -165,6 +166,24 @@ func (s *SubnetSpec) setNodeSubnetDefaults(clusterName string, index int) {
 		if s.NatGateway.NatGatewayIP.Name == "" {
 			s.NatGateway.NatGatewayIP.Name = generateNatGatewayIPName(s.NatGateway.Name)
 		}
+		s.NatGateway.setIPDefaults()
+	}
+}
+
+// setIPDefaults generates the additional public IPs up to IPsCount and defaults the public IP prefix name.
+func (n *NatGateway) setIPDefaults() {
+	if n.IPsCount != nil {
+		for i := int32(len(n.AdditionalIPs)) + 1; i < *n.IPsCount; i++ {
+			n.AdditionalIPs = append(n.AdditionalIPs, PublicIPSpec{
+				Name: generateNatGatewayAdditionalIPName(n.Name, i),
+			})
+		}
+	}
+	if n.IPPrefix != nil && n.IPPrefix.Name == "" {
+		n.IPPrefix.Name = generateNatGatewayIPPrefixName(n.Name)
+		if resourceID, err := azureutil.ParseResourceID(n.IPPrefix.ID); err == nil {
+			n.IPPrefix.Name = resourceID.Name
+		}
 	}
 }
 
@@ -194,8 +213,11 @@ func (s *SubnetSpec) setClusterSubnetDefaults(clusterName string) {
 	if s.NatGateway.Name == "" {
 		s.NatGateway.Name = generateClusterNatGatewayName(clusterName)
 	}
-	if !s.IsIPv6Enabled() && s.ID == "" && s.NatGateway.NatGatewayIP.Name == "" {
-		s.NatGateway.NatGatewayIP.Name = generateNatGatewayIPName(s.NatGateway.Name)
+	if !s.IsIPv6Enabled() && s.ID == "" {
+		if s.NatGateway.NatGatewayIP.Name == "" {
+			s.NatGateway.NatGatewayIP.Name = generateNatGatewayIPName(s.NatGateway.Name)
+		}
+		s.NatGateway.setIPDefaults()
 	}
 	s.setDefaults(DefaultClusterSubnetCIDR)
 	s.SecurityGroup.SecurityGroupClass.setDefaults()
@@ -203,7 +225,17 @@ func (s *SubnetSpec) setClusterSubnetDefaults(clusterName string) {
 
 func (c *AzureCluster) setVnetPeeringDefaults() {
 	for i, peering := range c.Spec.NetworkSpec.Vnet.Peerings {
-		if peering.ResourceGroup == "" {
+		if peering.RemoteVnetID != "" {
+			if resourceID, err := azureutil.ParseResourceID(peering.RemoteVnetID); err == nil {
+				if peering.ResourceGroup == "" {
+					c.Spec.NetworkSpec.Vnet.Peerings[i].ResourceGroup = resourceID.ResourceGroupName
+				}
+				if peering.RemoteVnetName == "" {
+					c.Spec.NetworkSpec.Vnet.Peerings[i].RemoteVnetName = resourceID.Name
+				}
+			}
+		}
+		if c.Spec.NetworkSpec.Vnet.Peerings[i].ResourceGroup == "" {
 			c.Spec.NetworkSpec.Vnet.Peerings[i].ResourceGroup = c.Spec.ResourceGroup
 		}
 	}
@@ -279,7 +311,7 @@ func (c *AzureCluster) SetNodeOutboundLBDefaults() {
 	}
 
 	if lb.FrontendIPsCount == nil {
-		lb.FrontendIPsCount = ptr.To[int32](1)
+		lb.FrontendIPsCount = ptr.To(int32(max(len(lb.FrontendIPs), 1)))
 	}
 
 	c.setOutboundLBFrontendIPs(lb, generateNodeOutboundIPName)
@@ -299,7 +331,7 @@ func (c *AzureCluster) SetControlPlaneOutboundLBDefaults() {
 		lb.Name = generateControlPlaneOutboundLBName(c.ObjectMeta.Name)
 	}
 	if lb.FrontendIPsCount == nil {
-		lb.FrontendIPsCount = ptr.To[int32](1)
+		lb.FrontendIPsCount = ptr.To(int32(max(len(lb.FrontendIPs), 1)))
 	}
 	c.setOutboundLBFrontendIPs(lb, generateControlPlaneOutboundIPName)
 	c.SetControlPlaneOutboundLBBackendPoolNameDefault()
@@ -338,27 +370,33 @@ func (c *AzureCluster) SetControlPlaneOutboundLBBackendPoolNameDefault() {
 
 // setOutboundLBFrontendIPs sets the frontend ips for the given load balancer.
 // The name of the frontend ip is generated using generatePublicIPName function.
+// Frontend ips that are specified, e.g. to reference existing public IPs or a Gateway Load Balancer, are kept and
+// only their missing names are defaulted. Frontend ips are added until there are FrontendIPsCount of them.
 func (c *AzureCluster) setOutboundLBFrontendIPs(lb *LoadBalancerSpec, generatePublicIPName func(string) string) {
-	switch *lb.FrontendIPsCount {
-	case 0:
+	count := int(*lb.FrontendIPsCount)
+	if count == 0 {
 		lb.FrontendIPs = []FrontendIP{}
-	case 1:
-		lb.FrontendIPs = []FrontendIP{
-			{
-				Name: generateFrontendIPConfigName(lb.Name),
-				PublicIP: &PublicIPSpec{
-					Name: generatePublicIPName(c.ObjectMeta.Name),
-				},
-			},
+		return
+	}
+
+	for i := 0; i < count; i++ {
+		if i >= len(lb.FrontendIPs) {
+			lb.FrontendIPs = append(lb.FrontendIPs, FrontendIP{})
 		}
-	default:
-		lb.FrontendIPs = make([]FrontendIP, *lb.FrontendIPsCount)
-		for i := 0; i < int(*lb.FrontendIPsCount); i++ {
-			lb.FrontendIPs[i] = FrontendIP{
-				Name: withIndex(generateFrontendIPConfigName(lb.Name), i+1),
-				PublicIP: &PublicIPSpec{
-					Name: withIndex(generatePublicIPName(c.ObjectMeta.Name), i+1),
-				},
+		frontendIPName, publicIPName := generateFrontendIPConfigName(lb.Name), generatePublicIPName(c.ObjectMeta.Name)
+		if count > 1 {
+			frontendIPName, publicIPName = withIndex(frontendIPName, i+1), withIndex(publicIPName, i+1)
+		}
+		if lb.FrontendIPs[i].Name == "" {
+			lb.FrontendIPs[i].Name = frontendIPName
+		}
+		if lb.FrontendIPs[i].PublicIP == nil {
+			lb.FrontendIPs[i].PublicIP = &PublicIPSpec{}
+		}
+		if lb.FrontendIPs[i].PublicIP.Name == "" {
+			lb.FrontendIPs[i].PublicIP.Name = publicIPName
+			if resourceID, err := azureutil.ParseResourceID(lb.FrontendIPs[i].PublicIP.ID); err == nil {
+				lb.FrontendIPs[i].PublicIP.Name = resourceID.Name
 			}
 		}
 	}
@@ -538,6 +576,16 @@ func generateNatGatewayIPName(natGatewayName string) string {
 	return fmt.Sprintf("pip-%s", natGatewayName)
 }
 
+// generateNatGatewayAdditionalIPName generates the name of an additional NAT gateway IP.
+func generateNatGatewayAdditionalIPName(natGatewayName string, index int32) string {
+	return fmt.Sprintf("pip-%s-%d", natGatewayName, index)
+}
+
+// generateNatGatewayIPPrefixName generates a NAT gateway IP prefix name.
+func generateNatGatewayIPPrefixName(natGatewayName string) string {
+	return fmt.Sprintf("pipp-%s", natGatewayName)
+}
+
 // withIndex appends the index as suffix to a generated name.
 func withIndex(name string, n int) string {
 	return fmt.Sprintf("%s-%d", name, n)
@@ -415,6 +415,87 @@ func TestSubnetDefaults(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "node subnet NAT gateway with multiple public IPs and a public IP prefix",
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: Subnets{
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role:       SubnetControlPlane,
+									CIDRBlocks: []string{"10.0.0.16/24"},
+									Name:       "my-controlplane-subnet",
+								},
+							},
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role:       SubnetNode,
+									CIDRBlocks: []string{"10.1.0.16/24"},
+									Name:       "my-node-subnet",
+								},
+								NatGateway: NatGateway{
+									NatGatewayClassSpec: NatGatewayClassSpec{
+										Name:     "foo-natgw",
+										IPsCount: ptr.To[int32](3),
+										IPPrefix: &PublicIPPrefixSpec{PrefixLength: 30},
+									},
+									AdditionalIPs: []PublicIPSpec{
+										{Name: "my-natgw-ip"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						Subnets: Subnets{
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role:       SubnetControlPlane,
+									CIDRBlocks: []string{"10.0.0.16/24"},
+									Name:       "my-controlplane-subnet",
+								},
+								SecurityGroup: SecurityGroup{Name: "cluster-test-controlplane-nsg"},
+								RouteTable:    RouteTable{},
+							},
+							{
+								SubnetClassSpec: SubnetClassSpec{
+									Role:       SubnetNode,
+									CIDRBlocks: []string{"10.1.0.16/24"},
+									Name:       "my-node-subnet",
+								},
+								SecurityGroup: SecurityGroup{Name: "cluster-test-node-nsg"},
+								RouteTable:    RouteTable{Name: "cluster-test-node-routetable"},
+								NatGateway: NatGateway{
+									NatGatewayClassSpec: NatGatewayClassSpec{
+										Name:     "foo-natgw",
+										IPsCount: ptr.To[int32](3),
+										IPPrefix: &PublicIPPrefixSpec{Name: "pipp-foo-natgw", PrefixLength: 30},
+									},
+									NatGatewayIP: PublicIPSpec{
+										Name: "pip-foo-natgw",
+									},
+									AdditionalIPs: []PublicIPSpec{
+										{Name: "my-natgw-ip"},
+										{Name: "pip-foo-natgw-2"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 		{
 			name: "subnets specified",
 			cluster: &AzureCluster{
@@ -1189,6 +1270,49 @@ func TestVnetPeeringDefaults(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "peering with remote vnet ID",
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					ResourceGroup: "cluster-test",
+					NetworkSpec: NetworkSpec{
+						Vnet: VnetSpec{
+							Peerings: VnetPeerings{
+								{
+									VnetPeeringClassSpec: VnetPeeringClassSpec{
+										RemoteVnetID: "/subscriptions/hub-sub/resourceGroups/hub-rg/providers/Microsoft.Network/virtualNetworks/hub-vnet",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					ResourceGroup: "cluster-test",
+					NetworkSpec: NetworkSpec{
+						Vnet: VnetSpec{
+							Peerings: VnetPeerings{
+								{
+									VnetPeeringClassSpec: VnetPeeringClassSpec{
+										RemoteVnetName: "hub-vnet",
+										RemoteVnetID:   "/subscriptions/hub-sub/resourceGroups/hub-rg/providers/Microsoft.Network/virtualNetworks/hub-vnet",
+										ResourceGroup:  "hub-rg",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -2093,6 +2217,290 @@ func TestNodeOutboundLBDefaults(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "frontend IPs referencing existing public IPs are kept and padded to the frontend IPs count",
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						APIServerLB: LoadBalancerSpec{LoadBalancerClassSpec: LoadBalancerClassSpec{Type: Public}},
+						NodeOutboundLB: &LoadBalancerSpec{
+							FrontendIPsCount: ptr.To[int32](3),
+							FrontendIPs: []FrontendIP{
+								{
+									PublicIP: &PublicIPSpec{
+										ID: "/subscriptions/123/resourceGroups/ip-rg/providers/Microsoft.Network/publicIPAddresses/my-egress-ip",
+									},
+								},
+								{
+									Name: "prefix-frontEnd",
+									PublicIP: &PublicIPSpec{
+										PublicIPPrefixID: "/subscriptions/123/resourceGroups/ip-rg/providers/Microsoft.Network/publicIPPrefixes/my-prefix",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						APIServerLB: LoadBalancerSpec{
+							LoadBalancerClassSpec: LoadBalancerClassSpec{
+								Type: Public,
+							},
+						},
+						NodeOutboundLB: &LoadBalancerSpec{
+							FrontendIPs: []FrontendIP{
+								{
+									Name: "cluster-test-frontEnd-1",
+									PublicIP: &PublicIPSpec{
+										Name: "my-egress-ip",
+										ID:   "/subscriptions/123/resourceGroups/ip-rg/providers/Microsoft.Network/publicIPAddresses/my-egress-ip",
+									},
+								},
+								{
+									Name: "prefix-frontEnd",
+									PublicIP: &PublicIPSpec{
+										Name:             "pip-cluster-test-node-outbound-2",
+										PublicIPPrefixID: "/subscriptions/123/resourceGroups/ip-rg/providers/Microsoft.Network/publicIPPrefixes/my-prefix",
+									},
+								},
+								{
+									Name: "cluster-test-frontEnd-3",
+									PublicIP: &PublicIPSpec{
+										Name: "pip-cluster-test-node-outbound-3",
+									},
+								},
+							},
+							BackendPool: BackendPool{
+								Name: "cluster-test-outboundBackendPool",
+							},
+							FrontendIPsCount: ptr.To[int32](3),
+							LoadBalancerClassSpec: LoadBalancerClassSpec{
+								SKU:                  SKUStandard,
+								Type:                 Public,
+								IdleTimeoutInMinutes: ptr.To[int32](DefaultOutboundRuleIdleTimeoutInMinutes),
+							},
+							Name: "cluster-test",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "frontend IPs count defaults to the number of frontend IPs",
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						APIServerLB: LoadBalancerSpec{LoadBalancerClassSpec: LoadBalancerClassSpec{Type: Public}},
+						NodeOutboundLB: &LoadBalancerSpec{
+							FrontendIPs: []FrontendIP{
+								{
+									Name: "first-frontEnd",
+									PublicIP: &PublicIPSpec{
+										ID: "/subscriptions/123/resourceGroups/ip-rg/providers/Microsoft.Network/publicIPAddresses/first-ip",
+									},
+								},
+								{
+									Name: "second-frontEnd",
+									PublicIP: &PublicIPSpec{
+										ID: "/subscriptions/123/resourceGroups/ip-rg/providers/Microsoft.Network/publicIPAddresses/second-ip",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						APIServerLB: LoadBalancerSpec{
+							LoadBalancerClassSpec: LoadBalancerClassSpec{
+								Type: Public,
+							},
+						},
+						NodeOutboundLB: &LoadBalancerSpec{
+							FrontendIPs: []FrontendIP{
+								{
+									Name: "first-frontEnd",
+									PublicIP: &PublicIPSpec{
+										Name: "first-ip",
+										ID:   "/subscriptions/123/resourceGroups/ip-rg/providers/Microsoft.Network/publicIPAddresses/first-ip",
+									},
+								},
+								{
+									Name: "second-frontEnd",
+									PublicIP: &PublicIPSpec{
+										Name: "second-ip",
+										ID:   "/subscriptions/123/resourceGroups/ip-rg/providers/Microsoft.Network/publicIPAddresses/second-ip",
+									},
+								},
+							},
+							BackendPool: BackendPool{
+								Name: "cluster-test-outboundBackendPool",
+							},
+							FrontendIPsCount: ptr.To[int32](2),
+							LoadBalancerClassSpec: LoadBalancerClassSpec{
+								SKU:                  SKUStandard,
+								Type:                 Public,
+								IdleTimeoutInMinutes: ptr.To[int32](DefaultOutboundRuleIdleTimeoutInMinutes),
+							},
+							Name: "cluster-test",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "frontend IPs chained to a gateway load balancer are kept",
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						APIServerLB: LoadBalancerSpec{LoadBalancerClassSpec: LoadBalancerClassSpec{Type: Public}},
+						NodeOutboundLB: &LoadBalancerSpec{
+							FrontendIPsCount: ptr.To[int32](2),
+							FrontendIPs: []FrontendIP{
+								{
+									GatewayLoadBalancerFrontendIPID: "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/my-gwlb/frontendIPConfigurations/my-gwlb-frontEnd",
+								},
+								{
+									Name: "custom-frontEnd",
+									PublicIP: &PublicIPSpec{
+										Name: "custom-pip",
+									},
+									GatewayLoadBalancerFrontendIPID: "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/my-gwlb/frontendIPConfigurations/my-gwlb-frontEnd",
+								},
+							},
+						},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						APIServerLB: LoadBalancerSpec{
+							LoadBalancerClassSpec: LoadBalancerClassSpec{
+								Type: Public,
+							},
+						},
+						NodeOutboundLB: &LoadBalancerSpec{
+							FrontendIPs: []FrontendIP{
+								{
+									Name: "cluster-test-frontEnd-1",
+									PublicIP: &PublicIPSpec{
+										Name: "pip-cluster-test-node-outbound-1",
+									},
+									GatewayLoadBalancerFrontendIPID: "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/my-gwlb/frontendIPConfigurations/my-gwlb-frontEnd",
+								},
+								{
+									Name: "custom-frontEnd",
+									PublicIP: &PublicIPSpec{
+										Name: "custom-pip",
+									},
+									GatewayLoadBalancerFrontendIPID: "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/my-gwlb/frontendIPConfigurations/my-gwlb-frontEnd",
+								},
+							},
+							BackendPool: BackendPool{
+								Name: "cluster-test-outboundBackendPool",
+							},
+							FrontendIPsCount: ptr.To[int32](2),
+							LoadBalancerClassSpec: LoadBalancerClassSpec{
+								SKU:                  SKUStandard,
+								Type:                 Public,
+								IdleTimeoutInMinutes: ptr.To[int32](DefaultOutboundRuleIdleTimeoutInMinutes),
+							},
+							Name: "cluster-test",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "frontend IPs chained to a gateway load balancer are kept when the frontend IPs count is increased",
+			cluster: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						APIServerLB: LoadBalancerSpec{LoadBalancerClassSpec: LoadBalancerClassSpec{Type: Public}},
+						NodeOutboundLB: &LoadBalancerSpec{
+							FrontendIPsCount: ptr.To[int32](2),
+							FrontendIPs: []FrontendIP{
+								{
+									Name: "cluster-test-frontEnd",
+									PublicIP: &PublicIPSpec{
+										Name: "pip-cluster-test-node-outbound",
+									},
+									GatewayLoadBalancerFrontendIPID: "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/my-gwlb/frontendIPConfigurations/my-gwlb-frontEnd",
+								},
+							},
+						},
+					},
+				},
+			},
+			output: &AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-test",
+				},
+				Spec: AzureClusterSpec{
+					NetworkSpec: NetworkSpec{
+						APIServerLB: LoadBalancerSpec{
+							LoadBalancerClassSpec: LoadBalancerClassSpec{
+								Type: Public,
+							},
+						},
+						NodeOutboundLB: &LoadBalancerSpec{
+							FrontendIPs: []FrontendIP{
+								{
+									Name: "cluster-test-frontEnd",
+									PublicIP: &PublicIPSpec{
+										Name: "pip-cluster-test-node-outbound",
+									},
+									GatewayLoadBalancerFrontendIPID: "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/my-gwlb/frontendIPConfigurations/my-gwlb-frontEnd",
+								},
+								{
+									Name: "cluster-test-frontEnd-2",
+									PublicIP: &PublicIPSpec{
+										Name: "pip-cluster-test-node-outbound-2",
+									},
+								},
+							},
+							BackendPool: BackendPool{
+								Name: "cluster-test-outboundBackendPool",
+							},
+							FrontendIPsCount: ptr.To[int32](2),
+							LoadBalancerClassSpec: LoadBalancerClassSpec{
+								SKU:                  SKUStandard,
+								Type:                 Public,
+								IdleTimeoutInMinutes: ptr.To[int32](DefaultOutboundRuleIdleTimeoutInMinutes),
+							},
+							Name: "cluster-test",
+						},
+					},
+				},
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -74,6 +74,11 @@ type AzureClusterStatus struct {
 	// next reconciliation loop.
 	// +optional
 	LongRunningOperationStates Futures `json:"longRunningOperationStates,omitempty"`
+
+	// NatGateways lists the egress public IPs and public IP prefixes of the cluster's NAT gateways,
+	// e.g. for partners to allowlist.
+	// +optional
+	NatGateways []NatGatewayStatus `json:"natGateways,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -21,14 +21,17 @@ import (
 	"net"
 	"reflect"
 	"regexp"
+	"strings"
 
 	valid "github.com/asaskevich/govalidator"
+	"github.com/google/uuid"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api-provider-azure/feature"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
@@ -50,6 +53,14 @@ const (
 	MinLBIdleTimeoutInMinutes = 4
 	// MaxLBIdleTimeoutInMinutes is the maximum number of minutes for the LB idle timeout.
 	MaxLBIdleTimeoutInMinutes = 30
+	// MaxNatGatewayPublicIPs is the maximum number of public IP addresses of a NAT gateway, including the addresses of its public IP prefixes.
+	MaxNatGatewayPublicIPs = 16
+	// MinNatGatewayIdleTimeoutInMinutes is the minimum number of minutes for the NAT gateway idle timeout.
+	MinNatGatewayIdleTimeoutInMinutes = 4
+	// MaxNatGatewayIdleTimeoutInMinutes is the maximum number of minutes for the NAT gateway idle timeout.
+	MaxNatGatewayIdleTimeoutInMinutes = 120
+	// apiServerLBRuleName is the name of the load balancing rule CAPZ creates for the API server.
+	apiServerLBRuleName = "LBRuleHTTPS"
 	// Network security rules should be a number between 100 and 4096.
 	// https://learn.microsoft.com/azure/virtual-network/network-security-groups-overview#security-rules
 	minRulePriority = 100
@@ -60,13 +71,25 @@ const (
 	serviceEndpointLocationRegexPattern = `^([a-z]{1,42}\d{0,5}|[*])$`
 	// described in https://learn.microsoft.com/azure/azure-resource-manager/management/resource-name-rules.
 	privateEndpointRegex = `^[-\w\._]+$`
+	// Must be a resource provider namespace followed by one or more resource types, e.g. Microsoft.Web/serverFarms.
+	subnetDelegationServiceNameRegexPattern = `^[a-zA-Z][a-zA-Z0-9]*(\.[a-zA-Z0-9]+)+(/[a-zA-Z0-9]+)+$`
 	// resource ID Pattern.
 	resourceIDPattern = `(?i)subscriptions/(.+)/resourceGroups/(.+)/providers/(.+?)/(.+?)/(.+)`
+	// described in https://learn.microsoft.com/azure/azure-resource-manager/management/resource-name-rules#microsoftnetwork.
+	applicationSecurityGroupNameRegexPattern = `^[a-zA-Z0-9]([-\w\.]{0,78}[a-zA-Z0-9_])?$`
+	applicationSecurityGroupIDRegexPattern   = `(?i)^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft\.Network/applicationSecurityGroups/[^/]+$`
+	storageAccountIDRegexPattern             = `(?i)^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft\.Storage/storageAccounts/[^/]+$`
+	logAnalyticsWorkspaceIDRegexPattern      = `(?i)^/subscriptions/[^/]+/resourceGroups/[^/]+/providers/Microsoft\.OperationalInsights/workspaces/[^/]+$`
 )
 
 var (
-	serviceEndpointServiceRegex  = regexp.MustCompile(serviceEndpointServiceRegexPattern)
-	serviceEndpointLocationRegex = regexp.MustCompile(serviceEndpointLocationRegexPattern)
+	serviceEndpointServiceRegex       = regexp.MustCompile(serviceEndpointServiceRegexPattern)
+	serviceEndpointLocationRegex      = regexp.MustCompile(serviceEndpointLocationRegexPattern)
+	subnetDelegationServiceNameRegex  = regexp.MustCompile(subnetDelegationServiceNameRegexPattern)
+	applicationSecurityGroupNameRegex = regexp.MustCompile(applicationSecurityGroupNameRegexPattern)
+	applicationSecurityGroupIDRegex   = regexp.MustCompile(applicationSecurityGroupIDRegexPattern)
+	storageAccountIDRegex             = regexp.MustCompile(storageAccountIDRegexPattern)
+	logAnalyticsWorkspaceIDRegex      = regexp.MustCompile(logAnalyticsWorkspaceIDRegexPattern)
 )
 
 // validateCluster validates a cluster.
@@ -90,7 +113,7 @@ func (c *AzureCluster) validateClusterSpec(old *AzureCluster) field.ErrorList {
 	if old != nil {
 		oldNetworkSpec = old.Spec.NetworkSpec
 	}
-	allErrs = append(allErrs, validateNetworkSpec(c.Spec.NetworkSpec, oldNetworkSpec, field.NewPath("spec").Child("networkSpec"))...)
+	allErrs = append(allErrs, validateNetworkSpec(c.Spec.SubscriptionID, c.Spec.NetworkSpec, oldNetworkSpec, field.NewPath("spec").Child("networkSpec"))...)
 
 	var oldCloudProviderConfigOverrides *CloudProviderConfigOverrides
 	if old != nil {
@@ -107,6 +130,14 @@ func (c *AzureCluster) validateClusterSpec(old *AzureCluster) field.ErrorList {
 	if err := validateBastionSpec(c.Spec.BastionSpec, field.NewPath("spec").Child("azureBastion").Child("bastionSpec")); err != nil {
 		allErrs = append(allErrs, err)
 	}
+	if c.Spec.BastionSpec.AzureBastion != nil {
+		allErrs = append(allErrs, validatePublicIPReferences(c.Spec.BastionSpec.AzureBastion.PublicIP,
+			field.NewPath("spec", "bastionSpec", "azureBastion", "publicIP"))...)
+		if len(c.Spec.BastionSpec.AzureBastion.Subnet.Delegations) > 0 {
+			allErrs = append(allErrs, field.Forbidden(field.NewPath("spec", "bastionSpec", "azureBastion", "subnet", "delegations"),
+				"the Azure Bastion subnet cannot be delegated"))
+		}
+	}
 
 	if err := validateIdentityRef(c.Spec.IdentityRef, field.NewPath("spec").Child("identityRef")); err != nil {
 		allErrs = append(allErrs, err)
@@ -153,8 +184,8 @@ func validateIdentityRef(identityRef *corev1.ObjectReference, fldPath *field.Pat
 	return nil
 }
 
-// validateNetworkSpec validates a NetworkSpec.
-func validateNetworkSpec(networkSpec NetworkSpec, old NetworkSpec, fldPath *field.Path) field.ErrorList {
+// validateNetworkSpec validates a NetworkSpec of a cluster in the given subscription.
+func validateNetworkSpec(subscriptionID string, networkSpec NetworkSpec, old NetworkSpec, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 	// If the user specifies a resourceGroup for vnet, it means
 	// that they intend to use a pre-existing vnet. In this case,
@@ -169,9 +200,13 @@ func validateNetworkSpec(networkSpec NetworkSpec, old NetworkSpec, fldPath *fiel
 
 		allErrs = append(allErrs, validateSubnets(networkSpec.Subnets, networkSpec.Vnet, fldPath.Child("subnets"))...)
 
-		allErrs = append(allErrs, validateVnetPeerings(networkSpec.Vnet.Peerings, fldPath.Child("peerings"))...)
+		allErrs = append(allErrs, validateVnetPeerings(networkSpec.Vnet.Peerings, subscriptionID, fldPath.Child("peerings"))...)
 	}
 
+	allErrs = append(allErrs, validateVnetClassSpec(networkSpec.Vnet.VnetClassSpec, fldPath.Child("vnet"))...)
+
+	allErrs = append(allErrs, validateApplicationSecurityGroups(networkSpec, fldPath)...)
+
 	var cidrBlocks []string
 	controlPlaneSubnet, err := networkSpec.GetControlPlaneSubnet()
 	if err != nil {
@@ -250,6 +285,9 @@ func validateSubnets(subnets Subnets, vnet VnetSpec, fldPath *field.Path) field.
 				allErrs = append(allErrs, err...)
 			}
 		}
+		if subnet.SecurityGroup.FlowLog != nil {
+			allErrs = append(allErrs, validateFlowLog(subnet.SecurityGroup.FlowLog, fldPath.Index(i).Child("securityGroup", "flowLog"))...)
+		}
 		allErrs = append(allErrs, validateSubnetCIDR(subnet.CIDRBlocks, vnet.CIDRBlocks, fldPath.Index(i).Child("cidrBlocks"))...)
 
 		if len(subnet.ServiceEndpoints) > 0 {
@@ -259,6 +297,17 @@ func validateSubnets(subnets Subnets, vnet VnetSpec, fldPath *field.Path) field.
 		if len(subnet.PrivateEndpoints) > 0 {
 			allErrs = append(allErrs, validatePrivateEndpoints(subnet.PrivateEndpoints, subnet.CIDRBlocks, fldPath.Index(i).Child("privateEndpoints"))...)
 		}
+
+		allErrs = append(allErrs, validateSubnetDelegations(subnet.Delegations, subnet.Role, fldPath.Index(i).Child("delegations"))...)
+		allErrs = append(allErrs, validateServiceEndpointPolicyIDs(subnet.ServiceEndpointPolicyIDs, fldPath.Index(i).Child("serviceEndpointPolicyIDs"))...)
+
+		if len(subnet.RouteTable.Routes) > 0 {
+			allErrs = append(allErrs, validateRouteTable(subnet.RouteTable, fldPath.Index(i).Child("routeTable"))...)
+		}
+
+		if subnet.IsNatGatewayEnabled() {
+			allErrs = append(allErrs, validateNatGateway(subnet.NatGateway, fldPath.Index(i).Child("natGateway"))...)
+		}
 	}
 
 	// The clusterSubnet is applicable to both the control-plane and node pools.
@@ -329,13 +378,44 @@ func validateVnetCIDR(vnetCIDRBlocks []string, fldPath *field.Path) field.ErrorL
 	return allErrs
 }
 
+// validateVnetClassSpec validates the DDoS protection plan and DNS servers of a Vnet.
+func validateVnetClassSpec(vnet VnetClassSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if vnet.DDoSProtectionPlanID != "" {
+		resourceID, err := azureutil.ParseResourceID(vnet.DDoSProtectionPlanID)
+		if err != nil || !strings.EqualFold(resourceID.ResourceType.String(), "Microsoft.Network/ddosProtectionPlans") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ddosProtectionPlanID"), vnet.DDoSProtectionPlanID,
+				"must be a valid Azure DDoS protection plan resource ID"))
+		}
+	}
+
+	dnsServers := make(map[string]bool, len(vnet.DNSServers))
+	for i, dnsServer := range vnet.DNSServers {
+		if net.ParseIP(dnsServer) == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("dnsServers").Index(i), dnsServer, "must be a valid IP address"))
+		}
+		if dnsServers[dnsServer] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("dnsServers").Index(i), dnsServer))
+		}
+		dnsServers[dnsServer] = true
+	}
+
+	return allErrs
+}
+
 // validateVnetPeerings validates a list of virtual network peerings.
-func validateVnetPeerings(peerings VnetPeerings, fldPath *field.Path) field.ErrorList {
+func validateVnetPeerings(peerings VnetPeerings, subscriptionID string, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 	vnetIdentifiers := make(map[string]bool, len(peerings))
 
-	for _, peering := range peerings {
-		vnetIdentifier := peering.ResourceGroup + "/" + peering.RemoteVnetName
+	for i, peering := range peerings {
+		allErrs = append(allErrs, validateVnetPeeringClassSpec(peering.VnetPeeringClassSpec, fldPath.Index(i))...)
+
+		// Remote vnets specified by name are in the cluster's subscription.
+		vnetIdentifier := strings.ToLower(subscriptionID + "/" + peering.ResourceGroup + "/" + peering.RemoteVnetName)
+		if resourceID, err := azureutil.ParseResourceID(peering.RemoteVnetID); err == nil {
+			vnetIdentifier = strings.ToLower(resourceID.SubscriptionID + "/" + resourceID.ResourceGroupName + "/" + resourceID.Name)
+		}
 		if _, ok := vnetIdentifiers[vnetIdentifier]; ok {
 			allErrs = append(allErrs, field.Duplicate(fldPath, vnetIdentifier))
 		}
@@ -344,6 +424,40 @@ func validateVnetPeerings(peerings VnetPeerings, fldPath *field.Path) field.Erro
 	return allErrs
 }
 
+// validateVnetPeeringClassSpec validates the remote virtual network and reverse peering identity of a peering.
+func validateVnetPeeringClassSpec(peering VnetPeeringClassSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if peering.RemoteVnetID == "" {
+		if peering.RemoteVnetName == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("remoteVnetName"), "remoteVnetName is required if remoteVnetID is not set"))
+		}
+	} else {
+		resourceID, err := azureutil.ParseResourceID(peering.RemoteVnetID)
+		switch {
+		case err != nil:
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("remoteVnetID"), peering.RemoteVnetID, "must be a valid Azure resource ID"))
+		case !strings.EqualFold(resourceID.ResourceType.String(), "Microsoft.Network/virtualNetworks"):
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("remoteVnetID"), peering.RemoteVnetID, "must be the ID of a virtual network"))
+		default:
+			if peering.RemoteVnetName != "" && !strings.EqualFold(peering.RemoteVnetName, resourceID.Name) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("remoteVnetName"), peering.RemoteVnetName, "must match the name in remoteVnetID"))
+			}
+			if peering.ResourceGroup != "" && !strings.EqualFold(peering.ResourceGroup, resourceID.ResourceGroupName) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Child("resourceGroup"), peering.ResourceGroup, "must match the resource group in remoteVnetID"))
+			}
+		}
+	}
+
+	if peering.ReversePeeringIdentityRef != nil {
+		if err := validateIdentityRef(peering.ReversePeeringIdentityRef, fldPath.Child("reversePeeringIdentityRef")); err != nil {
+			allErrs = append(allErrs, err)
+		}
+	}
+
+	return allErrs
+}
+
 // validateLoadBalancerName validates the Name of a Load Balancer.
 func validateLoadBalancerName(name string, fldPath *field.Path) *field.Error {
 	if success, _ := regexp.Match(loadBalancerRegex, []byte(name)); !success {
@@ -380,6 +494,83 @@ func validateSecurityRule(rule SecurityRule, fldPath *field.Path) (allErrs field
 		allErrs = append(allErrs, field.Invalid(fldPath, rule.Source, "security rule cannot have both source and sources"))
 	}
 
+	if len(rule.SourceApplicationSecurityGroups) > 0 {
+		if rule.Source != nil || rule.Sources != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("sourceApplicationSecurityGroups"), "security rule cannot have both source application security groups and source or sources"))
+		}
+		allErrs = append(allErrs, validateApplicationSecurityGroupReferences(rule.SourceApplicationSecurityGroups, fldPath.Child("sourceApplicationSecurityGroups"))...)
+	}
+
+	if len(rule.DestinationApplicationSecurityGroups) > 0 {
+		if rule.Destination != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("destinationApplicationSecurityGroups"), "security rule cannot have both destination application security groups and destination"))
+		}
+		allErrs = append(allErrs, validateApplicationSecurityGroupReferences(rule.DestinationApplicationSecurityGroups, fldPath.Child("destinationApplicationSecurityGroups"))...)
+	}
+
+	return allErrs
+}
+
+// validateApplicationSecurityGroups validates the application security groups of a NetworkSpec, and that the
+// security rules only reference application security groups by name if the cluster defines them.
+func validateApplicationSecurityGroups(networkSpec NetworkSpec, networkSpecPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	fldPath := networkSpecPath.Child("applicationSecurityGroups")
+
+	asgNames := make(map[string]bool, len(networkSpec.ApplicationSecurityGroups))
+	for i, asg := range networkSpec.ApplicationSecurityGroups {
+		if !applicationSecurityGroupNameRegex.MatchString(asg.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("name"), asg.Name,
+				fmt.Sprintf("name of application security group doesn't match regex %s", applicationSecurityGroupNameRegexPattern)))
+		}
+		if asgNames[strings.ToLower(asg.Name)] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("name"), asg.Name))
+		}
+		asgNames[strings.ToLower(asg.Name)] = true
+	}
+
+	subnetsPath := networkSpecPath.Child("subnets")
+	for i, subnet := range networkSpec.Subnets {
+		for j, rule := range subnet.SecurityGroup.SecurityRules {
+			rulePath := subnetsPath.Index(i).Child("securityGroup", "securityRules").Index(j)
+			for k, ref := range rule.SourceApplicationSecurityGroups {
+				if !IsApplicationSecurityGroupID(ref) && !asgNames[strings.ToLower(ref)] {
+					allErrs = append(allErrs, field.NotFound(rulePath.Child("sourceApplicationSecurityGroups").Index(k), ref))
+				}
+			}
+			for k, ref := range rule.DestinationApplicationSecurityGroups {
+				if !IsApplicationSecurityGroupID(ref) && !asgNames[strings.ToLower(ref)] {
+					allErrs = append(allErrs, field.NotFound(rulePath.Child("destinationApplicationSecurityGroups").Index(k), ref))
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+// validateApplicationSecurityGroupReferences validates references to application security groups, which are
+// either names of the cluster's application security groups or resource IDs.
+func validateApplicationSecurityGroupReferences(refs []string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	seen := make(map[string]bool, len(refs))
+	for i, ref := range refs {
+		switch {
+		case IsApplicationSecurityGroupID(ref):
+			if !applicationSecurityGroupIDRegex.MatchString(ref) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i), ref, "invalid application security group resource ID"))
+			}
+		case !applicationSecurityGroupNameRegex.MatchString(ref):
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), ref,
+				fmt.Sprintf("name of application security group doesn't match regex %s", applicationSecurityGroupNameRegexPattern)))
+		}
+		if seen[strings.ToLower(ref)] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), ref))
+		}
+		seen[strings.ToLower(ref)] = true
+	}
+
 	return allErrs
 }
 
@@ -427,9 +618,20 @@ func validateAPIServerLB(lb LoadBalancerSpec, old LoadBalancerSpec, cidrs []stri
 				allErrs = append(allErrs, field.Forbidden(fldPath.Child("frontendIPConfigs").Index(0).Child("privateIP"),
 					"Public Load Balancers cannot have a Private IP"))
 			}
+			if publicIP := lb.FrontendIPs[0].PublicIP; publicIP != nil {
+				allErrs = append(allErrs, validatePublicIPReferences(*publicIP,
+					fldPath.Child("frontendIPConfigs").Index(0).Child("publicIP"))...)
+				// CAPZ can't generate the FQDN of an existing public IP.
+				if publicIP.ID != "" && publicIP.DNSName == "" {
+					allErrs = append(allErrs, field.Required(fldPath.Child("frontendIPConfigs").Index(0).Child("publicIP", "dnsName"),
+						"dnsName is required when referencing an existing public IP with id, it must resolve to the public IP"))
+				}
+			}
 		}
 	}
 
+	allErrs = append(allErrs, validateGatewayLoadBalancerFrontendIPs(lb, fldPath.Child("frontendIPs"))...)
+
 	return allErrs
 }
 
@@ -480,6 +682,10 @@ func validateNodeOutboundLB(lb *LoadBalancerSpec, old *LoadBalancerSpec, apiserv
 			fmt.Sprintf("Max front end ips allowed is %d", MaxLoadBalancerOutboundIPs)))
 	}
 
+	allErrs = append(allErrs, validateOutboundLBFrontendIPsCount(*lb, fldPath)...)
+	allErrs = append(allErrs, validateFrontendIPsPublicIPReferences(lb.FrontendIPs, fldPath.Child("frontendIPs"))...)
+	allErrs = append(allErrs, validateGatewayLoadBalancerFrontendIPs(*lb, fldPath.Child("frontendIPs"))...)
+
 	return allErrs
 }
 
@@ -499,6 +705,82 @@ func validateControlPlaneOutboundLB(lb *LoadBalancerSpec, apiserverLB LoadBalanc
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("frontendIPsCount"), *lb.FrontendIPsCount,
 				fmt.Sprintf("Max front end ips allowed is %d", MaxLoadBalancerOutboundIPs)))
 		}
+		allErrs = append(allErrs, validateOutboundLBFrontendIPsCount(*lb, fldPath)...)
+		allErrs = append(allErrs, validateFrontendIPsPublicIPReferences(lb.FrontendIPs, fldPath.Child("frontendIPs"))...)
+		allErrs = append(allErrs, validateGatewayLoadBalancerFrontendIPs(*lb, fldPath.Child("frontendIPs"))...)
+	}
+
+	return allErrs
+}
+
+// validateOutboundLBFrontendIPsCount validates that an outbound load balancer doesn't specify more frontend IPs than
+// its FrontendIPsCount, as the frontend IPs beyond the count would not be created.
+func validateOutboundLBFrontendIPsCount(lb LoadBalancerSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if lb.FrontendIPsCount != nil && len(lb.FrontendIPs) > int(*lb.FrontendIPsCount) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("frontendIPs"), len(lb.FrontendIPs),
+			fmt.Sprintf("must not specify more frontend IPs than frontendIPsCount (%d)", *lb.FrontendIPsCount)))
+	}
+	return allErrs
+}
+
+// validateGatewayLoadBalancerFrontendIPs validates the references of the frontend IPs of a load balancer to Gateway
+// Load Balancer frontend IP configurations, which Azure only supports on public frontends of Standard SKU load balancers.
+func validateGatewayLoadBalancerFrontendIPs(lb LoadBalancerSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, frontendIP := range lb.FrontendIPs {
+		if frontendIP.GatewayLoadBalancerFrontendIPID == "" {
+			continue
+		}
+		idPath := fldPath.Index(i).Child("gatewayLoadBalancerFrontendIPID")
+		if lb.Type != Public || frontendIP.PublicIP == nil {
+			allErrs = append(allErrs, field.Forbidden(idPath, "only public frontend IPs can be chained to a gateway load balancer"))
+		} else if lb.SKU != SKUStandard {
+			allErrs = append(allErrs, field.Forbidden(idPath, "only frontend IPs of Standard SKU load balancers can be chained to a gateway load balancer"))
+		}
+		resourceID, err := azureutil.ParseResourceID(frontendIP.GatewayLoadBalancerFrontendIPID)
+		if err != nil || !strings.EqualFold(resourceID.ResourceType.String(), "Microsoft.Network/loadBalancers/frontendIPConfigurations") {
+			allErrs = append(allErrs, field.Invalid(idPath, frontendIP.GatewayLoadBalancerFrontendIPID,
+				"must be a valid Azure load balancer frontend IP configuration resource ID"))
+		}
+	}
+	return allErrs
+}
+
+// validateFrontendIPsPublicIPReferences validates the references to existing public IPs and public IP prefixes of frontend IPs.
+func validateFrontendIPsPublicIPReferences(frontendIPs []FrontendIP, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	for i, frontendIP := range frontendIPs {
+		if frontendIP.PublicIP != nil {
+			allErrs = append(allErrs, validatePublicIPReferences(*frontendIP.PublicIP, fldPath.Index(i).Child("publicIP"))...)
+		}
+	}
+	return allErrs
+}
+
+// validatePublicIPReferences validates the references of a public IP to an existing public IP or public IP prefix.
+func validatePublicIPReferences(ip PublicIPSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if ip.ID != "" {
+		resourceID, err := azureutil.ParseResourceID(ip.ID)
+		if err != nil || !strings.EqualFold(resourceID.ResourceType.String(), "Microsoft.Network/publicIPAddresses") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("id"), ip.ID, "must be a valid Azure public IP resource ID"))
+		} else if !strings.EqualFold(resourceID.Name, ip.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), ip.Name, "must match the name of the public IP in id"))
+		}
+		if ip.PublicIPPrefixID != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("publicIPPrefixID"), "cannot be set when referencing an existing public IP with id"))
+		}
+		if len(ip.IPTags) > 0 {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("ipTags"), "cannot be set when referencing an existing public IP with id"))
+		}
+	}
+
+	if ip.PublicIPPrefixID != "" {
+		if resourceID, err := azureutil.ParseResourceID(ip.PublicIPPrefixID); err != nil || !strings.EqualFold(resourceID.ResourceType.String(), "Microsoft.Network/publicIPPrefixes") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("publicIPPrefixID"), ip.PublicIPPrefixID, "must be a valid Azure public IP prefix resource ID"))
+		}
 	}
 
 	return allErrs
@@ -566,6 +848,111 @@ func validateClassSpecForAPIServerLB(lb LoadBalancerClassSpec, old *LoadBalancer
 			fmt.Sprintf("Node outbound idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLoadBalancerOutboundIPs)))
 	}
 
+	if lb.HealthProbe != nil {
+		allErrs = append(allErrs, validateLoadBalancerProbe(*lb.HealthProbe, apiServerLBPath.Child("healthProbe"))...)
+	}
+
+	allErrs = append(allErrs, validateLoadBalancingRules(lb.AdditionalRules, apiServerLBPath.Child("additionalRules"))...)
+
+	return allErrs
+}
+
+// validateLoadBalancerProbe validates a load balancer health probe.
+func validateLoadBalancerProbe(probe LoadBalancerProbe, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	switch probe.Protocol {
+	case "", LoadBalancerProbeProtocolHTTP, LoadBalancerProbeProtocolHTTPS:
+		if probe.RequestPath != "" && !strings.HasPrefix(probe.RequestPath, "/") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("requestPath"), probe.RequestPath, "requestPath must start with /"))
+		}
+	case LoadBalancerProbeProtocolTCP:
+		if probe.RequestPath != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("requestPath"), "requestPath is not allowed for Tcp probes"))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("protocol"), probe.Protocol,
+			[]string{string(LoadBalancerProbeProtocolHTTP), string(LoadBalancerProbeProtocolHTTPS), string(LoadBalancerProbeProtocolTCP)}))
+	}
+
+	if probe.Port != nil && (*probe.Port < 1 || *probe.Port > 65535) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("port"), *probe.Port, "port must be between 1 and 65535"))
+	}
+
+	if probe.IntervalInSeconds != nil && *probe.IntervalInSeconds < 5 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("intervalInSeconds"), *probe.IntervalInSeconds, "intervalInSeconds must be at least 5"))
+	}
+
+	if probe.NumberOfProbes != nil && *probe.NumberOfProbes < 1 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("numberOfProbes"), *probe.NumberOfProbes, "numberOfProbes must be at least 1"))
+	}
+
+	return allErrs
+}
+
+// validateLoadBalancingRules validates the additional load balancing rules of the API server load balancer.
+func validateLoadBalancingRules(rules []LoadBalancingRule, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	names := make(map[string]struct{}, len(rules))
+	frontendPorts := make(map[string]struct{}, len(rules))
+	for i, rule := range rules {
+		rulePath := fldPath.Index(i)
+
+		if rule.Name == "" {
+			allErrs = append(allErrs, field.Required(rulePath.Child("name"), "name is required"))
+		} else if rule.Name == apiServerLBRuleName {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("name"), rule.Name, "name is reserved for the API server load balancing rule"))
+		} else if _, ok := names[rule.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(rulePath.Child("name"), rule.Name))
+		}
+		names[rule.Name] = struct{}{}
+
+		switch rule.Protocol {
+		case "", LoadBalancingRuleProtocolTCP, LoadBalancingRuleProtocolUDP:
+		default:
+			allErrs = append(allErrs, field.NotSupported(rulePath.Child("protocol"), rule.Protocol,
+				[]string{string(LoadBalancingRuleProtocolTCP), string(LoadBalancingRuleProtocolUDP)}))
+		}
+
+		if rule.FrontendPort < 1 || rule.FrontendPort > 65534 {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("frontendPort"), rule.FrontendPort, "frontendPort must be between 1 and 65534"))
+		}
+
+		if rule.BackendPort < 1 || rule.BackendPort > 65535 {
+			allErrs = append(allErrs, field.Invalid(rulePath.Child("backendPort"), rule.BackendPort, "backendPort must be between 1 and 65535"))
+		}
+
+		protocol := rule.Protocol
+		if protocol == "" {
+			protocol = LoadBalancingRuleProtocolTCP
+		}
+		frontendPort := fmt.Sprintf("%s/%d", protocol, rule.FrontendPort)
+		if _, ok := frontendPorts[frontendPort]; ok {
+			allErrs = append(allErrs, field.Duplicate(rulePath.Child("frontendPort"), rule.FrontendPort))
+		}
+		frontendPorts[frontendPort] = struct{}{}
+
+		if rule.HealthProbe != nil {
+			allErrs = append(allErrs, validateLoadBalancerProbe(*rule.HealthProbe, rulePath.Child("healthProbe"))...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateNoAPIServerLBSettings ensures settings that only apply to the API server load balancer are not set on an outbound load balancer.
+func validateNoAPIServerLBSettings(lb LoadBalancerClassSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if lb.HealthProbe != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("healthProbe"), "health probes can only be configured on the API server load balancer"))
+	}
+
+	if len(lb.AdditionalRules) > 0 {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("additionalRules"), "additional load balancing rules can only be configured on the API server load balancer"))
+	}
+
 	return allErrs
 }
 
@@ -599,6 +986,8 @@ func validateClassSpecForNodeOutboundLB(lb *LoadBalancerClassSpec, old *LoadBala
 			fmt.Sprintf("Node outbound idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLoadBalancerOutboundIPs)))
 	}
 
+	allErrs = append(allErrs, validateNoAPIServerLBSettings(*lb, fldPath)...)
+
 	return allErrs
 }
 
@@ -620,6 +1009,178 @@ func validateClassSpecForControlPlaneOutboundLB(lb *LoadBalancerClassSpec, apise
 			allErrs = append(allErrs, field.Invalid(fldPath.Child("idleTimeoutInMinutes"), *lb.IdleTimeoutInMinutes,
 				fmt.Sprintf("Control plane outbound idle timeout should be between %d and %d minutes", MinLBIdleTimeoutInMinutes, MaxLoadBalancerOutboundIPs)))
 		}
+
+		allErrs = append(allErrs, validateNoAPIServerLBSettings(*lb, fldPath)...)
+	}
+
+	return allErrs
+}
+
+// validateNatGateway validates the public IPs and public IP prefix of a NAT gateway.
+func validateNatGateway(natGateway NatGateway, fldPath *field.Path) field.ErrorList {
+	allErrs := validateNatGatewayClassSpec(natGateway.NatGatewayClassSpec, fldPath)
+
+	allErrs = append(allErrs, validatePublicIPReferences(natGateway.NatGatewayIP, fldPath.Child("ip"))...)
+
+	names := map[string]struct{}{natGateway.NatGatewayIP.Name: {}}
+	for i, ip := range natGateway.AdditionalIPs {
+		if ip.Name == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("additionalIPs").Index(i).Child("name"), "name is required"))
+			continue
+		}
+		allErrs = append(allErrs, validatePublicIPReferences(ip, fldPath.Child("additionalIPs").Index(i))...)
+		if _, ok := names[ip.Name]; ok {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Child("additionalIPs").Index(i).Child("name"), ip.Name))
+		}
+		names[ip.Name] = struct{}{}
+	}
+
+	ipsCount := int32(len(natGateway.AdditionalIPs)) + 1
+	if natGateway.IPsCount != nil && ipsCount > *natGateway.IPsCount {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipsCount"), *natGateway.IPsCount,
+			"ipsCount must be greater than or equal to the number of public IPs in ip and additionalIPs"))
+	}
+	allErrs = append(allErrs, validateNatGatewayIPsCount(ipsCount, natGateway.IPPrefix, fldPath)...)
+
+	return allErrs
+}
+
+// validateNatGatewayClassSpec validates the NAT gateway properties that may be shared across several Azure clusters.
+func validateNatGatewayClassSpec(natGateway NatGatewayClassSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if natGateway.IPsCount != nil && (*natGateway.IPsCount < 1 || *natGateway.IPsCount > MaxNatGatewayPublicIPs) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipsCount"), *natGateway.IPsCount,
+			fmt.Sprintf("ipsCount must be between 1 and %d", MaxNatGatewayPublicIPs)))
+	}
+
+	if natGateway.IPPrefix != nil && (natGateway.IPPrefix.PrefixLength < 28 || natGateway.IPPrefix.PrefixLength > 31) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ipPrefix", "prefixLength"), natGateway.IPPrefix.PrefixLength,
+			"prefixLength must be between 28 and 31"))
+	}
+
+	if natGateway.IPPrefix != nil && natGateway.IPPrefix.ID != "" {
+		resourceID, err := azureutil.ParseResourceID(natGateway.IPPrefix.ID)
+		if err != nil || !strings.EqualFold(resourceID.ResourceType.String(), "Microsoft.Network/publicIPPrefixes") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ipPrefix", "id"), natGateway.IPPrefix.ID, "must be a valid Azure public IP prefix resource ID"))
+		} else if natGateway.IPPrefix.Name != "" && !strings.EqualFold(resourceID.Name, natGateway.IPPrefix.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("ipPrefix", "name"), natGateway.IPPrefix.Name, "must match the name of the public IP prefix in id"))
+		}
+	}
+
+	if natGateway.IdleTimeoutInMinutes != nil && (*natGateway.IdleTimeoutInMinutes < MinNatGatewayIdleTimeoutInMinutes || *natGateway.IdleTimeoutInMinutes > MaxNatGatewayIdleTimeoutInMinutes) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("idleTimeoutInMinutes"), *natGateway.IdleTimeoutInMinutes,
+			fmt.Sprintf("NAT gateway idle timeout should be between %d and %d minutes", MinNatGatewayIdleTimeoutInMinutes, MaxNatGatewayIdleTimeoutInMinutes)))
+	}
+
+	return allErrs
+}
+
+// validateNatGatewayIPsCount validates that the public IPs and the addresses of the public IP prefix of a NAT gateway don't exceed the Azure limit.
+func validateNatGatewayIPsCount(ipsCount int32, prefix *PublicIPPrefixSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	total := ipsCount
+	if prefix != nil && prefix.PrefixLength >= 28 && prefix.PrefixLength <= 31 {
+		total += 1 << (32 - prefix.PrefixLength)
+	}
+	if total > MaxNatGatewayPublicIPs {
+		allErrs = append(allErrs, field.Invalid(fldPath, total,
+			fmt.Sprintf("a NAT gateway supports at most %d public IP addresses, including the addresses of its public IP prefix", MaxNatGatewayPublicIPs)))
+	}
+
+	return allErrs
+}
+
+// validateRouteTable validates the user-defined routes of a RouteTable.
+func validateRouteTable(routeTable RouteTable, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if routeTable.Name == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("name"), "name is required when routes are specified"))
+	}
+
+	routeNames := make(map[string]bool, len(routeTable.Routes))
+	for i, route := range routeTable.Routes {
+		routePath := fldPath.Child("routes").Index(i)
+		if route.Name == "" {
+			allErrs = append(allErrs, field.Required(routePath.Child("name"), "name is required for all routes"))
+		} else {
+			if routeNames[strings.ToLower(route.Name)] {
+				allErrs = append(allErrs, field.Duplicate(routePath.Child("name"), route.Name))
+			}
+			routeNames[strings.ToLower(route.Name)] = true
+		}
+
+		if _, _, err := net.ParseCIDR(route.AddressPrefix); err != nil {
+			allErrs = append(allErrs, field.Invalid(routePath.Child("addressPrefix"), route.AddressPrefix, "invalid CIDR format"))
+		}
+
+		switch {
+		case route.NextHopType == RouteNextHopTypeVirtualAppliance && route.NextHopIPAddress == "":
+			allErrs = append(allErrs, field.Required(routePath.Child("nextHopIPAddress"), "nextHopIPAddress is required when nextHopType is VirtualAppliance"))
+		case route.NextHopType == RouteNextHopTypeVirtualAppliance && net.ParseIP(route.NextHopIPAddress) == nil:
+			allErrs = append(allErrs, field.Invalid(routePath.Child("nextHopIPAddress"), route.NextHopIPAddress, "invalid IP address"))
+		case route.NextHopType != RouteNextHopTypeVirtualAppliance && route.NextHopIPAddress != "":
+			allErrs = append(allErrs, field.Forbidden(routePath.Child("nextHopIPAddress"), "nextHopIPAddress is only allowed when nextHopType is VirtualAppliance"))
+		}
+	}
+
+	return allErrs
+}
+
+// validateFlowLog validates the flow log of a SecurityGroup.
+func validateFlowLog(flowLog *FlowLog, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if flowLog.StorageAccountID == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("storageAccountID"), "storageAccountID is required"))
+	} else if !storageAccountIDRegex.MatchString(flowLog.StorageAccountID) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("storageAccountID"), flowLog.StorageAccountID,
+			fmt.Sprintf("storage account ID doesn't match regex %s", storageAccountIDRegexPattern)))
+	}
+
+	if flowLog.RetentionDays != nil && (*flowLog.RetentionDays < 0 || *flowLog.RetentionDays > 365) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retentionDays"), *flowLog.RetentionDays, "retentionDays must be between 0 and 365"))
+	}
+
+	if ta := flowLog.TrafficAnalytics; ta != nil {
+		taPath := fldPath.Child("trafficAnalytics")
+		if !logAnalyticsWorkspaceIDRegex.MatchString(ta.WorkspaceResourceID) {
+			allErrs = append(allErrs, field.Invalid(taPath.Child("workspaceResourceID"), ta.WorkspaceResourceID,
+				fmt.Sprintf("Log Analytics workspace resource ID doesn't match regex %s", logAnalyticsWorkspaceIDRegexPattern)))
+		}
+		if _, err := uuid.Parse(ta.WorkspaceID); err != nil {
+			allErrs = append(allErrs, field.Invalid(taPath.Child("workspaceID"), ta.WorkspaceID, "workspaceID must be a valid GUID"))
+		}
+		if ta.IntervalInMinutes != nil && *ta.IntervalInMinutes != 10 && *ta.IntervalInMinutes != 60 {
+			allErrs = append(allErrs, field.NotSupported(taPath.Child("intervalInMinutes"), *ta.IntervalInMinutes, []string{"10", "60"}))
+		}
+	}
+
+	return allErrs
+}
+
+// validateFlowLogUpdate validates that a flow log is not removed from a security group and that the fields identifying
+// the flow log don't change, as the previous flow log would otherwise be left behind.
+func validateFlowLogUpdate(old, flowLog *FlowLog, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if old == nil {
+		return allErrs
+	}
+	if flowLog == nil {
+		return append(allErrs, field.Forbidden(fldPath, "flow log cannot be removed from a security group, disable it instead"))
+	}
+
+	if flowLog.Name != old.Name {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), flowLog.Name, "field is immutable"))
+	}
+	if flowLog.NetworkWatcherName != old.NetworkWatcherName {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("networkWatcherName"), flowLog.NetworkWatcherName, "field is immutable"))
+	}
+	if flowLog.NetworkWatcherResourceGroup != old.NetworkWatcherResourceGroup {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("networkWatcherResourceGroup"), flowLog.NetworkWatcherResourceGroup, "field is immutable"))
 	}
 
 	return allErrs
@@ -675,6 +1236,61 @@ func validateServiceEndpointLocationName(location string, fldPath *field.Path) *
 	return nil
 }
 
+// validateSubnetDelegations validates the delegations of a subnet with the given role.
+func validateSubnetDelegations(delegations SubnetDelegations, role SubnetRole, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(delegations) == 0 {
+		return allErrs
+	}
+
+	// Machines cannot be placed in delegated subnets, so only subnets that machines cannot select can be delegated.
+	if role != SubnetDelegated {
+		return append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("subnets with role %s cannot be delegated, use role %s", role, SubnetDelegated)))
+	}
+
+	names := make(map[string]bool, len(delegations))
+	serviceNames := make(map[string]bool, len(delegations))
+	for i, delegation := range delegations {
+		if success, _ := regexp.MatchString(privateEndpointRegex, delegation.Name); !success {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("name"), delegation.Name,
+				fmt.Sprintf("name of delegation doesn't match regex %s", privateEndpointRegex)))
+		}
+		if names[delegation.Name] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("name"), delegation.Name))
+		}
+		names[delegation.Name] = true
+
+		if !subnetDelegationServiceNameRegex.MatchString(delegation.ServiceName) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("serviceName"), delegation.ServiceName,
+				fmt.Sprintf("service name of delegation doesn't match regex %s", subnetDelegationServiceNameRegexPattern)))
+		}
+		if serviceNames[delegation.ServiceName] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i).Child("serviceName"), delegation.ServiceName))
+		}
+		serviceNames[delegation.ServiceName] = true
+	}
+
+	return allErrs
+}
+
+// validateServiceEndpointPolicyIDs validates the service endpoint policy resource IDs of a subnet.
+func validateServiceEndpointPolicyIDs(ids []string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	seen := make(map[string]bool, len(ids))
+	for i, id := range ids {
+		resourceID, err := azureutil.ParseResourceID(id)
+		if err != nil || !strings.EqualFold(resourceID.ResourceType.String(), "Microsoft.Network/serviceEndpointPolicies") {
+			allErrs = append(allErrs, field.Invalid(fldPath.Index(i), id, "must be a valid Azure service endpoint policy resource ID"))
+		}
+		if seen[strings.ToLower(id)] {
+			allErrs = append(allErrs, field.Duplicate(fldPath.Index(i), id))
+		}
+		seen[strings.ToLower(id)] = true
+	}
+
+	return allErrs
+}
+
 func validatePrivateEndpoints(privateEndpointSpecs []PrivateEndpointSpec, subnetCIDRs []string, fldPath *field.Path) field.ErrorList {
 	var allErrs field.ErrorList
 
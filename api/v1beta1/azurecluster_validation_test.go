@@ -316,7 +316,7 @@ func TestNetworkSpecWithPreexistingVnetValid(t *testing.T) {
 	for _, test := range testCase {
 		t.Run(test.name, func(t *testing.T) {
 			g := NewWithT(t)
-			errs := validateNetworkSpec(test.networkSpec, NetworkSpec{}, field.NewPath("spec").Child("networkSpec"))
+			errs := validateNetworkSpec("", test.networkSpec, NetworkSpec{}, field.NewPath("spec").Child("networkSpec"))
 			g.Expect(errs).To(BeNil())
 		})
 	}
@@ -338,7 +338,7 @@ func TestNetworkSpecWithPreexistingVnetLackRequiredSubnets(t *testing.T) {
 
 	t.Run(testCase.name, func(t *testing.T) {
 		g := NewWithT(t)
-		errs := validateNetworkSpec(testCase.networkSpec, NetworkSpec{}, field.NewPath("spec").Child("networkSpec"))
+		errs := validateNetworkSpec("", testCase.networkSpec, NetworkSpec{}, field.NewPath("spec").Child("networkSpec"))
 		g.Expect(errs).To(HaveLen(1))
 		g.Expect(errs[0].Type).To(Equal(field.ErrorTypeRequired))
 		g.Expect(errs[0].Field).To(Equal("spec.networkSpec.subnets"))
@@ -361,7 +361,7 @@ func TestNetworkSpecWithPreexistingVnetInvalidResourceGroup(t *testing.T) {
 
 	t.Run(testCase.name, func(t *testing.T) {
 		g := NewWithT(t)
-		errs := validateNetworkSpec(testCase.networkSpec, NetworkSpec{}, field.NewPath("spec").Child("networkSpec"))
+		errs := validateNetworkSpec("", testCase.networkSpec, NetworkSpec{}, field.NewPath("spec").Child("networkSpec"))
 		g.Expect(errs).To(HaveLen(1))
 		g.Expect(errs[0].Type).To(Equal(field.ErrorTypeInvalid))
 		g.Expect(errs[0].Field).To(Equal("spec.networkSpec.vnet.resourceGroup"))
@@ -384,7 +384,7 @@ func TestNetworkSpecWithoutPreexistingVnetValid(t *testing.T) {
 
 	t.Run(testCase.name, func(t *testing.T) {
 		g := NewWithT(t)
-		errs := validateNetworkSpec(testCase.networkSpec, NetworkSpec{}, field.NewPath("spec").Child("networkSpec"))
+		errs := validateNetworkSpec("", testCase.networkSpec, NetworkSpec{}, field.NewPath("spec").Child("networkSpec"))
 		g.Expect(errs).To(BeNil())
 	})
 }
@@ -809,6 +809,61 @@ func TestValidateSecurityRule(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "security rule - valid application security groups",
+			validRule: SecurityRule{
+				Name:                            "allow_web",
+				Description:                     "Allow web tier",
+				Priority:                        4000,
+				SourceApplicationSecurityGroups: []string{"web"},
+				DestinationApplicationSecurityGroups: []string{
+					"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/applicationSecurityGroups/db",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "security rule - source application security groups with source",
+			validRule: SecurityRule{
+				Name:                            "allow_web",
+				Description:                     "Allow web tier",
+				Priority:                        4000,
+				Source:                          ptr.To("*"),
+				SourceApplicationSecurityGroups: []string{"web"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "security rule - destination application security groups with destination",
+			validRule: SecurityRule{
+				Name:                                 "allow_web",
+				Description:                          "Allow web tier",
+				Priority:                             4000,
+				Destination:                          ptr.To("*"),
+				DestinationApplicationSecurityGroups: []string{"web"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "security rule - invalid application security group resource ID",
+			validRule: SecurityRule{
+				Name:                            "allow_web",
+				Description:                     "Allow web tier",
+				Priority:                        4000,
+				SourceApplicationSecurityGroups: []string{"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/web"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "security rule - duplicate application security groups",
+			validRule: SecurityRule{
+				Name:                            "allow_web",
+				Description:                     "Allow web tier",
+				Priority:                        4000,
+				SourceApplicationSecurityGroups: []string{"web", "Web"},
+			},
+			wantErr: true,
+		},
 	}
 	for _, testCase := range tests {
 		testCase := testCase
@@ -1027,6 +1082,52 @@ func TestValidateAPIServerLB(t *testing.T) {
 			cpCIDRS: []string{"10.0.0.0/24", "10.1.0.0/24"},
 			wantErr: false,
 		},
+		{
+			name: "public LB referencing an existing public IP without DNS name",
+			lb: LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						PublicIP: &PublicIPSpec{
+							Name: "reserved-ip",
+							ID:   "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip",
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+				Name: "my-public-lb",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueRequired",
+				Field:  "apiServerLB.frontendIPConfigs[0].publicIP.dnsName",
+				Detail: "dnsName is required when referencing an existing public IP with id, it must resolve to the public IP",
+			},
+		},
+		{
+			name: "public LB referencing an existing public IP",
+			lb: LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name: "ip-1",
+						PublicIP: &PublicIPSpec{
+							Name:    "reserved-ip",
+							DNSName: "api.example.com",
+							ID:      "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip",
+						},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Public,
+					SKU:  SKUStandard,
+				},
+				Name: "my-public-lb",
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, test := range testcases {
@@ -1264,6 +1365,26 @@ func TestValidateNodeOutboundLB(t *testing.T) {
 				Detail:   "Max front end ips allowed is 16",
 			},
 		},
+		{
+			name: "more frontend ips than the frontend ips count",
+			lb: &LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{Name: "frontend-ip-1"},
+					{
+						Name:                            "frontend-ip-2",
+						GatewayLoadBalancerFrontendIPID: "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/my-gwlb/frontendIPConfigurations/my-gwlb-frontEnd",
+					},
+				},
+				FrontendIPsCount: ptr.To[int32](1),
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "nodeOutboundLB.frontendIPs",
+				BadValue: 2,
+				Detail:   "must not specify more frontend IPs than frontendIPsCount (1)",
+			},
+		},
 	}
 
 	for _, test := range testcases {
@@ -1344,6 +1465,25 @@ func TestValidateControlPlaneNodeOutboundLB(t *testing.T) {
 				Detail:   "Max front end ips allowed is 16",
 			},
 		},
+		{
+			name: "cp outbound lb cannot have additional rules",
+			lb: &LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					AdditionalRules: []LoadBalancingRule{{Name: "ingress", FrontendPort: 443, BackendPort: 443}},
+				},
+			},
+			apiServerLB: LoadBalancerSpec{
+				LoadBalancerClassSpec: LoadBalancerClassSpec{
+					Type: Internal,
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "controlPlaneOutboundLB.additionalRules",
+				Detail: "additional load balancing rules can only be configured on the API server load balancer",
+			},
+		},
 	}
 
 	for _, test := range testcases {
@@ -1361,6 +1501,210 @@ func TestValidateControlPlaneNodeOutboundLB(t *testing.T) {
 	}
 }
 
+func TestValidateLoadBalancerProbe(t *testing.T) {
+	tests := []struct {
+		name        string
+		probe       LoadBalancerProbe
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "valid https probe",
+			probe: LoadBalancerProbe{
+				Protocol:          LoadBalancerProbeProtocolHTTPS,
+				Port:              ptr.To[int32](6443),
+				RequestPath:       "/livez",
+				IntervalInSeconds: ptr.To[int32](5),
+				NumberOfProbes:    ptr.To[int32](2),
+			},
+			wantErr: false,
+		},
+		{
+			name:    "valid empty probe",
+			probe:   LoadBalancerProbe{},
+			wantErr: false,
+		},
+		{
+			name: "request path without leading slash",
+			probe: LoadBalancerProbe{
+				Protocol:    LoadBalancerProbeProtocolHTTP,
+				RequestPath: "healthz",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.healthProbe.requestPath",
+				BadValue: "healthz",
+				Detail:   "requestPath must start with /",
+			},
+		},
+		{
+			name: "request path set for tcp probe",
+			probe: LoadBalancerProbe{
+				Protocol:    LoadBalancerProbeProtocolTCP,
+				RequestPath: "/readyz",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.healthProbe.requestPath",
+				Detail: "requestPath is not allowed for Tcp probes",
+			},
+		},
+		{
+			name: "unsupported protocol",
+			probe: LoadBalancerProbe{
+				Protocol: "Grpc",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueNotSupported",
+				Field:    "apiServerLB.healthProbe.protocol",
+				BadValue: LoadBalancerProbeProtocol("Grpc"),
+				Detail:   `supported values: "Http", "Https", "Tcp"`,
+			},
+		},
+		{
+			name: "interval too short",
+			probe: LoadBalancerProbe{
+				IntervalInSeconds: ptr.To[int32](1),
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.healthProbe.intervalInSeconds",
+				BadValue: int32(1),
+				Detail:   "intervalInSeconds must be at least 5",
+			},
+		},
+		{
+			name: "number of probes too small",
+			probe: LoadBalancerProbe{
+				NumberOfProbes: ptr.To[int32](0),
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.healthProbe.numberOfProbes",
+				BadValue: int32(0),
+				Detail:   "numberOfProbes must be at least 1",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateLoadBalancerProbe(testCase.probe, field.NewPath("apiServerLB", "healthProbe"))
+			if testCase.wantErr {
+				// Searches for expected error in list of thrown errors
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateLoadBalancingRules(t *testing.T) {
+	tests := []struct {
+		name        string
+		rules       []LoadBalancingRule
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "valid rules",
+			rules: []LoadBalancingRule{
+				{Name: "konnectivity", FrontendPort: 8132, BackendPort: 8132},
+				{Name: "dns", Protocol: LoadBalancingRuleProtocolUDP, FrontendPort: 53, BackendPort: 53},
+				{Name: "dns-tcp", Protocol: LoadBalancingRuleProtocolTCP, FrontendPort: 53, BackendPort: 53},
+			},
+			wantErr: false,
+		},
+		{
+			name: "reserved rule name",
+			rules: []LoadBalancingRule{
+				{Name: "LBRuleHTTPS", FrontendPort: 443, BackendPort: 443},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.additionalRules[0].name",
+				BadValue: "LBRuleHTTPS",
+				Detail:   "name is reserved for the API server load balancing rule",
+			},
+		},
+		{
+			name: "duplicate rule name",
+			rules: []LoadBalancingRule{
+				{Name: "ingress", FrontendPort: 80, BackendPort: 80},
+				{Name: "ingress", FrontendPort: 443, BackendPort: 443},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueDuplicate",
+				Field:    "apiServerLB.additionalRules[1].name",
+				BadValue: "ingress",
+			},
+		},
+		{
+			name: "duplicate frontend port",
+			rules: []LoadBalancingRule{
+				{Name: "http", FrontendPort: 80, BackendPort: 80},
+				{Name: "http-alt", FrontendPort: 80, BackendPort: 8080},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueDuplicate",
+				Field:    "apiServerLB.additionalRules[1].frontendPort",
+				BadValue: int32(80),
+			},
+		},
+		{
+			name: "invalid backend port",
+			rules: []LoadBalancingRule{
+				{Name: "ingress", FrontendPort: 443},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "apiServerLB.additionalRules[0].backendPort",
+				BadValue: int32(0),
+				Detail:   "backendPort must be between 1 and 65535",
+			},
+		},
+		{
+			name: "invalid rule health probe",
+			rules: []LoadBalancingRule{
+				{
+					Name:         "ingress",
+					FrontendPort: 443,
+					BackendPort:  443,
+					HealthProbe:  &LoadBalancerProbe{Protocol: LoadBalancerProbeProtocolTCP, RequestPath: "/healthz"},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "apiServerLB.additionalRules[0].healthProbe.requestPath",
+				Detail: "requestPath is not allowed for Tcp probes",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateLoadBalancingRules(testCase.rules, field.NewPath("apiServerLB", "additionalRules"))
+			if testCase.wantErr {
+				// Searches for expected error in list of thrown errors
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
 func TestValidateCloudProviderConfigOverrides(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -1681,6 +2025,1135 @@ func TestValidateServiceEndpoints(t *testing.T) {
 	}
 }
 
+func TestValidateVnetClassSpec(t *testing.T) {
+	tests := []struct {
+		name        string
+		vnet        VnetClassSpec
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "valid DDoS protection plan and DNS servers",
+			vnet: VnetClassSpec{
+				DDoSProtectionPlanID: "/subscriptions/123/resourceGroups/ddos-rg/providers/Microsoft.Network/ddosProtectionPlans/my-plan",
+				DNSServers:           []string{"10.0.0.4", "fd00::4"},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid DDoS protection plan ID",
+			vnet: VnetClassSpec{
+				DDoSProtectionPlanID: "/subscriptions/123/resourceGroups/ddos-rg/providers/Microsoft.Network/publicIPAddresses/my-ip",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "vnet.ddosProtectionPlanID",
+				BadValue: "/subscriptions/123/resourceGroups/ddos-rg/providers/Microsoft.Network/publicIPAddresses/my-ip",
+				Detail:   "must be a valid Azure DDoS protection plan resource ID",
+			},
+		},
+		{
+			name: "invalid DNS server",
+			vnet: VnetClassSpec{
+				DNSServers: []string{"10.0.0.4", "dns.example.com"},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "vnet.dnsServers[1]",
+				BadValue: "dns.example.com",
+				Detail:   "must be a valid IP address",
+			},
+		},
+		{
+			name: "duplicate DNS server",
+			vnet: VnetClassSpec{
+				DNSServers: []string{"10.0.0.4", "10.0.0.4"},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueDuplicate",
+				Field:    "vnet.dnsServers[1]",
+				BadValue: "10.0.0.4",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateVnetClassSpec(testCase.vnet, field.NewPath("vnet"))
+			if testCase.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateVnetPeerings(t *testing.T) {
+	tests := []struct {
+		name     string
+		peerings VnetPeerings
+		wantErr  bool
+	}{
+		{
+			name: "peerings to vnets with the same name in different subscriptions",
+			peerings: VnetPeerings{
+				{VnetPeeringClassSpec: VnetPeeringClassSpec{ResourceGroup: "hub-rg", RemoteVnetName: "hub-vnet"}},
+				{VnetPeeringClassSpec: VnetPeeringClassSpec{
+					ResourceGroup:  "hub-rg",
+					RemoteVnetName: "hub-vnet",
+					RemoteVnetID:   "/subscriptions/456/resourceGroups/hub-rg/providers/Microsoft.Network/virtualNetworks/hub-vnet",
+				}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "peerings to the same vnet by name",
+			peerings: VnetPeerings{
+				{VnetPeeringClassSpec: VnetPeeringClassSpec{ResourceGroup: "hub-rg", RemoteVnetName: "hub-vnet"}},
+				{VnetPeeringClassSpec: VnetPeeringClassSpec{ResourceGroup: "hub-rg", RemoteVnetName: "hub-vnet"}},
+			},
+			wantErr: true,
+		},
+		{
+			name: "peerings to the same vnet in the cluster subscription by name and by ID",
+			peerings: VnetPeerings{
+				{VnetPeeringClassSpec: VnetPeeringClassSpec{ResourceGroup: "hub-rg", RemoteVnetName: "hub-vnet"}},
+				{VnetPeeringClassSpec: VnetPeeringClassSpec{
+					RemoteVnetID: "/subscriptions/123/resourceGroups/Hub-RG/providers/Microsoft.Network/virtualNetworks/hub-vnet",
+				}},
+			},
+			wantErr: true,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateVnetPeerings(testCase.peerings, "123", field.NewPath("peerings"))
+			if testCase.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(ContainSubstring("Duplicate value"))))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateVnetPeeringClassSpec(t *testing.T) {
+	tests := []struct {
+		name        string
+		peering     VnetPeeringClassSpec
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "valid remote vnet name",
+			peering: VnetPeeringClassSpec{
+				ResourceGroup:  "hub-rg",
+				RemoteVnetName: "hub-vnet",
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid remote vnet ID in another subscription with a reverse peering identity",
+			peering: VnetPeeringClassSpec{
+				ResourceGroup:  "hub-rg",
+				RemoteVnetName: "hub-vnet",
+				RemoteVnetID:   "/subscriptions/hub-sub/resourceGroups/hub-rg/providers/Microsoft.Network/virtualNetworks/hub-vnet",
+				ReversePeeringIdentityRef: &corev1.ObjectReference{
+					Kind: AzureClusterIdentityKind,
+					Name: "hub-identity",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "missing remote vnet name and ID",
+			peering: VnetPeeringClassSpec{ResourceGroup: "hub-rg"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueRequired",
+				Field:  "peering.remoteVnetName",
+				Detail: "remoteVnetName is required if remoteVnetID is not set",
+			},
+		},
+		{
+			name: "remote vnet ID of another resource type",
+			peering: VnetPeeringClassSpec{
+				RemoteVnetID: "/subscriptions/hub-sub/resourceGroups/hub-rg/providers/Microsoft.Network/publicIPAddresses/hub-ip",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "peering.remoteVnetID",
+				BadValue: "/subscriptions/hub-sub/resourceGroups/hub-rg/providers/Microsoft.Network/publicIPAddresses/hub-ip",
+				Detail:   "must be the ID of a virtual network",
+			},
+		},
+		{
+			name: "remote vnet name doesn't match remote vnet ID",
+			peering: VnetPeeringClassSpec{
+				RemoteVnetName: "other-vnet",
+				RemoteVnetID:   "/subscriptions/hub-sub/resourceGroups/hub-rg/providers/Microsoft.Network/virtualNetworks/hub-vnet",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "peering.remoteVnetName",
+				BadValue: "other-vnet",
+				Detail:   "must match the name in remoteVnetID",
+			},
+		},
+		{
+			name: "resource group doesn't match remote vnet ID",
+			peering: VnetPeeringClassSpec{
+				ResourceGroup: "other-rg",
+				RemoteVnetID:  "/subscriptions/hub-sub/resourceGroups/hub-rg/providers/Microsoft.Network/virtualNetworks/hub-vnet",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "peering.resourceGroup",
+				BadValue: "other-rg",
+				Detail:   "must match the resource group in remoteVnetID",
+			},
+		},
+		{
+			name: "reverse peering identity of another kind",
+			peering: VnetPeeringClassSpec{
+				RemoteVnetName: "hub-vnet",
+				ReversePeeringIdentityRef: &corev1.ObjectReference{
+					Kind: "Secret",
+					Name: "hub-identity",
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueNotSupported",
+				Field:    "peering.reversePeeringIdentityRef.name",
+				BadValue: "hub-identity",
+				Detail:   `supported values: "AzureClusterIdentity"`,
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateVnetPeeringClassSpec(testCase.peering, field.NewPath("peering"))
+			if testCase.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateGatewayLoadBalancerFrontendIPs(t *testing.T) {
+	gatewayFrontendIPID := "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/my-gwlb/frontendIPConfigurations/my-gwlb-frontEnd"
+	tests := []struct {
+		name        string
+		lb          LoadBalancerSpec
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "public frontend IP of a Standard SKU load balancer",
+			lb: LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name:                            "ip-config",
+						PublicIP:                        &PublicIPSpec{Name: "public-ip"},
+						GatewayLoadBalancerFrontendIPID: gatewayFrontendIPID,
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{Type: Public, SKU: SKUStandard},
+			},
+			wantErr: false,
+		},
+		{
+			name: "frontend IP of an internal load balancer",
+			lb: LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name:                            "ip-config",
+						GatewayLoadBalancerFrontendIPID: gatewayFrontendIPID,
+						FrontendIPClass:                 FrontendIPClass{PrivateIPAddress: "10.0.0.100"},
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{Type: Internal, SKU: SKUStandard},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "frontendIPs[0].gatewayLoadBalancerFrontendIPID",
+				Detail: "only public frontend IPs can be chained to a gateway load balancer",
+			},
+		},
+		{
+			name: "frontend IP of a load balancer that isn't Standard SKU",
+			lb: LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name:                            "ip-config",
+						PublicIP:                        &PublicIPSpec{Name: "public-ip"},
+						GatewayLoadBalancerFrontendIPID: gatewayFrontendIPID,
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{Type: Public, SKU: SKU("Basic")},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "frontendIPs[0].gatewayLoadBalancerFrontendIPID",
+				Detail: "only frontend IPs of Standard SKU load balancers can be chained to a gateway load balancer",
+			},
+		},
+		{
+			name: "ID of another resource type",
+			lb: LoadBalancerSpec{
+				FrontendIPs: []FrontendIP{
+					{
+						Name:                            "ip-config",
+						PublicIP:                        &PublicIPSpec{Name: "public-ip"},
+						GatewayLoadBalancerFrontendIPID: "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/my-gwlb",
+					},
+				},
+				LoadBalancerClassSpec: LoadBalancerClassSpec{Type: Public, SKU: SKUStandard},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "frontendIPs[0].gatewayLoadBalancerFrontendIPID",
+				BadValue: "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/my-gwlb",
+				Detail:   "must be a valid Azure load balancer frontend IP configuration resource ID",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateGatewayLoadBalancerFrontendIPs(testCase.lb, field.NewPath("frontendIPs"))
+			if testCase.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateSubnetDelegations(t *testing.T) {
+	tests := []struct {
+		name        string
+		delegations SubnetDelegations
+		role        SubnetRole
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "valid delegations",
+			delegations: SubnetDelegations{
+				{Name: "aci", ServiceName: "Microsoft.ContainerInstance/containerGroups"},
+				{Name: "ngfw", ServiceName: "PaloAltoNetworks.Cloudngfw/firewalls"},
+			},
+			role:    SubnetDelegated,
+			wantErr: false,
+		},
+		{
+			name: "delegated node subnet",
+			delegations: SubnetDelegations{
+				{Name: "aci", ServiceName: "Microsoft.ContainerInstance/containerGroups"},
+			},
+			role:    SubnetNode,
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueForbidden",
+				Field:    "subnets[0].delegations",
+				BadValue: "",
+				Detail:   "subnets with role node cannot be delegated, use role delegated",
+			},
+		},
+		{
+			name: "delegated control plane subnet",
+			delegations: SubnetDelegations{
+				{Name: "aci", ServiceName: "Microsoft.ContainerInstance/containerGroups"},
+			},
+			role:    SubnetControlPlane,
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueForbidden",
+				Field:    "subnets[0].delegations",
+				BadValue: "",
+				Detail:   "subnets with role control-plane cannot be delegated, use role delegated",
+			},
+		},
+		{
+			name: "invalid service name",
+			delegations: SubnetDelegations{
+				{Name: "aci", ServiceName: "ContainerInstance"},
+			},
+			role:    SubnetDelegated,
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "subnets[0].delegations[0].serviceName",
+				BadValue: "ContainerInstance",
+				Detail:   "service name of delegation doesn't match regex ^[a-zA-Z][a-zA-Z0-9]*(\\.[a-zA-Z0-9]+)+(/[a-zA-Z0-9]+)+$",
+			},
+		},
+		{
+			name: "duplicate service name",
+			delegations: SubnetDelegations{
+				{Name: "aci", ServiceName: "Microsoft.ContainerInstance/containerGroups"},
+				{Name: "aci-2", ServiceName: "Microsoft.ContainerInstance/containerGroups"},
+			},
+			role:    SubnetDelegated,
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueDuplicate",
+				Field:    "subnets[0].delegations[1].serviceName",
+				BadValue: "Microsoft.ContainerInstance/containerGroups",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateSubnetDelegations(testCase.delegations, testCase.role, field.NewPath("subnets[0].delegations"))
+			if testCase.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateServiceEndpointPolicyIDs(t *testing.T) {
+	tests := []struct {
+		name        string
+		ids         []string
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name:    "valid service endpoint policy ID",
+			ids:     []string{"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/serviceEndpointPolicies/my-policy"},
+			wantErr: false,
+		},
+		{
+			name:    "resource ID of another type",
+			ids:     []string{"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/routeTables/my-rt"},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "subnets[0].serviceEndpointPolicyIDs[0]",
+				BadValue: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/routeTables/my-rt",
+				Detail:   "must be a valid Azure service endpoint policy resource ID",
+			},
+		},
+		{
+			name: "duplicate service endpoint policy ID",
+			ids: []string{
+				"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/serviceEndpointPolicies/my-policy",
+				"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/serviceEndpointPolicies/My-Policy",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueDuplicate",
+				Field:    "subnets[0].serviceEndpointPolicyIDs[1]",
+				BadValue: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/serviceEndpointPolicies/My-Policy",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateServiceEndpointPolicyIDs(testCase.ids, field.NewPath("subnets[0].serviceEndpointPolicyIDs"))
+			if testCase.wantErr {
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateRouteTable(t *testing.T) {
+	tests := []struct {
+		name        string
+		routeTable  RouteTable
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "valid routes",
+			routeTable: RouteTable{
+				Name: "my-rt",
+				Routes: Routes{
+					{Name: "to-nva", AddressPrefix: "0.0.0.0/0", NextHopType: RouteNextHopTypeVirtualAppliance, NextHopIPAddress: "10.0.0.4"},
+					{Name: "to-onprem", AddressPrefix: "192.168.0.0/16", NextHopType: RouteNextHopTypeVirtualNetworkGateway},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "routes without route table name",
+			routeTable: RouteTable{
+				Routes: Routes{
+					{Name: "to-onprem", AddressPrefix: "192.168.0.0/16", NextHopType: RouteNextHopTypeVirtualNetworkGateway},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueRequired",
+				Field:    "subnets[0].routeTable.name",
+				BadValue: "",
+				Detail:   "name is required when routes are specified",
+			},
+		},
+		{
+			name: "duplicate route names",
+			routeTable: RouteTable{
+				Name: "my-rt",
+				Routes: Routes{
+					{Name: "to-onprem", AddressPrefix: "192.168.0.0/16", NextHopType: RouteNextHopTypeVirtualNetworkGateway},
+					{Name: "To-OnPrem", AddressPrefix: "172.16.0.0/12", NextHopType: RouteNextHopTypeVirtualNetworkGateway},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueDuplicate",
+				Field:    "subnets[0].routeTable.routes[1].name",
+				BadValue: "To-OnPrem",
+			},
+		},
+		{
+			name: "invalid address prefix",
+			routeTable: RouteTable{
+				Name: "my-rt",
+				Routes: Routes{
+					{Name: "to-onprem", AddressPrefix: "192.168.0.0", NextHopType: RouteNextHopTypeVirtualNetworkGateway},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "subnets[0].routeTable.routes[0].addressPrefix",
+				BadValue: "192.168.0.0",
+				Detail:   "invalid CIDR format",
+			},
+		},
+		{
+			name: "virtual appliance without next hop IP address",
+			routeTable: RouteTable{
+				Name: "my-rt",
+				Routes: Routes{
+					{Name: "to-nva", AddressPrefix: "0.0.0.0/0", NextHopType: RouteNextHopTypeVirtualAppliance},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueRequired",
+				Field:    "subnets[0].routeTable.routes[0].nextHopIPAddress",
+				BadValue: "",
+				Detail:   "nextHopIPAddress is required when nextHopType is VirtualAppliance",
+			},
+		},
+		{
+			name: "virtual appliance with invalid next hop IP address",
+			routeTable: RouteTable{
+				Name: "my-rt",
+				Routes: Routes{
+					{Name: "to-nva", AddressPrefix: "0.0.0.0/0", NextHopType: RouteNextHopTypeVirtualAppliance, NextHopIPAddress: "10.0.0"},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "subnets[0].routeTable.routes[0].nextHopIPAddress",
+				BadValue: "10.0.0",
+				Detail:   "invalid IP address",
+			},
+		},
+		{
+			name: "next hop IP address with another next hop type",
+			routeTable: RouteTable{
+				Name: "my-rt",
+				Routes: Routes{
+					{Name: "to-internet", AddressPrefix: "0.0.0.0/0", NextHopType: RouteNextHopTypeInternet, NextHopIPAddress: "10.0.0.4"},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "subnets[0].routeTable.routes[0].nextHopIPAddress",
+				Detail: "nextHopIPAddress is only allowed when nextHopType is VirtualAppliance",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateRouteTable(testCase.routeTable, field.NewPath("subnets[0].routeTable"))
+			if testCase.wantErr {
+				// Searches for expected error in list of thrown errors
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateNatGateway(t *testing.T) {
+	tests := []struct {
+		name        string
+		natGateway  NatGateway
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "valid NAT gateway with additional public IPs and a public IP prefix",
+			natGateway: NatGateway{
+				NatGatewayClassSpec: NatGatewayClassSpec{
+					Name:                 "my-natgw",
+					IPsCount:             ptr.To[int32](2),
+					IPPrefix:             &PublicIPPrefixSpec{Name: "pipp-my-natgw", PrefixLength: 29},
+					IdleTimeoutInMinutes: ptr.To[int32](30),
+				},
+				NatGatewayIP:  PublicIPSpec{Name: "pip-my-natgw"},
+				AdditionalIPs: []PublicIPSpec{{Name: "pip-my-natgw-1"}},
+			},
+			wantErr: false,
+		},
+		{
+			name: "additional public IP without name",
+			natGateway: NatGateway{
+				NatGatewayClassSpec: NatGatewayClassSpec{Name: "my-natgw"},
+				NatGatewayIP:        PublicIPSpec{Name: "pip-my-natgw"},
+				AdditionalIPs:       []PublicIPSpec{{}},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueRequired",
+				Field:    "subnets[0].natGateway.additionalIPs[0].name",
+				BadValue: "",
+				Detail:   "name is required",
+			},
+		},
+		{
+			name: "duplicate public IP names",
+			natGateway: NatGateway{
+				NatGatewayClassSpec: NatGatewayClassSpec{Name: "my-natgw"},
+				NatGatewayIP:        PublicIPSpec{Name: "pip-my-natgw"},
+				AdditionalIPs:       []PublicIPSpec{{Name: "pip-my-natgw"}},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueDuplicate",
+				Field:    "subnets[0].natGateway.additionalIPs[0].name",
+				BadValue: "pip-my-natgw",
+			},
+		},
+		{
+			name: "ipsCount lower than the number of public IPs",
+			natGateway: NatGateway{
+				NatGatewayClassSpec: NatGatewayClassSpec{Name: "my-natgw", IPsCount: ptr.To[int32](1)},
+				NatGatewayIP:        PublicIPSpec{Name: "pip-my-natgw"},
+				AdditionalIPs:       []PublicIPSpec{{Name: "pip-my-natgw-1"}},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "subnets[0].natGateway.ipsCount",
+				BadValue: int32(1),
+				Detail:   "ipsCount must be greater than or equal to the number of public IPs in ip and additionalIPs",
+			},
+		},
+		{
+			name: "ipsCount out of range",
+			natGateway: NatGateway{
+				NatGatewayClassSpec: NatGatewayClassSpec{Name: "my-natgw", IPsCount: ptr.To[int32](17)},
+				NatGatewayIP:        PublicIPSpec{Name: "pip-my-natgw"},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "subnets[0].natGateway.ipsCount",
+				BadValue: int32(17),
+				Detail:   "ipsCount must be between 1 and 16",
+			},
+		},
+		{
+			name: "invalid public IP prefix length",
+			natGateway: NatGateway{
+				NatGatewayClassSpec: NatGatewayClassSpec{Name: "my-natgw", IPPrefix: &PublicIPPrefixSpec{Name: "pipp-my-natgw", PrefixLength: 27}},
+				NatGatewayIP:        PublicIPSpec{Name: "pip-my-natgw"},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "subnets[0].natGateway.ipPrefix.prefixLength",
+				BadValue: int32(27),
+				Detail:   "prefixLength must be between 28 and 31",
+			},
+		},
+		{
+			name: "too many public IP addresses with the public IP prefix",
+			natGateway: NatGateway{
+				NatGatewayClassSpec: NatGatewayClassSpec{Name: "my-natgw", IPPrefix: &PublicIPPrefixSpec{Name: "pipp-my-natgw", PrefixLength: 28}},
+				NatGatewayIP:        PublicIPSpec{Name: "pip-my-natgw"},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "subnets[0].natGateway",
+				BadValue: int32(17),
+				Detail:   "a NAT gateway supports at most 16 public IP addresses, including the addresses of its public IP prefix",
+			},
+		},
+		{
+			name: "public IP prefix id is not a public IP prefix resource ID",
+			natGateway: NatGateway{
+				NatGatewayClassSpec: NatGatewayClassSpec{Name: "my-natgw", IPPrefix: &PublicIPPrefixSpec{
+					Name:         "reserved-prefix",
+					ID:           "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-prefix",
+					PrefixLength: 31,
+				}},
+				NatGatewayIP: PublicIPSpec{Name: "pip-my-natgw"},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "subnets[0].natGateway.ipPrefix.id",
+				BadValue: "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-prefix",
+				Detail:   "must be a valid Azure public IP prefix resource ID",
+			},
+		},
+		{
+			name: "invalid reference to an existing public IP",
+			natGateway: NatGateway{
+				NatGatewayClassSpec: NatGatewayClassSpec{Name: "my-natgw"},
+				NatGatewayIP:        PublicIPSpec{Name: "pip-my-natgw", ID: "pip-my-natgw"},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "subnets[0].natGateway.ip.id",
+				BadValue: "pip-my-natgw",
+				Detail:   "must be a valid Azure public IP resource ID",
+			},
+		},
+		{
+			name: "idle timeout out of range",
+			natGateway: NatGateway{
+				NatGatewayClassSpec: NatGatewayClassSpec{Name: "my-natgw", IdleTimeoutInMinutes: ptr.To[int32](121)},
+				NatGatewayIP:        PublicIPSpec{Name: "pip-my-natgw"},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "subnets[0].natGateway.idleTimeoutInMinutes",
+				BadValue: int32(121),
+				Detail:   "NAT gateway idle timeout should be between 4 and 120 minutes",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateNatGateway(testCase.natGateway, field.NewPath("subnets[0].natGateway"))
+			if testCase.wantErr {
+				// Searches for expected error in list of thrown errors
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidatePublicIPReferences(t *testing.T) {
+	tests := []struct {
+		name        string
+		publicIP    PublicIPSpec
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "valid reference to an existing public IP",
+			publicIP: PublicIPSpec{
+				Name: "reserved-ip",
+				ID:   "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip",
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid public IP allocated from an existing public IP prefix",
+			publicIP: PublicIPSpec{
+				Name:             "pip-my-natgw",
+				PublicIPPrefixID: "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPPrefixes/reserved-prefix",
+			},
+			wantErr: false,
+		},
+		{
+			name: "id is not a public IP resource ID",
+			publicIP: PublicIPSpec{
+				Name: "reserved-ip",
+				ID:   "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPPrefixes/reserved-ip",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "publicIP.id",
+				BadValue: "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPPrefixes/reserved-ip",
+				Detail:   "must be a valid Azure public IP resource ID",
+			},
+		},
+		{
+			name: "name doesn't match the name in id",
+			publicIP: PublicIPSpec{
+				Name: "my-ip",
+				ID:   "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "publicIP.name",
+				BadValue: "my-ip",
+				Detail:   "must match the name of the public IP in id",
+			},
+		},
+		{
+			name: "id and publicIPPrefixID are mutually exclusive",
+			publicIP: PublicIPSpec{
+				Name:             "reserved-ip",
+				ID:               "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip",
+				PublicIPPrefixID: "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPPrefixes/reserved-prefix",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "publicIP.publicIPPrefixID",
+				Detail: "cannot be set when referencing an existing public IP with id",
+			},
+		},
+		{
+			name: "ipTags with id",
+			publicIP: PublicIPSpec{
+				Name:   "reserved-ip",
+				ID:     "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip",
+				IPTags: []IPTag{{Type: "FirstPartyUsage", Tag: "SQL"}},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:   "FieldValueForbidden",
+				Field:  "publicIP.ipTags",
+				Detail: "cannot be set when referencing an existing public IP with id",
+			},
+		},
+		{
+			name: "publicIPPrefixID is not a public IP prefix resource ID",
+			publicIP: PublicIPSpec{
+				Name:             "pip-my-natgw",
+				PublicIPPrefixID: "reserved-prefix",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "publicIP.publicIPPrefixID",
+				BadValue: "reserved-prefix",
+				Detail:   "must be a valid Azure public IP prefix resource ID",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validatePublicIPReferences(testCase.publicIP, field.NewPath("publicIP"))
+			if testCase.wantErr {
+				// Searches for expected error in list of thrown errors
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateApplicationSecurityGroups(t *testing.T) {
+	subnetWithRule := func(rule SecurityRule) Subnets {
+		return Subnets{
+			{
+				SecurityGroup: SecurityGroup{
+					Name: "my-nsg",
+					SecurityGroupClass: SecurityGroupClass{
+						SecurityRules: SecurityRules{rule},
+					},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name        string
+		networkSpec NetworkSpec
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "valid application security groups referenced by name and ID",
+			networkSpec: NetworkSpec{
+				NetworkClassSpec: NetworkClassSpec{
+					ApplicationSecurityGroups: []ApplicationSecurityGroup{
+						{Name: "web", Role: "node"},
+						{Name: "control-plane-asg", Role: "control-plane"},
+					},
+				},
+				Subnets: subnetWithRule(SecurityRule{
+					Name:                            "allow_web",
+					SourceApplicationSecurityGroups: []string{"Web"},
+					DestinationApplicationSecurityGroups: []string{
+						"/subscriptions/123/resourceGroups/other-rg/providers/Microsoft.Network/applicationSecurityGroups/db",
+					},
+				}),
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid application security group name",
+			networkSpec: NetworkSpec{
+				NetworkClassSpec: NetworkClassSpec{
+					ApplicationSecurityGroups: []ApplicationSecurityGroup{
+						{Name: "-web"},
+					},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "networkSpec.applicationSecurityGroups[0].name",
+				BadValue: "-web",
+				Detail:   "name of application security group doesn't match regex ^[a-zA-Z0-9]([-\\w\\.]{0,78}[a-zA-Z0-9_])?$",
+			},
+		},
+		{
+			name: "duplicate application security group names",
+			networkSpec: NetworkSpec{
+				NetworkClassSpec: NetworkClassSpec{
+					ApplicationSecurityGroups: []ApplicationSecurityGroup{
+						{Name: "web"},
+						{Name: "WEB"},
+					},
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueDuplicate",
+				Field:    "networkSpec.applicationSecurityGroups[1].name",
+				BadValue: "WEB",
+			},
+		},
+		{
+			name: "security rule references undefined application security group",
+			networkSpec: NetworkSpec{
+				NetworkClassSpec: NetworkClassSpec{
+					ApplicationSecurityGroups: []ApplicationSecurityGroup{
+						{Name: "web"},
+					},
+				},
+				Subnets: subnetWithRule(SecurityRule{
+					Name:                                 "allow_web",
+					DestinationApplicationSecurityGroups: []string{"db"},
+				}),
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueNotFound",
+				Field:    "networkSpec.subnets[0].securityGroup.securityRules[0].destinationApplicationSecurityGroups[0]",
+				BadValue: "db",
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateApplicationSecurityGroups(testCase.networkSpec, field.NewPath("networkSpec"))
+			if testCase.wantErr {
+				// Searches for expected error in list of thrown errors
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateFlowLog(t *testing.T) {
+	const (
+		storageAccountID = "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.Storage/storageAccounts/flowlogs"
+		workspaceID      = "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.OperationalInsights/workspaces/my-workspace"
+	)
+	tests := []struct {
+		name        string
+		flowLog     FlowLog
+		wantErr     bool
+		expectedErr field.Error
+	}{
+		{
+			name: "valid flow log with traffic analytics",
+			flowLog: FlowLog{
+				StorageAccountID: storageAccountID,
+				RetentionDays:    ptr.To[int32](30),
+				TrafficAnalytics: &TrafficAnalytics{
+					WorkspaceResourceID: workspaceID,
+					WorkspaceID:         "00000000-0000-0000-0000-000000000000",
+					IntervalInMinutes:   ptr.To[int32](10),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "missing storage account ID",
+			flowLog: FlowLog{},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueRequired",
+				Field:    "securityGroup.flowLog.storageAccountID",
+				BadValue: "",
+				Detail:   "storageAccountID is required",
+			},
+		},
+		{
+			name: "invalid storage account ID",
+			flowLog: FlowLog{
+				StorageAccountID: "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.Network/networkSecurityGroups/flowlogs",
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "securityGroup.flowLog.storageAccountID",
+				BadValue: "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.Network/networkSecurityGroups/flowlogs",
+				Detail:   "storage account ID doesn't match regex " + storageAccountIDRegexPattern,
+			},
+		},
+		{
+			name: "retention days out of range",
+			flowLog: FlowLog{
+				StorageAccountID: storageAccountID,
+				RetentionDays:    ptr.To[int32](366),
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "securityGroup.flowLog.retentionDays",
+				BadValue: int32(366),
+				Detail:   "retentionDays must be between 0 and 365",
+			},
+		},
+		{
+			name: "invalid traffic analytics workspace ID",
+			flowLog: FlowLog{
+				StorageAccountID: storageAccountID,
+				TrafficAnalytics: &TrafficAnalytics{
+					WorkspaceResourceID: workspaceID,
+					WorkspaceID:         "my-workspace",
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueInvalid",
+				Field:    "securityGroup.flowLog.trafficAnalytics.workspaceID",
+				BadValue: "my-workspace",
+				Detail:   "workspaceID must be a valid GUID",
+			},
+		},
+		{
+			name: "unsupported traffic analytics interval",
+			flowLog: FlowLog{
+				StorageAccountID: storageAccountID,
+				TrafficAnalytics: &TrafficAnalytics{
+					WorkspaceResourceID: workspaceID,
+					WorkspaceID:         "00000000-0000-0000-0000-000000000000",
+					IntervalInMinutes:   ptr.To[int32](30),
+				},
+			},
+			wantErr: true,
+			expectedErr: field.Error{
+				Type:     "FieldValueNotSupported",
+				Field:    "securityGroup.flowLog.trafficAnalytics.intervalInMinutes",
+				BadValue: int32(30),
+				Detail:   `supported values: "10", "60"`,
+			},
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateFlowLog(&testCase.flowLog, field.NewPath("securityGroup", "flowLog"))
+			if testCase.wantErr {
+				// Searches for expected error in list of thrown errors
+				g.Expect(err).To(ContainElement(MatchError(testCase.expectedErr.Error())))
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateFlowLogUpdate(t *testing.T) {
+	tests := []struct {
+		name    string
+		old     *FlowLog
+		flowLog *FlowLog
+		wantErr bool
+	}{
+		{
+			name:    "flow log added",
+			flowLog: &FlowLog{StorageAccountID: "storage"},
+			wantErr: false,
+		},
+		{
+			name:    "flow log disabled",
+			old:     &FlowLog{StorageAccountID: "storage"},
+			flowLog: &FlowLog{StorageAccountID: "other-storage", Enabled: ptr.To(false)},
+			wantErr: false,
+		},
+		{
+			name:    "flow log removed",
+			old:     &FlowLog{StorageAccountID: "storage"},
+			wantErr: true,
+		},
+		{
+			name:    "flow log renamed",
+			old:     &FlowLog{StorageAccountID: "storage"},
+			flowLog: &FlowLog{Name: "my-flowlog", StorageAccountID: "storage"},
+			wantErr: true,
+		},
+		{
+			name:    "flow log moved to another network watcher",
+			old:     &FlowLog{StorageAccountID: "storage", NetworkWatcherName: "watcher"},
+			flowLog: &FlowLog{StorageAccountID: "storage", NetworkWatcherName: "other-watcher"},
+			wantErr: true,
+		},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateFlowLogUpdate(testCase.old, testCase.flowLog, field.NewPath("securityGroup", "flowLog"))
+			if testCase.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
 func TestServiceEndpointsLackRequiredFieldService(t *testing.T) {
 	type test struct {
 		name             string
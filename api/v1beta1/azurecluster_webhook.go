@@ -181,6 +181,8 @@ func (c *AzureCluster) validateSubnetUpdate(old *AzureCluster) field.ErrorList {
 						c.Spec.NetworkSpec.Subnets[i].SecurityGroup.Name, "field is immutable"),
 				)
 			}
+			allErrs = append(allErrs, validateFlowLogUpdate(oldSubnet.SecurityGroup.FlowLog, subnet.SecurityGroup.FlowLog,
+				field.NewPath("spec", "networkSpec", "subnets").Index(oldSubnetIndex[subnet.Name]).Child("securityGroup", "flowLog"))...)
 		}
 	}
 
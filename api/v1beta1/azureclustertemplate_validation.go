@@ -22,6 +22,7 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 )
 
@@ -46,6 +47,15 @@ func (c *AzureClusterTemplate) validateClusterTemplateSpec() field.ErrorList {
 		field.NewPath("spec").Child("template").Child("spec").
 			Child("networkSpec").Child("vnet").Child("cidrBlocks"))...)
 
+	allErrs = append(allErrs, validateVnetClassSpec(
+		c.Spec.Template.Spec.NetworkSpec.Vnet.VnetClassSpec,
+		field.NewPath("spec").Child("template").Child("spec").Child("networkSpec").Child("vnet"))...)
+
+	for i, peering := range c.Spec.Template.Spec.NetworkSpec.Vnet.Peerings {
+		allErrs = append(allErrs, validateVnetPeeringClassSpec(peering,
+			field.NewPath("spec").Child("template").Child("spec").Child("networkSpec").Child("vnet").Child("peerings").Index(i))...)
+	}
+
 	allErrs = append(allErrs, validateSubnetTemplates(
 		c.Spec.Template.Spec.NetworkSpec.Subnets,
 		c.Spec.Template.Spec.NetworkSpec.Vnet,
@@ -112,7 +122,17 @@ func validateSubnetTemplates(subnets SubnetTemplatesSpec, vnet VnetTemplateSpec,
 				allErrs = append(allErrs, err...)
 			}
 		}
+		if subnet.SecurityGroup.FlowLog != nil {
+			allErrs = append(allErrs, validateFlowLog(subnet.SecurityGroup.FlowLog, fld.Index(i).Child("securityGroup", "flowLog"))...)
+		}
 		allErrs = append(allErrs, validateSubnetCIDR(subnet.CIDRBlocks, vnet.CIDRBlocks, fld.Index(i).Child("cidrBlocks"))...)
+		allErrs = append(allErrs, validateSubnetDelegations(subnet.Delegations, subnet.Role, fld.Index(i).Child("delegations"))...)
+		allErrs = append(allErrs, validateServiceEndpointPolicyIDs(subnet.ServiceEndpointPolicyIDs, fld.Index(i).Child("serviceEndpointPolicyIDs"))...)
+		if subnet.IsNatGatewayEnabled() {
+			natGatewayPath := fld.Index(i).Child("natGateway")
+			allErrs = append(allErrs, validateNatGatewayClassSpec(subnet.NatGateway, natGatewayPath)...)
+			allErrs = append(allErrs, validateNatGatewayIPsCount(ptr.Deref(subnet.NatGateway.IPsCount, 1), subnet.NatGateway.IPPrefix, natGatewayPath)...)
+		}
 	}
 	for k, v := range requiredSubnetRoles {
 		if !v {
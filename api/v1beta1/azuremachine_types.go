@@ -143,6 +143,13 @@ type AzureMachineSpec struct {
 	// +optional
 	NetworkInterfaces []NetworkInterface `json:"networkInterfaces,omitempty"`
 
+	// ApplicationSecurityGroups are the application security groups the network interfaces of the VM join, in addition
+	// to the cluster's application security groups of the machine's role. They are referenced by the name of one of the
+	// cluster's application security groups or by the resource ID of an existing one.
+	// It is optional but may not be changed once set.
+	// +optional
+	ApplicationSecurityGroups []string `json:"applicationSecurityGroups,omitempty"`
+
 	// CapacityReservationGroupID specifies the capacity reservation group resource id that should be
 	// used for allocating the virtual machine.
 	// The field size should be greater than 0 and the field input must start with '/'.
@@ -151,6 +158,51 @@ type AzureMachineSpec struct {
 	// It is optional but may not be changed once set.
 	// +optional
 	CapacityReservationGroupID *string `json:"capacityReservationGroupID,omitempty"`
+
+	// ProximityPlacementGroupID is the resource ID of an existing proximity placement group to place the virtual
+	// machine in. Mutually exclusive with ProximityPlacementGroup.
+	// It is optional but may not be changed once set.
+	// +optional
+	ProximityPlacementGroupID *string `json:"proximityPlacementGroupID,omitempty"`
+
+	// ProximityPlacementGroup configures a proximity placement group that is created and owned by CAPZ and that
+	// the virtual machine is placed in. Machines that configure the same name share the proximity placement group.
+	// As a proximity placement group can't span availability zones, machines in a failure domain use a separate
+	// proximity placement group per failure domain, whose name is suffixed with the failure domain.
+	// When availability sets are used, the availability set is created in the proximity placement group.
+	// The proximity placement group is deleted along with the machine unless other VMs, scale sets or
+	// availability sets still belong to it. Mutually exclusive with ProximityPlacementGroupID.
+	// It is optional but may not be changed once set.
+	// +optional
+	ProximityPlacementGroup *ProximityPlacementGroup `json:"proximityPlacementGroup,omitempty"`
+
+	// HostGroupID is the resource ID of a dedicated host group to place the virtual machine in. The host group
+	// must have automatic placement enabled. Mutually exclusive with HostID.
+	// Virtual machines on dedicated hosts can't be in an availability set or be Spot VMs.
+	// It is optional but may not be changed once set.
+	// +optional
+	HostGroupID *string `json:"hostGroupID,omitempty"`
+
+	// HostID is the resource ID of the dedicated host to place the virtual machine on. Mutually exclusive with HostGroupID.
+	// Virtual machines on dedicated hosts can't be in an availability set or be Spot VMs.
+	// It is optional but may not be changed once set.
+	// +optional
+	HostID *string `json:"hostID,omitempty"`
+
+	// BootstrapTransport specifies how the bootstrap data is delivered to the VM.
+	// By default it is passed as custom data, which is limited to 64KB and can't be read back from the VM.
+	// It can instead be passed as user data, which is readable from the Instance Metadata Service, or larger
+	// bootstrap data can be stored in an Azure Storage blob or Key Vault secret that the VM fetches during boot
+	// using its managed identity.
+	// It is optional but may not be changed once set.
+	// +optional
+	BootstrapTransport *BootstrapTransport `json:"bootstrapTransport,omitempty"`
+
+	// BootstrapCheck configures the VM extension that reports whether the VM has bootstrapped successfully,
+	// or disables it for images that report their readiness in another way.
+	// It is optional but may not be changed once set.
+	// +optional
+	BootstrapCheck *BootstrapCheck `json:"bootstrapCheck,omitempty"`
 }
 
 // SpotVMOptions defines the options relevant to running the Machine on Spot VMs.
@@ -242,6 +294,31 @@ type AzureMachineStatus struct {
 	// next reconciliation loop.
 	// +optional
 	LongRunningOperationStates Futures `json:"longRunningOperationStates,omitempty"`
+
+	// BootstrapDiagnostics references the diagnostics collected from the VM the last time bootstrapping failed.
+	// +optional
+	BootstrapDiagnostics *BootstrapDiagnostics `json:"bootstrapDiagnostics,omitempty"`
+}
+
+const (
+	// BootstrapDiagnosticsSerialConsoleLogKey is the key of the VM's serial console log in the bootstrap diagnostics Secret.
+	BootstrapDiagnosticsSerialConsoleLogKey = "serialConsoleLog"
+	// BootstrapDiagnosticsExtensionStatusKey is the key of the VM extension status in the bootstrap diagnostics Secret.
+	BootstrapDiagnosticsExtensionStatusKey = "extensionStatus"
+)
+
+// BootstrapDiagnostics references the diagnostics collected from a VM whose bootstrapping failed.
+type BootstrapDiagnostics struct {
+	// SecretName is the name of the Secret in the AzureMachine's namespace that holds a truncated copy of the VM's
+	// serial console log and the status of its VM extensions.
+	SecretName string `json:"secretName"`
+
+	// CollectionTime is the time the diagnostics were collected.
+	CollectionTime metav1.Time `json:"collectionTime"`
+
+	// FailureMessage is the message of the bootstrap failure the diagnostics were collected for.
+	// +optional
+	FailureMessage string `json:"failureMessage,omitempty"`
 }
 
 // AdditionalCapabilities enables or disables a capability on the virtual machine.
@@ -19,6 +19,8 @@ package v1beta1
 import (
 	"encoding/base64"
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/google/uuid"
@@ -71,6 +73,22 @@ func ValidateAzureMachineSpec(spec AzureMachineSpec) field.ErrorList {
 		allErrs = append(allErrs, errs...)
 	}
 
+	if errs := ValidateVMPlacement(spec.ProximityPlacementGroupID, spec.ProximityPlacementGroup, spec.HostGroupID, spec.HostID, spec.SpotVMOptions, field.NewPath("spec")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := validateApplicationSecurityGroupReferences(spec.ApplicationSecurityGroups, field.NewPath("spec", "applicationSecurityGroups")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := ValidateBootstrapTransport(spec.BootstrapTransport, spec.OSDisk.OSType, spec.Identity, field.NewPath("bootstrapTransport")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := ValidateBootstrapCheck(spec.BootstrapCheck, field.NewPath("bootstrapCheck")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
 	return allErrs
 }
 
@@ -175,9 +193,17 @@ func ValidateDataDisks(dataDisks []DataDisk, fieldPath *field.Path) field.ErrorL
 	lunSet := make(map[int32]struct{})
 	nameSet := make(map[string]struct{})
 	for _, disk := range dataDisks {
-		// validate that the disk size is between 4 and 32767.
-		if disk.DiskSizeGB < 4 || disk.DiskSizeGB > 32767 {
-			allErrs = append(allErrs, field.Invalid(fieldPath.Child("DiskSizeGB"), "", "the disk size should be a value between 4 and 32767"))
+		if disk.ManagedDiskID != nil {
+			allErrs = append(allErrs, validateExistingDataDisk(disk, fieldPath)...)
+		} else {
+			// validate that the disk size is between 4 and 32767.
+			if disk.DiskSizeGB < 4 || disk.DiskSizeGB > 32767 {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Child("DiskSizeGB"), "", "the disk size should be a value between 4 and 32767"))
+			}
+
+			if disk.MaxShares != nil {
+				allErrs = append(allErrs, field.Forbidden(fieldPath.Child("MaxShares"), "can only be set when attaching an existing disk with ManagedDiskID"))
+			}
 		}
 
 		// validate that all names are unique
@@ -214,6 +240,27 @@ func ValidateDataDisks(dataDisks []DataDisk, fieldPath *field.Path) field.ErrorL
 	return allErrs
 }
 
+// validateExistingDataDisk validates a data disk that attaches an existing managed disk.
+func validateExistingDataDisk(disk DataDisk, fieldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	resourceID, err := azureutil.ParseResourceID(*disk.ManagedDiskID)
+	if err != nil || !strings.EqualFold(resourceID.ResourceType.String(), "Microsoft.Compute/disks") {
+		allErrs = append(allErrs, field.Invalid(fieldPath.Child("ManagedDiskID"), *disk.ManagedDiskID, "must be a valid Azure managed disk resource ID"))
+	}
+	if disk.DiskSizeGB != 0 {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("DiskSizeGB"), "cannot be set when attaching an existing disk with ManagedDiskID"))
+	}
+	if disk.ManagedDisk != nil {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("ManagedDisk"), "cannot be set when attaching an existing disk with ManagedDiskID"))
+	}
+	if disk.DeletionPolicy != "" {
+		allErrs = append(allErrs, field.Forbidden(fieldPath.Child("DeletionPolicy"), "cannot be set when attaching an existing disk with ManagedDiskID, existing disks are never deleted"))
+	}
+
+	return allErrs
+}
+
 // ValidateOSDisk validates the OSDisk spec.
 func ValidateOSDisk(osDisk OSDisk, fieldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -290,8 +337,8 @@ func ValidateDataDisksUpdate(oldDataDisks, newDataDisks []DataDisk, fieldPath *f
 
 	for i, newDisk := range newDataDisks {
 		if oldDisk, ok := oldDisks[newDisk.NameSuffix]; ok {
-			if newDisk.DiskSizeGB != oldDisk.DiskSizeGB {
-				allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("diskSizeGB"), newDataDisks, fieldErrMsg))
+			if newDisk.DiskSizeGB < oldDisk.DiskSizeGB {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("diskSizeGB"), newDataDisks, "data disks can only be expanded, not shrunk"))
 			}
 
 			allErrs = append(allErrs, validateManagedDisksUpdate(oldDisk.ManagedDisk, newDisk.ManagedDisk, fieldPath.Index(i).Child("managedDisk"))...)
@@ -305,6 +352,14 @@ func ValidateDataDisksUpdate(oldDataDisks, newDataDisks []DataDisk, fieldPath *f
 			if newDisk.CachingType != oldDisk.CachingType {
 				allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("cachingType"), newDataDisks, fieldErrMsg))
 			}
+
+			if !reflect.DeepEqual(newDisk.ManagedDiskID, oldDisk.ManagedDiskID) {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("managedDiskID"), newDataDisks, fieldErrMsg))
+			}
+
+			if !reflect.DeepEqual(newDisk.MaxShares, oldDisk.MaxShares) {
+				allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("maxShares"), newDataDisks, fieldErrMsg))
+			}
 		} else {
 			allErrs = append(allErrs, field.Invalid(fieldPath.Index(i).Child("nameSuffix"), newDataDisks, diskErrMsg))
 		}
@@ -471,3 +526,109 @@ func ValidateCapacityReservationGroupID(capacityReservationGroupID *string, fldP
 
 	return allErrs
 }
+
+// ValidateVMPlacement validates the proximity placement group and dedicated host references of a virtual machine.
+func ValidateVMPlacement(proximityPlacementGroupID *string, proximityPlacementGroup *ProximityPlacementGroup, hostGroupID, hostID *string, spotVMOptions *SpotVMOptions, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if proximityPlacementGroupID != nil && !validProximityPlacementGroupID.MatchString(*proximityPlacementGroupID) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("proximityPlacementGroupID"), proximityPlacementGroupID,
+			fmt.Sprintf("resource ID must match %q", validProximityPlacementGroupID.String())))
+	}
+	if proximityPlacementGroupID != nil && proximityPlacementGroup != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("proximityPlacementGroup"), "cannot be set together with proximityPlacementGroupID"))
+	}
+
+	if hostGroupID != nil && !validHostGroupID.MatchString(*hostGroupID) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("hostGroupID"), hostGroupID,
+			fmt.Sprintf("resource ID must match %q", validHostGroupID.String())))
+	}
+	if hostID != nil && !validHostID.MatchString(*hostID) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("hostID"), hostID,
+			fmt.Sprintf("resource ID must match %q", validHostID.String())))
+	}
+	if hostGroupID != nil && hostID != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("hostID"), "cannot be set together with hostGroupID"))
+	}
+	if (hostGroupID != nil || hostID != nil) && spotVMOptions != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("spotVMOptions"), "Spot VMs are not supported on dedicated hosts"))
+	}
+
+	return allErrs
+}
+
+// ValidateBootstrapTransport validates the bootstrap transport.
+func ValidateBootstrapTransport(transport *BootstrapTransport, osType string, identity VMIdentity, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if transport == nil {
+		return allErrs
+	}
+
+	if transport.Type == BootstrapTransportStorageBlob {
+		if transport.StorageBlob == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("storageBlob"), "storageBlob is required when type is StorageBlob"))
+		}
+	} else if transport.StorageBlob != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("storageBlob"), "storageBlob can only be set when type is StorageBlob"))
+	}
+
+	if transport.Type == BootstrapTransportKeyVaultSecret {
+		if transport.KeyVaultSecret == nil {
+			allErrs = append(allErrs, field.Required(fldPath.Child("keyVaultSecret"), "keyVaultSecret is required when type is KeyVaultSecret"))
+		}
+	} else if transport.KeyVaultSecret != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("keyVaultSecret"), "keyVaultSecret can only be set when type is KeyVaultSecret"))
+	}
+
+	if transport.Type != "" && transport.Type != BootstrapTransportCustomData && osType == WindowsOS {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("type"), "only the CustomData bootstrap transport is supported for Windows machines"))
+	}
+
+	if transport.Type == BootstrapTransportStorageBlob || transport.Type == BootstrapTransportKeyVaultSecret {
+		if identity != VMIdentitySystemAssigned && identity != VMIdentityUserAssigned {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("type"), "a system-assigned or user-assigned identity is required for the VM to fetch its bootstrap data"))
+		}
+	}
+
+	return allErrs
+}
+
+// ValidateBootstrapCheck validates the bootstrap check configuration.
+func ValidateBootstrapCheck(check *BootstrapCheck, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if check == nil {
+		return allErrs
+	}
+
+	if check.Disabled {
+		if check.Command != "" || check.Timeout != nil || check.Extension != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath, "command, timeout and extension can't be set when the bootstrap check is disabled"))
+		}
+		return allErrs
+	}
+
+	if check.Command != "" && check.Timeout != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("timeout"), "timeout can't be set together with command"))
+	}
+
+	if check.Timeout != nil && (check.Timeout.Duration <= 0 || check.Timeout.Duration > MaxBootstrapCheckTimeout) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("timeout"), check.Timeout.Duration.String(),
+			fmt.Sprintf("timeout must be greater than 0 and at most %s", MaxBootstrapCheckTimeout)))
+	}
+
+	if check.Extension != nil {
+		if check.Extension.Publisher == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("extension", "publisher"), "publisher is required"))
+		}
+		if check.Extension.Type == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("extension", "type"), "type is required"))
+		}
+		if check.Extension.Version == "" {
+			allErrs = append(allErrs, field.Required(fldPath.Child("extension", "version"), "version is required"))
+		}
+	}
+
+	return allErrs
+}
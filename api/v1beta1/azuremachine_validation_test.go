@@ -22,11 +22,13 @@ import (
 	"encoding/base64"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/google/uuid"
 	. "github.com/onsi/gomega"
 	"golang.org/x/crypto/ssh"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 )
@@ -294,6 +296,92 @@ func TestAzureMachine_ValidateDataDisks(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "valid existing managed disk",
+			disks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk"),
+					Lun:           ptr.To[int32](0),
+					CachingType:   string(armcompute.PossibleCachingTypesValues()[0]),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid shared existing managed disk",
+			disks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk"),
+					MaxShares:     ptr.To[int32](2),
+					Lun:           ptr.To[int32](0),
+					CachingType:   "None",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid existing managed disk ID",
+			disks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet"),
+					Lun:           ptr.To[int32](0),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing managed disk with a disk size",
+			disks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk"),
+					DiskSizeGB:    64,
+					Lun:           ptr.To[int32](0),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing managed disk with managed disk parameters",
+			disks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk"),
+					Lun:           ptr.To[int32](0),
+					ManagedDisk: &ManagedDiskParameters{
+						StorageAccountType: "Premium_LRS",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "existing managed disk with a deletion policy",
+			disks: []DataDisk{
+				{
+					NameSuffix:     "my_disk",
+					ManagedDiskID:  ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk"),
+					Lun:            ptr.To[int32](0),
+					DeletionPolicy: DiskDeletionPolicyRetain,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "max shares without an existing managed disk",
+			disks: []DataDisk{
+				{
+					NameSuffix: "my_disk",
+					DiskSizeGB: 64,
+					MaxShares:  ptr.To[int32](2),
+					Lun:        ptr.To[int32](0),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "invalid disk size",
 			disks: []DataDisk{
@@ -732,6 +820,43 @@ func TestAzureMachine_ValidateDataDisksUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "data disks can be expanded and their deletion policy changed",
+			disks: []DataDisk{
+				{
+					NameSuffix:     "my_disk_1",
+					DiskSizeGB:     256,
+					Lun:            ptr.To[int32](0),
+					DeletionPolicy: DiskDeletionPolicySnapshot,
+				},
+			},
+			oldDisks: []DataDisk{
+				{
+					NameSuffix: "my_disk_1",
+					DiskSizeGB: 128,
+					Lun:        ptr.To[int32](0),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "data disks cannot be shrunk",
+			disks: []DataDisk{
+				{
+					NameSuffix: "my_disk_1",
+					DiskSizeGB: 64,
+					Lun:        ptr.To[int32](0),
+				},
+			},
+			oldDisks: []DataDisk{
+				{
+					NameSuffix: "my_disk_1",
+					DiskSizeGB: 128,
+					Lun:        ptr.To[int32](0),
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "validate updates to optional fields",
 			disks: []DataDisk{
@@ -823,6 +948,44 @@ func TestAzureMachine_ValidateDataDisksUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "cannot change the existing managed disk",
+			disks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-other-disk"),
+					Lun:           ptr.To[int32](0),
+				},
+			},
+			oldDisks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk"),
+					Lun:           ptr.To[int32](0),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "cannot change max shares",
+			disks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk"),
+					MaxShares:     ptr.To[int32](3),
+					Lun:           ptr.To[int32](0),
+				},
+			},
+			oldDisks: []DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk"),
+					MaxShares:     ptr.To[int32](2),
+					Lun:           ptr.To[int32](0),
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, test := range tests {
@@ -1111,3 +1274,331 @@ func TestAzureMachine_ValidateConfidentialCompute(t *testing.T) {
 		})
 	}
 }
+
+func TestAzureMachine_ValidateBootstrapTransport(t *testing.T) {
+	tests := []struct {
+		name      string
+		transport *BootstrapTransport
+		osType    string
+		identity  VMIdentity
+		wantErr   bool
+	}{
+		{
+			name:      "valid without a bootstrap transport",
+			transport: nil,
+			osType:    LinuxOS,
+			identity:  VMIdentityNone,
+			wantErr:   false,
+		},
+		{
+			name:      "valid custom data transport without an identity",
+			transport: &BootstrapTransport{Type: BootstrapTransportCustomData},
+			osType:    WindowsOS,
+			identity:  VMIdentityNone,
+			wantErr:   false,
+		},
+		{
+			name: "valid storage blob transport",
+			transport: &BootstrapTransport{
+				Type:        BootstrapTransportStorageBlob,
+				StorageBlob: &StorageBlobBootstrapTransport{StorageAccountName: "mystorageaccount"},
+			},
+			osType:   LinuxOS,
+			identity: VMIdentitySystemAssigned,
+			wantErr:  false,
+		},
+		{
+			name: "valid key vault secret transport",
+			transport: &BootstrapTransport{
+				Type:           BootstrapTransportKeyVaultSecret,
+				KeyVaultSecret: &KeyVaultSecretBootstrapTransport{VaultName: "my-vault"},
+			},
+			osType:   LinuxOS,
+			identity: VMIdentityUserAssigned,
+			wantErr:  false,
+		},
+		{
+			name:      "valid user data transport without an identity",
+			transport: &BootstrapTransport{Type: BootstrapTransportUserData},
+			osType:    LinuxOS,
+			identity:  VMIdentityNone,
+			wantErr:   false,
+		},
+		{
+			name:      "invalid user data transport for a Windows machine",
+			transport: &BootstrapTransport{Type: BootstrapTransportUserData},
+			osType:    WindowsOS,
+			identity:  VMIdentityNone,
+			wantErr:   true,
+		},
+		{
+			name:      "invalid storage blob transport without storageBlob",
+			transport: &BootstrapTransport{Type: BootstrapTransportStorageBlob},
+			osType:    LinuxOS,
+			identity:  VMIdentitySystemAssigned,
+			wantErr:   true,
+		},
+		{
+			name: "invalid custom data transport with keyVaultSecret",
+			transport: &BootstrapTransport{
+				Type:           BootstrapTransportCustomData,
+				KeyVaultSecret: &KeyVaultSecretBootstrapTransport{VaultName: "my-vault"},
+			},
+			osType:   LinuxOS,
+			identity: VMIdentitySystemAssigned,
+			wantErr:  true,
+		},
+		{
+			name: "invalid key vault secret transport with storageBlob",
+			transport: &BootstrapTransport{
+				Type:           BootstrapTransportKeyVaultSecret,
+				StorageBlob:    &StorageBlobBootstrapTransport{StorageAccountName: "mystorageaccount"},
+				KeyVaultSecret: &KeyVaultSecretBootstrapTransport{VaultName: "my-vault"},
+			},
+			osType:   LinuxOS,
+			identity: VMIdentitySystemAssigned,
+			wantErr:  true,
+		},
+		{
+			name: "invalid storage blob transport for a Windows machine",
+			transport: &BootstrapTransport{
+				Type:        BootstrapTransportStorageBlob,
+				StorageBlob: &StorageBlobBootstrapTransport{StorageAccountName: "mystorageaccount"},
+			},
+			osType:   WindowsOS,
+			identity: VMIdentitySystemAssigned,
+			wantErr:  true,
+		},
+		{
+			name: "invalid key vault secret transport without an identity",
+			transport: &BootstrapTransport{
+				Type:           BootstrapTransportKeyVaultSecret,
+				KeyVaultSecret: &KeyVaultSecretBootstrapTransport{VaultName: "my-vault"},
+			},
+			osType:   LinuxOS,
+			identity: VMIdentityNone,
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateBootstrapTransport(tc.transport, tc.osType, tc.identity, field.NewPath("bootstrapTransport"))
+			if tc.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestAzureMachine_ValidateBootstrapCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		check   *BootstrapCheck
+		wantErr bool
+	}{
+		{
+			name:    "valid without a bootstrap check",
+			check:   nil,
+			wantErr: false,
+		},
+		{
+			name:    "valid disabled bootstrap check",
+			check:   &BootstrapCheck{Disabled: true},
+			wantErr: false,
+		},
+		{
+			name:    "valid custom timeout",
+			check:   &BootstrapCheck{Timeout: &metav1.Duration{Duration: 20 * time.Minute}},
+			wantErr: false,
+		},
+		{
+			name: "valid custom command and extension",
+			check: &BootstrapCheck{
+				Command: "systemctl is-active my-image-ready.target",
+				Extension: &BootstrapCheckExtension{
+					Publisher: "Microsoft.Azure.Extensions",
+					Type:      "CustomScript",
+					Version:   "2.1",
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name:    "invalid disabled bootstrap check with a command",
+			check:   &BootstrapCheck{Disabled: true, Command: "true"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid command with a timeout",
+			check:   &BootstrapCheck{Command: "true", Timeout: &metav1.Duration{Duration: time.Minute}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid zero timeout",
+			check:   &BootstrapCheck{Timeout: &metav1.Duration{}},
+			wantErr: true,
+		},
+		{
+			name:    "invalid timeout longer than the VM extension limit",
+			check:   &BootstrapCheck{Timeout: &metav1.Duration{Duration: 2 * time.Hour}},
+			wantErr: true,
+		},
+		{
+			name: "invalid extension without a type",
+			check: &BootstrapCheck{
+				Extension: &BootstrapCheckExtension{
+					Publisher: "Microsoft.Azure.Extensions",
+					Version:   "2.1",
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateBootstrapCheck(tc.check, field.NewPath("bootstrapCheck"))
+			if tc.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestAzureMachine_ValidateVMPlacement(t *testing.T) {
+	const (
+		ppgID       = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.Compute/proximityPlacementGroups/my-ppg"
+		hostGroupID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.Compute/hostGroups/my-hg"
+		hostID      = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.Compute/hostGroups/my-hg/hosts/my-host"
+	)
+	tests := []struct {
+		name                      string
+		proximityPlacementGroupID *string
+		proximityPlacementGroup   *ProximityPlacementGroup
+		hostGroupID               *string
+		hostID                    *string
+		spotVMOptions             *SpotVMOptions
+		wantErr                   bool
+	}{
+		{
+			name:    "valid without placement",
+			wantErr: false,
+		},
+		{
+			name:                      "valid proximity placement group ID and host group ID",
+			proximityPlacementGroupID: ptr.To(ppgID),
+			hostGroupID:               ptr.To(hostGroupID),
+			wantErr:                   false,
+		},
+		{
+			name:                    "valid managed proximity placement group and host ID",
+			proximityPlacementGroup: &ProximityPlacementGroup{Name: "my-ppg"},
+			hostID:                  ptr.To(hostID),
+			wantErr:                 false,
+		},
+		{
+			name:          "valid Spot VM without dedicated host",
+			spotVMOptions: &SpotVMOptions{},
+			wantErr:       false,
+		},
+		{
+			name:                      "invalid proximity placement group ID",
+			proximityPlacementGroupID: ptr.To(hostGroupID),
+			wantErr:                   true,
+		},
+		{
+			name:                      "invalid proximity placement group ID together with a managed proximity placement group",
+			proximityPlacementGroupID: ptr.To(ppgID),
+			proximityPlacementGroup:   &ProximityPlacementGroup{Name: "my-ppg"},
+			wantErr:                   true,
+		},
+		{
+			name:        "invalid host group ID",
+			hostGroupID: ptr.To(hostID),
+			wantErr:     true,
+		},
+		{
+			name:    "invalid host ID",
+			hostID:  ptr.To(hostGroupID),
+			wantErr: true,
+		},
+		{
+			name:        "invalid host group ID together with host ID",
+			hostGroupID: ptr.To(hostGroupID),
+			hostID:      ptr.To(hostID),
+			wantErr:     true,
+		},
+		{
+			name:          "invalid Spot VM on a dedicated host",
+			hostGroupID:   ptr.To(hostGroupID),
+			spotVMOptions: &SpotVMOptions{},
+			wantErr:       true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := ValidateVMPlacement(tc.proximityPlacementGroupID, tc.proximityPlacementGroup, tc.hostGroupID, tc.hostID, tc.spotVMOptions, field.NewPath("spec"))
+			if tc.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestAzureMachine_ValidateApplicationSecurityGroups(t *testing.T) {
+	tests := []struct {
+		name                      string
+		applicationSecurityGroups []string
+		wantErr                   bool
+	}{
+		{
+			name:    "valid without application security groups",
+			wantErr: false,
+		},
+		{
+			name: "valid application security group names and IDs",
+			applicationSecurityGroups: []string{
+				"web",
+				"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.Network/applicationSecurityGroups/db",
+			},
+			wantErr: false,
+		},
+		{
+			name:                      "invalid application security group name",
+			applicationSecurityGroups: []string{"web!"},
+			wantErr:                   true,
+		},
+		{
+			name:                      "invalid application security group ID",
+			applicationSecurityGroups: []string{"/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/db"},
+			wantErr:                   true,
+		},
+		{
+			name:                      "duplicate application security groups",
+			applicationSecurityGroups: []string{"web", "WEB"},
+			wantErr:                   true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			err := validateApplicationSecurityGroupReferences(tc.applicationSecurityGroups, field.NewPath("spec", "applicationSecurityGroups"))
+			if tc.wantErr {
+				g.Expect(err).NotTo(BeEmpty())
+			} else {
+				g.Expect(err).To(BeEmpty())
+			}
+		})
+	}
+}
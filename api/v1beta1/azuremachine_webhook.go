@@ -127,11 +127,9 @@ func (mw *azureMachineWebhook) ValidateUpdate(ctx context.Context, oldObj, newOb
 		allErrs = append(allErrs, err)
 	}
 
-	if err := webhookutils.ValidateImmutable(
-		field.NewPath("Spec", "DataDisks"),
-		old.Spec.DataDisks,
-		m.Spec.DataDisks); err != nil {
-		allErrs = append(allErrs, err)
+	// data disks are immutable, except that they can be expanded and their deletion policy changed.
+	if errs := ValidateDataDisksUpdate(old.Spec.DataDisks, m.Spec.DataDisks, field.NewPath("Spec", "DataDisks")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
 	}
 
 	if err := webhookutils.ValidateImmutable(
@@ -206,6 +204,13 @@ func (mw *azureMachineWebhook) ValidateUpdate(ctx context.Context, oldObj, newOb
 		}
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "applicationSecurityGroups"),
+		old.Spec.ApplicationSecurityGroups,
+		m.Spec.ApplicationSecurityGroups); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("spec", "capacityReservationGroupID"),
 		old.Spec.CapacityReservationGroupID,
@@ -213,6 +218,48 @@ func (mw *azureMachineWebhook) ValidateUpdate(ctx context.Context, oldObj, newOb
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "proximityPlacementGroupID"),
+		old.Spec.ProximityPlacementGroupID,
+		m.Spec.ProximityPlacementGroupID); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "proximityPlacementGroup"),
+		old.Spec.ProximityPlacementGroup,
+		m.Spec.ProximityPlacementGroup); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "hostGroupID"),
+		old.Spec.HostGroupID,
+		m.Spec.HostGroupID); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "hostID"),
+		old.Spec.HostID,
+		m.Spec.HostID); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "bootstrapTransport"),
+		old.Spec.BootstrapTransport,
+		m.Spec.BootstrapTransport); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("spec", "bootstrapCheck"),
+		old.Spec.BootstrapCheck,
+		m.Spec.BootstrapCheck); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if len(allErrs) == 0 {
 		return nil, nil
 	}
@@ -504,6 +504,28 @@ func TestAzureMachine_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "validTest: azuremachine.spec.DataDisks can be expanded",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					DataDisks: []DataDisk{
+						{
+							DiskSizeGB: 128,
+						},
+					},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					DataDisks: []DataDisk{
+						{
+							DiskSizeGB: 256,
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalidTest: azuremachine.spec.SSHPublicKey is immutable",
 			oldMachine: &AzureMachine{
@@ -820,6 +842,34 @@ func TestAzureMachine_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "invalidTest: azuremachine.spec.applicationSecurityGroups is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					ApplicationSecurityGroups: []string{"web"},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					ApplicationSecurityGroups: []string{"web", "db"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "validTest: azuremachine.spec.applicationSecurityGroups is unchanged",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					ApplicationSecurityGroups: []string{"web"},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					ApplicationSecurityGroups: []string{"web"},
+				},
+			},
+			wantErr: false,
+		},
 		{
 			name: "invalidTest: azuremachine.spec.capacityReservationGroupID is immutable",
 			oldMachine: &AzureMachine{
@@ -876,6 +926,141 @@ func TestAzureMachine_ValidateUpdate(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "invalidTest: azuremachine.spec.proximityPlacementGroupID is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					ProximityPlacementGroupID: nil,
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					ProximityPlacementGroupID: ptr.To("proximityPlacementGroupID-1"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalidTest: azuremachine.spec.proximityPlacementGroup is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					ProximityPlacementGroup: &ProximityPlacementGroup{Name: "ppg-1"},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					ProximityPlacementGroup: &ProximityPlacementGroup{Name: "ppg-2"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalidTest: azuremachine.spec.hostGroupID is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					HostGroupID: ptr.To("hostGroupID-1"),
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					HostGroupID: nil,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalidTest: azuremachine.spec.hostID is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					HostID: ptr.To("hostID-1"),
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					HostID: ptr.To("hostID-2"),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "validTest: azuremachine.spec placement is unchanged",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					ProximityPlacementGroup: &ProximityPlacementGroup{Name: "ppg-1"},
+					HostGroupID:             ptr.To("hostGroupID-1"),
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					ProximityPlacementGroup: &ProximityPlacementGroup{Name: "ppg-1"},
+					HostGroupID:             ptr.To("hostGroupID-1"),
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalidTest: azuremachine.spec.bootstrapTransport is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					BootstrapTransport: &BootstrapTransport{Type: BootstrapTransportCustomData},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					BootstrapTransport: &BootstrapTransport{
+						Type:           BootstrapTransportKeyVaultSecret,
+						KeyVaultSecret: &KeyVaultSecretBootstrapTransport{VaultName: "my-vault"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "validTest: azuremachine.spec.bootstrapTransport is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					BootstrapTransport: &BootstrapTransport{
+						Type:           BootstrapTransportKeyVaultSecret,
+						KeyVaultSecret: &KeyVaultSecretBootstrapTransport{VaultName: "my-vault"},
+					},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					BootstrapTransport: &BootstrapTransport{
+						Type:           BootstrapTransportKeyVaultSecret,
+						KeyVaultSecret: &KeyVaultSecretBootstrapTransport{VaultName: "my-vault"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalidTest: azuremachine.spec.bootstrapCheck is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					BootstrapCheck: &BootstrapCheck{Disabled: true},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "validTest: azuremachine.spec.bootstrapCheck is immutable",
+			oldMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					BootstrapCheck: &BootstrapCheck{Disabled: true},
+				},
+			},
+			newMachine: &AzureMachine{
+				Spec: AzureMachineSpec{
+					BootstrapCheck: &BootstrapCheck{Disabled: true},
+				},
+			},
+			wantErr: false,
+		},
 	}
 
 	for _, tc := range tests {
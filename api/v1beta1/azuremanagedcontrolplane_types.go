@@ -264,6 +264,255 @@ type AzureKeyVaultKms struct {
 	KeyVaultResourceID *string `json:"keyVaultResourceID,omitempty"`
 }
 
+// ManagedClusterWorkloadAutoScalerProfile defines the workload autoscaler profile for a managed cluster.
+type ManagedClusterWorkloadAutoScalerProfile struct {
+	// Keda defines the Kubernetes Event-driven Autoscaling (KEDA) settings for the workload autoscaler profile.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/keda-about
+	// +optional
+	Keda *ManagedClusterWorkloadAutoScalerProfileKeda `json:"keda,omitempty"`
+
+	// VerticalPodAutoscaler defines the Vertical Pod Autoscaler (VPA) settings for the workload autoscaler profile.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/vertical-pod-autoscaler
+	// +optional
+	VerticalPodAutoscaler *ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler `json:"verticalPodAutoscaler,omitempty"`
+}
+
+// ManagedClusterWorkloadAutoScalerProfileKeda defines the KEDA settings for the workload autoscaler profile.
+type ManagedClusterWorkloadAutoScalerProfileKeda struct {
+	// Enabled enables KEDA.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
+// ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler defines the VPA settings for the workload autoscaler profile.
+type ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler struct {
+	// Enabled enables VPA.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
+// ManagedClusterAzureMonitorProfile defines the Azure Monitor add-on profiles for monitoring the managed cluster.
+type ManagedClusterAzureMonitorProfile struct {
+	// Metrics defines the metrics profile for the Azure Monitor managed service for Prometheus add-on.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://aka.ms/AzureManagedPrometheus
+	// +optional
+	Metrics *ManagedClusterAzureMonitorProfileMetrics `json:"metrics,omitempty"`
+}
+
+// ManagedClusterAzureMonitorProfileMetrics defines the metrics profile for the Azure Monitor managed service for Prometheus add-on.
+type ManagedClusterAzureMonitorProfileMetrics struct {
+	// Enabled enables the Prometheus collector.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// KubeStateMetrics defines the kube-state-metrics settings for the Azure Managed Prometheus add-on.
+	// +optional
+	KubeStateMetrics *ManagedClusterAzureMonitorProfileKubeStateMetrics `json:"kubeStateMetrics,omitempty"`
+}
+
+// ManagedClusterAzureMonitorProfileKubeStateMetrics defines the kube-state-metrics settings for the Azure Managed Prometheus add-on.
+// See also [AKS doc].
+//
+// [AKS doc]: https://aka.ms/AzureManagedPrometheus-optional-parameters
+type ManagedClusterAzureMonitorProfileKubeStateMetrics struct {
+	// MetricAnnotationsAllowList is a comma-separated list of Kubernetes annotation keys that will be used in the resource's
+	// labels metric, e.g. "namespaces=[kubernetes.io/team,...],pods=[kubernetes.io/team],...". By default the metric
+	// contains only resource name and namespace labels.
+	// +optional
+	MetricAnnotationsAllowList *string `json:"metricAnnotationsAllowList,omitempty"`
+
+	// MetricLabelsAllowlist is a comma-separated list of additional Kubernetes label keys that will be used in the resource's
+	// labels metric, e.g. "namespaces=[k8s-label-1,k8s-label-n,...],pods=[app],...". By default the metric contains only
+	// resource name and namespace labels.
+	// +optional
+	MetricLabelsAllowlist *string `json:"metricLabelsAllowlist,omitempty"`
+}
+
+// ManagedClusterStorageProfile defines the storage profile for a managed cluster.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/azure/aks/csi-storage-drivers
+type ManagedClusterStorageProfile struct {
+	// BlobCSIDriver defines the AzureBlob CSI Driver settings for the storage profile.
+	// +optional
+	BlobCSIDriver *ManagedClusterStorageProfileBlobCSIDriver `json:"blobCSIDriver,omitempty"`
+
+	// DiskCSIDriver defines the AzureDisk CSI Driver settings for the storage profile.
+	// +optional
+	DiskCSIDriver *ManagedClusterStorageProfileDiskCSIDriver `json:"diskCSIDriver,omitempty"`
+
+	// FileCSIDriver defines the AzureFile CSI Driver settings for the storage profile.
+	// +optional
+	FileCSIDriver *ManagedClusterStorageProfileFileCSIDriver `json:"fileCSIDriver,omitempty"`
+
+	// SnapshotController defines the Snapshot Controller settings for the storage profile.
+	// +optional
+	SnapshotController *ManagedClusterStorageProfileSnapshotController `json:"snapshotController,omitempty"`
+}
+
+// ManagedClusterStorageProfileBlobCSIDriver defines the AzureBlob CSI Driver settings for the storage profile.
+type ManagedClusterStorageProfileBlobCSIDriver struct {
+	// Enabled enables the AzureBlob CSI Driver. The default value is false.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
+// ManagedClusterStorageProfileDiskCSIDriver defines the AzureDisk CSI Driver settings for the storage profile.
+type ManagedClusterStorageProfileDiskCSIDriver struct {
+	// Enabled enables the AzureDisk CSI Driver. The default value is true.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
+// ManagedClusterStorageProfileFileCSIDriver defines the AzureFile CSI Driver settings for the storage profile.
+type ManagedClusterStorageProfileFileCSIDriver struct {
+	// Enabled enables the AzureFile CSI Driver. The default value is true.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
+// ManagedClusterStorageProfileSnapshotController defines the Snapshot Controller settings for the storage profile.
+type ManagedClusterStorageProfileSnapshotController struct {
+	// Enabled enables the Snapshot Controller. The default value is true.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
+// ServiceMeshMode is the mode of the service mesh.
+type ServiceMeshMode string
+
+const (
+	// ServiceMeshModeIstio enables the Istio-based service mesh add-on.
+	ServiceMeshModeIstio ServiceMeshMode = "Istio"
+
+	// ServiceMeshModeDisabled disables the service mesh add-on.
+	ServiceMeshModeDisabled ServiceMeshMode = "Disabled"
+)
+
+// IstioIngressGatewayMode is the mode of an Istio ingress gateway.
+type IstioIngressGatewayMode string
+
+const (
+	// IstioIngressGatewayModeExternal exposes the ingress gateway through a public IP address.
+	IstioIngressGatewayModeExternal IstioIngressGatewayMode = "External"
+
+	// IstioIngressGatewayModeInternal exposes the ingress gateway through a private IP address.
+	IstioIngressGatewayModeInternal IstioIngressGatewayMode = "Internal"
+)
+
+// ServiceMeshProfile defines the service mesh profile for a managed cluster.
+// See also [AKS doc].
+//
+// [AKS doc]: https://learn.microsoft.com/azure/aks/istio-about
+type ServiceMeshProfile struct {
+	// Mode is the mode of the service mesh.
+	// To disable a mesh that was previously enabled, set the mode to Disabled.
+	// +kubebuilder:validation:Enum=Istio;Disabled
+	// +kubebuilder:validation:Required
+	Mode ServiceMeshMode `json:"mode"`
+
+	// Istio is the Istio service mesh configuration. Only used when Mode is Istio.
+	// +optional
+	Istio *IstioServiceMesh `json:"istio,omitempty"`
+}
+
+// IstioServiceMesh defines the Istio service mesh configuration.
+type IstioServiceMesh struct {
+	// Revisions is the list of revisions of the Istio control plane, e.g. "asm-1-19".
+	// When an upgrade is not in progress, this holds one value. During a canary upgrade, this holds the current and
+	// the next consecutive revision. Removing the old revision completes the upgrade, removing the new one rolls it back.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/istio-upgrade
+	// +kubebuilder:validation:MaxItems=2
+	// +listType=set
+	// +optional
+	Revisions []string `json:"revisions,omitempty"`
+
+	// Components is the Istio components configuration.
+	// +optional
+	Components *IstioComponents `json:"components,omitempty"`
+
+	// CertificateAuthority is the Istio service mesh certificate authority configuration.
+	// Immutable while the mesh is enabled.
+	// +optional
+	CertificateAuthority *IstioCertificateAuthority `json:"certificateAuthority,omitempty"`
+}
+
+// IstioComponents defines the Istio components configuration.
+type IstioComponents struct {
+	// IngressGateways is the list of Istio ingress gateways. At most one External and one Internal gateway may be configured.
+	// +kubebuilder:validation:MaxItems=2
+	// +optional
+	IngressGateways []IstioIngressGateway `json:"ingressGateways,omitempty"`
+
+	// EgressGateways is the list of Istio egress gateways.
+	// +optional
+	EgressGateways []IstioEgressGateway `json:"egressGateways,omitempty"`
+}
+
+// IstioIngressGateway defines an Istio ingress gateway.
+type IstioIngressGateway struct {
+	// Mode is the mode of the ingress gateway.
+	// +kubebuilder:validation:Enum=External;Internal
+	// +kubebuilder:validation:Required
+	Mode IstioIngressGatewayMode `json:"mode"`
+
+	// Enabled defines whether the ingress gateway is enabled.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+}
+
+// IstioEgressGateway defines an Istio egress gateway.
+type IstioEgressGateway struct {
+	// Enabled defines whether the egress gateway is enabled.
+	// +kubebuilder:validation:Required
+	Enabled bool `json:"enabled"`
+
+	// NodeSelector is the node selector for the egress gateway.
+	// +optional
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+}
+
+// IstioCertificateAuthority defines the Istio service mesh certificate authority (CA) configuration.
+type IstioCertificateAuthority struct {
+	// Plugin defines the plug-in CA certificates stored in Azure Key Vault.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/istio-plugin-ca
+	// +optional
+	Plugin *IstioPluginCertificateAuthority `json:"plugin,omitempty"`
+}
+
+// IstioPluginCertificateAuthority defines the plug-in CA certificates for the service mesh, stored in Azure Key Vault.
+type IstioPluginCertificateAuthority struct {
+	// KeyVaultResourceID is the resource ID of the Key Vault containing the certificate objects.
+	// +kubebuilder:validation:Required
+	KeyVaultResourceID string `json:"keyVaultResourceID"`
+
+	// CertObjectName is the intermediate certificate object name in Azure Key Vault.
+	// +kubebuilder:validation:Required
+	CertObjectName string `json:"certObjectName"`
+
+	// KeyObjectName is the intermediate certificate private key object name in Azure Key Vault.
+	// +kubebuilder:validation:Required
+	KeyObjectName string `json:"keyObjectName"`
+
+	// RootCertObjectName is the root certificate object name in Azure Key Vault.
+	// +kubebuilder:validation:Required
+	RootCertObjectName string `json:"rootCertObjectName"`
+
+	// CertChainObjectName is the certificate chain object name in Azure Key Vault.
+	// +kubebuilder:validation:Required
+	CertChainObjectName string `json:"certChainObjectName"`
+}
+
 // HTTPProxyConfig is the HTTP proxy configuration for the cluster.
 type HTTPProxyConfig struct {
 	// HTTPProxy is the HTTP proxy server endpoint to use.
@@ -425,6 +674,10 @@ type AzureManagedControlPlaneStatus struct {
 	// +optional
 	OIDCIssuerProfile *OIDCIssuerProfileStatus `json:"oidcIssuerProfile,omitempty"`
 
+	// StorageProfile reports which CSI drivers and storage features are enabled on the Managed Cluster.
+	// +optional
+	StorageProfile *ManagedClusterStorageProfileStatus `json:"storageProfile,omitempty"`
+
 	// Version defines the Kubernetes version for the control plane instance.
 	// +optional
 	Version string `json:"version"`
@@ -437,6 +690,25 @@ type OIDCIssuerProfileStatus struct {
 	IssuerURL *string `json:"issuerURL,omitempty"`
 }
 
+// ManagedClusterStorageProfileStatus reports which CSI drivers and storage features are enabled on the Managed Cluster.
+type ManagedClusterStorageProfileStatus struct {
+	// BlobCSIDriverEnabled is true when the AzureBlob CSI Driver is enabled.
+	// +optional
+	BlobCSIDriverEnabled bool `json:"blobCSIDriverEnabled,omitempty"`
+
+	// DiskCSIDriverEnabled is true when the AzureDisk CSI Driver is enabled.
+	// +optional
+	DiskCSIDriverEnabled bool `json:"diskCSIDriverEnabled,omitempty"`
+
+	// FileCSIDriverEnabled is true when the AzureFile CSI Driver is enabled.
+	// +optional
+	FileCSIDriverEnabled bool `json:"fileCSIDriverEnabled,omitempty"`
+
+	// SnapshotControllerEnabled is true when the Snapshot Controller is enabled.
+	// +optional
+	SnapshotControllerEnabled bool `json:"snapshotControllerEnabled,omitempty"`
+}
+
 // AutoScalerProfile parameters to be applied to the cluster-autoscaler.
 // See also [AKS doc], [K8s doc].
 //
@@ -46,6 +46,7 @@ var (
 	rScaleDownTime             = regexp.MustCompile(`^(\d+)m$`)
 	rScaleDownDelayAfterDelete = regexp.MustCompile(`^(\d+)s$`)
 	rScanInterval              = regexp.MustCompile(`^(\d+)s$`)
+	rIstioRevision             = regexp.MustCompile(`^asm-(\d+)-(\d+)$`)
 )
 
 // SetupAzureManagedControlPlaneWebhookWithManager sets up and registers the webhook with the manager.
@@ -232,6 +233,22 @@ func (mw *azureManagedControlPlaneWebhook) ValidateUpdate(ctx context.Context, o
 		allErrs = append(allErrs, errs...)
 	}
 
+	if errs := m.Spec.AzureManagedControlPlaneClassSpec.validateServiceMeshProfileUpdate(&old.Spec.AzureManagedControlPlaneClassSpec); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := m.Spec.AzureManagedControlPlaneClassSpec.validateWorkloadAutoScalerProfileUpdate(&old.Spec.AzureManagedControlPlaneClassSpec); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := m.Spec.AzureManagedControlPlaneClassSpec.validateAzureMonitorProfileUpdate(&old.Spec.AzureManagedControlPlaneClassSpec); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	if errs := m.Spec.AzureManagedControlPlaneClassSpec.validateStorageProfileUpdate(&old.Spec.AzureManagedControlPlaneClassSpec); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
 	if len(allErrs) == 0 {
 		return nil, m.Validate(mw.Client)
 	}
@@ -284,6 +301,10 @@ func (m *AzureManagedControlPlane) Validate(cli client.Client) error {
 
 	allErrs = append(allErrs, m.Spec.AzureManagedControlPlaneClassSpec.validateSecurityProfile()...)
 
+	allErrs = append(allErrs, m.Spec.AzureManagedControlPlaneClassSpec.validateServiceMeshProfile()...)
+
+	allErrs = append(allErrs, m.Spec.AzureManagedControlPlaneClassSpec.validateAzureMonitorProfile()...)
+
 	allErrs = append(allErrs, validateNetworkPolicy(m.Spec.NetworkPolicy, m.Spec.NetworkDataplane, field.NewPath("spec").Child("NetworkPolicy"))...)
 
 	allErrs = append(allErrs, validateNetworkDataplane(m.Spec.NetworkDataplane, m.Spec.NetworkPolicy, m.Spec.NetworkPluginMode, field.NewPath("spec").Child("NetworkDataplane"))...)
@@ -886,6 +907,233 @@ func (m *AzureManagedControlPlaneClassSpec) validateDefender(old *AzureManagedCo
 	return allErrs
 }
 
+// validateServiceMeshProfile validates a ServiceMeshProfile.
+func (m *AzureManagedControlPlaneClassSpec) validateServiceMeshProfile() field.ErrorList {
+	var allErrs field.ErrorList
+	if m.ServiceMeshProfile == nil || m.ServiceMeshProfile.Istio == nil {
+		return nil
+	}
+	fldPath := field.NewPath("Spec", "ServiceMeshProfile", "Istio")
+	istio := m.ServiceMeshProfile.Istio
+
+	if m.ServiceMeshProfile.Mode != ServiceMeshModeIstio {
+		allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("can only be set when Spec.ServiceMeshProfile.Mode is %s", ServiceMeshModeIstio)))
+		return allErrs
+	}
+
+	for i, revision := range istio.Revisions {
+		if !rIstioRevision.MatchString(revision) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("Revisions").Index(i), revision, "revision must match the format asm-<major>-<minor>"))
+		}
+	}
+	if len(allErrs) == 0 && len(istio.Revisions) == 2 {
+		if !istioRevisionsAreConsecutive(istio.Revisions[0], istio.Revisions[1]) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("Revisions"), istio.Revisions, "a canary upgrade can only run two consecutive revisions"))
+		}
+	}
+
+	if istio.Components != nil {
+		ingressModes := map[IstioIngressGatewayMode]struct{}{}
+		for i, gateway := range istio.Components.IngressGateways {
+			if _, ok := ingressModes[gateway.Mode]; ok {
+				allErrs = append(allErrs, field.Duplicate(fldPath.Child("Components", "IngressGateways").Index(i).Child("Mode"), gateway.Mode))
+			}
+			ingressModes[gateway.Mode] = struct{}{}
+		}
+	}
+
+	return allErrs
+}
+
+// validateServiceMeshProfileUpdate validates a ServiceMeshProfile update.
+// Istio revisions may only change through a canary upgrade: the next consecutive revision is added alongside the
+// current one, then one of the two is removed to either complete or roll back the upgrade.
+func (m *AzureManagedControlPlaneClassSpec) validateServiceMeshProfileUpdate(old *AzureManagedControlPlaneClassSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if old.ServiceMeshProfile == nil {
+		return nil
+	}
+	if m.ServiceMeshProfile == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("Spec", "ServiceMeshProfile"),
+			nil, fmt.Sprintf("cannot unset Spec.ServiceMeshProfile, to disable the service mesh please set Spec.ServiceMeshProfile.Mode to %s", ServiceMeshModeDisabled)))
+		return allErrs
+	}
+	if old.ServiceMeshProfile.Mode != ServiceMeshModeIstio || m.ServiceMeshProfile.Mode != ServiceMeshModeIstio {
+		return nil
+	}
+
+	oldIstio := ptr.Deref(old.ServiceMeshProfile.Istio, IstioServiceMesh{})
+	newIstio := ptr.Deref(m.ServiceMeshProfile.Istio, IstioServiceMesh{})
+	fldPath := field.NewPath("Spec", "ServiceMeshProfile", "Istio")
+
+	if err := webhookutils.ValidateImmutable(fldPath.Child("CertificateAuthority"), oldIstio.CertificateAuthority, newIstio.CertificateAuthority); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if errs := validateIstioRevisionsUpdate(oldIstio.Revisions, newIstio.Revisions, fldPath.Child("Revisions")); len(errs) > 0 {
+		allErrs = append(allErrs, errs...)
+	}
+
+	return allErrs
+}
+
+// validateIstioRevisionsUpdate validates a transition between two sets of Istio revisions.
+func validateIstioRevisionsUpdate(oldRevisions, newRevisions []string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if len(oldRevisions) == 0 || webhookutils.EnsureStringSlicesAreEquivalent(oldRevisions, newRevisions) {
+		return nil
+	}
+
+	switch {
+	case len(newRevisions) == 0:
+		allErrs = append(allErrs, field.Invalid(fldPath, newRevisions, "revisions cannot be removed once set"))
+	case len(oldRevisions) == 1 && len(newRevisions) == 2:
+		// Starting a canary upgrade: the current revision must be kept and the added one must be newer.
+		current := oldRevisions[0]
+		var added string
+		switch current {
+		case newRevisions[0]:
+			added = newRevisions[1]
+		case newRevisions[1]:
+			added = newRevisions[0]
+		default:
+			allErrs = append(allErrs, field.Invalid(fldPath, newRevisions, fmt.Sprintf("the current revision %s must be kept while a canary upgrade is in progress", current)))
+			return allErrs
+		}
+		if compareIstioRevisions(added, current) <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath, newRevisions, fmt.Sprintf("revision %s cannot be downgraded to %s", current, added)))
+		}
+	case len(oldRevisions) == 2 && len(newRevisions) == 1:
+		// Completing or rolling back a canary upgrade.
+		if newRevisions[0] != oldRevisions[0] && newRevisions[0] != oldRevisions[1] {
+			allErrs = append(allErrs, field.Invalid(fldPath, newRevisions, "completing or rolling back a canary upgrade must keep one of the existing revisions"))
+		}
+	default:
+		allErrs = append(allErrs, field.Invalid(fldPath, newRevisions,
+			"revisions can only be changed through a canary upgrade: add the next revision alongside the current one, then remove one of them"))
+	}
+
+	return allErrs
+}
+
+// parseIstioRevision returns the major and minor versions of an Istio revision of the form asm-<major>-<minor>.
+func parseIstioRevision(revision string) (major, minor int, ok bool) {
+	matches := rIstioRevision.FindStringSubmatch(revision)
+	if len(matches) != 3 {
+		return 0, 0, false
+	}
+	major, majorErr := strconv.Atoi(matches[1])
+	minor, minorErr := strconv.Atoi(matches[2])
+	if majorErr != nil || minorErr != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
+
+// compareIstioRevisions returns a negative number when a is older than b, zero when they are equal and a positive
+// number when a is newer than b. Revisions that cannot be parsed compare as equal.
+func compareIstioRevisions(a, b string) int {
+	aMajor, aMinor, aOK := parseIstioRevision(a)
+	bMajor, bMinor, bOK := parseIstioRevision(b)
+	if !aOK || !bOK {
+		return 0
+	}
+	if aMajor != bMajor {
+		return aMajor - bMajor
+	}
+	return aMinor - bMinor
+}
+
+// istioRevisionsAreConsecutive returns true when the two revisions are in the same major version and one minor version apart.
+func istioRevisionsAreConsecutive(a, b string) bool {
+	aMajor, aMinor, aOK := parseIstioRevision(a)
+	bMajor, bMinor, bOK := parseIstioRevision(b)
+	if !aOK || !bOK || aMajor != bMajor {
+		return false
+	}
+	return aMinor-bMinor == 1 || bMinor-aMinor == 1
+}
+
+// validateWorkloadAutoScalerProfileUpdate validates a WorkloadAutoScalerProfile update.
+func (m *AzureManagedControlPlaneClassSpec) validateWorkloadAutoScalerProfileUpdate(old *AzureManagedControlPlaneClassSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if old.WorkloadAutoScalerProfile == nil {
+		return nil
+	}
+	fldPath := field.NewPath("Spec", "WorkloadAutoScalerProfile")
+	if m.WorkloadAutoScalerProfile == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath,
+			nil, "cannot unset Spec.WorkloadAutoScalerProfile, to disable the workload autoscalers please set Spec.WorkloadAutoScalerProfile.Keda.Enabled and Spec.WorkloadAutoScalerProfile.VerticalPodAutoscaler.Enabled to false"))
+		return allErrs
+	}
+	if old.WorkloadAutoScalerProfile.Keda != nil && m.WorkloadAutoScalerProfile.Keda == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("Keda"),
+			nil, "cannot unset Spec.WorkloadAutoScalerProfile.Keda, to disable KEDA please set Spec.WorkloadAutoScalerProfile.Keda.Enabled to false"))
+	}
+	if old.WorkloadAutoScalerProfile.VerticalPodAutoscaler != nil && m.WorkloadAutoScalerProfile.VerticalPodAutoscaler == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("VerticalPodAutoscaler"),
+			nil, "cannot unset Spec.WorkloadAutoScalerProfile.VerticalPodAutoscaler, to disable VPA please set Spec.WorkloadAutoScalerProfile.VerticalPodAutoscaler.Enabled to false"))
+	}
+	return allErrs
+}
+
+// validateAzureMonitorProfile validates an AzureMonitorProfile.
+func (m *AzureManagedControlPlaneClassSpec) validateAzureMonitorProfile() field.ErrorList {
+	var allErrs field.ErrorList
+	if m.AzureMonitorProfile == nil || m.AzureMonitorProfile.Metrics == nil {
+		return nil
+	}
+	if !m.AzureMonitorProfile.Metrics.Enabled && m.AzureMonitorProfile.Metrics.KubeStateMetrics != nil {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("Spec", "AzureMonitorProfile", "Metrics", "KubeStateMetrics"),
+			"can only be set when Spec.AzureMonitorProfile.Metrics.Enabled is true"))
+	}
+	return allErrs
+}
+
+// validateAzureMonitorProfileUpdate validates an AzureMonitorProfile update.
+func (m *AzureManagedControlPlaneClassSpec) validateAzureMonitorProfileUpdate(old *AzureManagedControlPlaneClassSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if old.AzureMonitorProfile == nil || old.AzureMonitorProfile.Metrics == nil {
+		return nil
+	}
+	if m.AzureMonitorProfile == nil || m.AzureMonitorProfile.Metrics == nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("Spec", "AzureMonitorProfile", "Metrics"),
+			nil, "cannot unset Spec.AzureMonitorProfile.Metrics, to disable metrics please set Spec.AzureMonitorProfile.Metrics.Enabled to false"))
+	}
+	return allErrs
+}
+
+// validateStorageProfileUpdate validates a StorageProfile update.
+// Drivers may be toggled after creation, but once set they must be disabled explicitly rather than removed.
+func (m *AzureManagedControlPlaneClassSpec) validateStorageProfileUpdate(old *AzureManagedControlPlaneClassSpec) field.ErrorList {
+	var allErrs field.ErrorList
+	if old.StorageProfile == nil {
+		return nil
+	}
+	fldPath := field.NewPath("Spec", "StorageProfile")
+	if m.StorageProfile == nil {
+		allErrs = append(allErrs, field.Invalid(fldPath,
+			nil, "cannot unset Spec.StorageProfile, to disable a storage driver please set its Enabled field to false"))
+		return allErrs
+	}
+	drivers := []struct {
+		name     string
+		old, new bool
+	}{
+		{"BlobCSIDriver", old.StorageProfile.BlobCSIDriver != nil, m.StorageProfile.BlobCSIDriver != nil},
+		{"DiskCSIDriver", old.StorageProfile.DiskCSIDriver != nil, m.StorageProfile.DiskCSIDriver != nil},
+		{"FileCSIDriver", old.StorageProfile.FileCSIDriver != nil, m.StorageProfile.FileCSIDriver != nil},
+		{"SnapshotController", old.StorageProfile.SnapshotController != nil, m.StorageProfile.SnapshotController != nil},
+	}
+	for _, driver := range drivers {
+		if driver.old && !driver.new {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child(driver.name),
+				nil, fmt.Sprintf("cannot unset Spec.StorageProfile.%[1]s, to disable it please set Spec.StorageProfile.%[1]s.Enabled to false", driver.name)))
+		}
+	}
+	return allErrs
+}
+
 // validateOIDCIssuerProfile validates an OIDCIssuerProfile.
 func (m *AzureManagedControlPlane) validateOIDCIssuerProfileUpdate(old *AzureManagedControlPlane) field.ErrorList {
 	var allErrs field.ErrorList
@@ -4258,3 +4258,401 @@ func TestValidateAMCPVirtualNetwork(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateServiceMeshProfile(t *testing.T) {
+	tests := []struct {
+		name      string
+		profile   *ServiceMeshProfile
+		expectErr bool
+	}{
+		{
+			name:      "no service mesh profile",
+			profile:   nil,
+			expectErr: false,
+		},
+		{
+			name: "valid Istio profile",
+			profile: &ServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &IstioServiceMesh{
+					Revisions: []string{"asm-1-19"},
+					Components: &IstioComponents{
+						IngressGateways: []IstioIngressGateway{
+							{Mode: IstioIngressGatewayModeExternal, Enabled: true},
+							{Mode: IstioIngressGatewayModeInternal, Enabled: true},
+						},
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "valid canary upgrade with two consecutive revisions",
+			profile: &ServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &IstioServiceMesh{
+					Revisions: []string{"asm-1-19", "asm-1-20"},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid revision format",
+			profile: &ServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &IstioServiceMesh{
+					Revisions: []string{"1.19"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid non-consecutive revisions",
+			profile: &ServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &IstioServiceMesh{
+					Revisions: []string{"asm-1-18", "asm-1-20"},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid duplicate ingress gateway modes",
+			profile: &ServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &IstioServiceMesh{
+					Components: &IstioComponents{
+						IngressGateways: []IstioIngressGateway{
+							{Mode: IstioIngressGatewayModeExternal, Enabled: true},
+							{Mode: IstioIngressGatewayModeExternal, Enabled: false},
+						},
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "invalid Istio configuration when mode is Disabled",
+			profile: &ServiceMeshProfile{
+				Mode: ServiceMeshModeDisabled,
+				Istio: &IstioServiceMesh{
+					Revisions: []string{"asm-1-19"},
+				},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			spec := &AzureManagedControlPlaneClassSpec{ServiceMeshProfile: tc.profile}
+			errs := spec.validateServiceMeshProfile()
+			if tc.expectErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateServiceMeshProfileUpdate(t *testing.T) {
+	istioProfile := func(revisions ...string) *ServiceMeshProfile {
+		return &ServiceMeshProfile{
+			Mode: ServiceMeshModeIstio,
+			Istio: &IstioServiceMesh{
+				Revisions: revisions,
+			},
+		}
+	}
+	tests := []struct {
+		name       string
+		oldProfile *ServiceMeshProfile
+		newProfile *ServiceMeshProfile
+		expectErr  bool
+	}{
+		{
+			name:       "enabling the service mesh",
+			oldProfile: nil,
+			newProfile: istioProfile("asm-1-19"),
+			expectErr:  false,
+		},
+		{
+			name:       "unsetting the service mesh profile",
+			oldProfile: istioProfile("asm-1-19"),
+			newProfile: nil,
+			expectErr:  true,
+		},
+		{
+			name:       "disabling the service mesh",
+			oldProfile: istioProfile("asm-1-19"),
+			newProfile: &ServiceMeshProfile{Mode: ServiceMeshModeDisabled},
+			expectErr:  false,
+		},
+		{
+			name:       "starting a canary upgrade",
+			oldProfile: istioProfile("asm-1-19"),
+			newProfile: istioProfile("asm-1-19", "asm-1-20"),
+			expectErr:  false,
+		},
+		{
+			name:       "starting a canary downgrade",
+			oldProfile: istioProfile("asm-1-19"),
+			newProfile: istioProfile("asm-1-18", "asm-1-19"),
+			expectErr:  true,
+		},
+		{
+			name:       "completing a canary upgrade",
+			oldProfile: istioProfile("asm-1-19", "asm-1-20"),
+			newProfile: istioProfile("asm-1-20"),
+			expectErr:  false,
+		},
+		{
+			name:       "rolling back a canary upgrade",
+			oldProfile: istioProfile("asm-1-19", "asm-1-20"),
+			newProfile: istioProfile("asm-1-19"),
+			expectErr:  false,
+		},
+		{
+			name:       "replacing the revision in place",
+			oldProfile: istioProfile("asm-1-19"),
+			newProfile: istioProfile("asm-1-20"),
+			expectErr:  true,
+		},
+		{
+			name:       "removing all revisions",
+			oldProfile: istioProfile("asm-1-19"),
+			newProfile: istioProfile(),
+			expectErr:  true,
+		},
+		{
+			name: "changing the plug-in certificate authority",
+			oldProfile: &ServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &IstioServiceMesh{
+					CertificateAuthority: &IstioCertificateAuthority{
+						Plugin: &IstioPluginCertificateAuthority{KeyVaultResourceID: "kv1"},
+					},
+				},
+			},
+			newProfile: &ServiceMeshProfile{
+				Mode: ServiceMeshModeIstio,
+				Istio: &IstioServiceMesh{
+					CertificateAuthority: &IstioCertificateAuthority{
+						Plugin: &IstioPluginCertificateAuthority{KeyVaultResourceID: "kv2"},
+					},
+				},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldSpec := &AzureManagedControlPlaneClassSpec{ServiceMeshProfile: tc.oldProfile}
+			newSpec := &AzureManagedControlPlaneClassSpec{ServiceMeshProfile: tc.newProfile}
+			errs := newSpec.validateServiceMeshProfileUpdate(oldSpec)
+			if tc.expectErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateWorkloadAutoScalerProfileUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		oldProfile *ManagedClusterWorkloadAutoScalerProfile
+		newProfile *ManagedClusterWorkloadAutoScalerProfile
+		expectErr  bool
+	}{
+		{
+			name:       "enabling KEDA",
+			oldProfile: nil,
+			newProfile: &ManagedClusterWorkloadAutoScalerProfile{
+				Keda: &ManagedClusterWorkloadAutoScalerProfileKeda{Enabled: true},
+			},
+			expectErr: false,
+		},
+		{
+			name: "disabling KEDA",
+			oldProfile: &ManagedClusterWorkloadAutoScalerProfile{
+				Keda: &ManagedClusterWorkloadAutoScalerProfileKeda{Enabled: true},
+			},
+			newProfile: &ManagedClusterWorkloadAutoScalerProfile{
+				Keda: &ManagedClusterWorkloadAutoScalerProfileKeda{Enabled: false},
+			},
+			expectErr: false,
+		},
+		{
+			name: "unsetting the workload autoscaler profile",
+			oldProfile: &ManagedClusterWorkloadAutoScalerProfile{
+				Keda: &ManagedClusterWorkloadAutoScalerProfileKeda{Enabled: true},
+			},
+			newProfile: nil,
+			expectErr:  true,
+		},
+		{
+			name: "unsetting VPA",
+			oldProfile: &ManagedClusterWorkloadAutoScalerProfile{
+				Keda:                  &ManagedClusterWorkloadAutoScalerProfileKeda{Enabled: true},
+				VerticalPodAutoscaler: &ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler{Enabled: true},
+			},
+			newProfile: &ManagedClusterWorkloadAutoScalerProfile{
+				Keda: &ManagedClusterWorkloadAutoScalerProfileKeda{Enabled: true},
+			},
+			expectErr: true,
+		},
+		{
+			name:       "adding VPA",
+			oldProfile: &ManagedClusterWorkloadAutoScalerProfile{},
+			newProfile: &ManagedClusterWorkloadAutoScalerProfile{
+				VerticalPodAutoscaler: &ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler{Enabled: true},
+			},
+			expectErr: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldSpec := &AzureManagedControlPlaneClassSpec{WorkloadAutoScalerProfile: tc.oldProfile}
+			newSpec := &AzureManagedControlPlaneClassSpec{WorkloadAutoScalerProfile: tc.newProfile}
+			errs := newSpec.validateWorkloadAutoScalerProfileUpdate(oldSpec)
+			if tc.expectErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateAzureMonitorProfile(t *testing.T) {
+	tests := []struct {
+		name       string
+		oldProfile *ManagedClusterAzureMonitorProfile
+		newProfile *ManagedClusterAzureMonitorProfile
+		expectErr  bool
+	}{
+		{
+			name:       "enabling metrics with kube-state-metrics allow lists",
+			oldProfile: nil,
+			newProfile: &ManagedClusterAzureMonitorProfile{
+				Metrics: &ManagedClusterAzureMonitorProfileMetrics{
+					Enabled: true,
+					KubeStateMetrics: &ManagedClusterAzureMonitorProfileKubeStateMetrics{
+						MetricLabelsAllowlist: ptr.To("namespaces=[k8s-label-1]"),
+					},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name:       "kube-state-metrics with metrics disabled",
+			oldProfile: nil,
+			newProfile: &ManagedClusterAzureMonitorProfile{
+				Metrics: &ManagedClusterAzureMonitorProfileMetrics{
+					Enabled: false,
+					KubeStateMetrics: &ManagedClusterAzureMonitorProfileKubeStateMetrics{
+						MetricLabelsAllowlist: ptr.To("namespaces=[k8s-label-1]"),
+					},
+				},
+			},
+			expectErr: true,
+		},
+		{
+			name: "disabling metrics",
+			oldProfile: &ManagedClusterAzureMonitorProfile{
+				Metrics: &ManagedClusterAzureMonitorProfileMetrics{Enabled: true},
+			},
+			newProfile: &ManagedClusterAzureMonitorProfile{
+				Metrics: &ManagedClusterAzureMonitorProfileMetrics{Enabled: false},
+			},
+			expectErr: false,
+		},
+		{
+			name: "unsetting metrics",
+			oldProfile: &ManagedClusterAzureMonitorProfile{
+				Metrics: &ManagedClusterAzureMonitorProfileMetrics{Enabled: true},
+			},
+			newProfile: nil,
+			expectErr:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldSpec := &AzureManagedControlPlaneClassSpec{AzureMonitorProfile: tc.oldProfile}
+			newSpec := &AzureManagedControlPlaneClassSpec{AzureMonitorProfile: tc.newProfile}
+			errs := newSpec.validateAzureMonitorProfile()
+			errs = append(errs, newSpec.validateAzureMonitorProfileUpdate(oldSpec)...)
+			if tc.expectErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
+
+func TestValidateStorageProfileUpdate(t *testing.T) {
+	tests := []struct {
+		name       string
+		oldProfile *ManagedClusterStorageProfile
+		newProfile *ManagedClusterStorageProfile
+		expectErr  bool
+	}{
+		{
+			name:       "enabling the blob CSI driver",
+			oldProfile: nil,
+			newProfile: &ManagedClusterStorageProfile{
+				BlobCSIDriver: &ManagedClusterStorageProfileBlobCSIDriver{Enabled: true},
+			},
+			expectErr: false,
+		},
+		{
+			name: "disabling the blob CSI driver",
+			oldProfile: &ManagedClusterStorageProfile{
+				BlobCSIDriver: &ManagedClusterStorageProfileBlobCSIDriver{Enabled: true},
+			},
+			newProfile: &ManagedClusterStorageProfile{
+				BlobCSIDriver: &ManagedClusterStorageProfileBlobCSIDriver{Enabled: false},
+			},
+			expectErr: false,
+		},
+		{
+			name: "unsetting the storage profile",
+			oldProfile: &ManagedClusterStorageProfile{
+				DiskCSIDriver: &ManagedClusterStorageProfileDiskCSIDriver{Enabled: true},
+			},
+			newProfile: nil,
+			expectErr:  true,
+		},
+		{
+			name: "unsetting the snapshot controller",
+			oldProfile: &ManagedClusterStorageProfile{
+				FileCSIDriver:      &ManagedClusterStorageProfileFileCSIDriver{Enabled: true},
+				SnapshotController: &ManagedClusterStorageProfileSnapshotController{Enabled: false},
+			},
+			newProfile: &ManagedClusterStorageProfile{
+				FileCSIDriver: &ManagedClusterStorageProfileFileCSIDriver{Enabled: true},
+			},
+			expectErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			oldSpec := &AzureManagedControlPlaneClassSpec{StorageProfile: tc.oldProfile}
+			newSpec := &AzureManagedControlPlaneClassSpec{StorageProfile: tc.newProfile}
+			errs := newSpec.validateStorageProfileUpdate(oldSpec)
+			if tc.expectErr {
+				g.Expect(errs).NotTo(BeEmpty())
+			} else {
+				g.Expect(errs).To(BeEmpty())
+			}
+		})
+	}
+}
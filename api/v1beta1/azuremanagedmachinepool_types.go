@@ -423,6 +423,49 @@ type AzureManagedMachinePoolSpec struct {
 	// ProviderIDList is the unique identifier as specified by the cloud provider.
 	// +optional
 	ProviderIDList []string `json:"providerIDList,omitempty"`
+
+	// Snapshot configures an AKS node pool snapshot to be taken of this node pool once it is provisioned.
+	// Changing the name takes a new snapshot. Snapshots are retained when the node pool is deleted.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/node-pool-snapshot
+	// +optional
+	Snapshot *AgentPoolSnapshot `json:"snapshot,omitempty"`
+
+	// ProximityPlacementGroup configures a proximity placement group that is created and owned by CAPZ and
+	// that the node pool is placed in. The proximity placement group is deleted along with the node pool unless
+	// other VMs or scale sets still belong to it. Mutually exclusive with ProximityPlacementGroupID.
+	// Immutable.
+	// +optional
+	ProximityPlacementGroup *ProximityPlacementGroup `json:"proximityPlacementGroup,omitempty"`
+}
+
+// AgentPoolSnapshot defines an AKS node pool snapshot taken from an AzureManagedMachinePool.
+type AgentPoolSnapshot struct {
+	// Name is the name of the snapshot resource.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=80
+	Name string `json:"name"`
+
+	// ResourceGroup is the name of the resource group the snapshot is created in.
+	// Defaults to the resource group of the AzureManagedControlPlane.
+	// +optional
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+}
+
+// AgentPoolSnapshotStatus defines the observed state of an AKS node pool snapshot.
+type AgentPoolSnapshotStatus struct {
+	// ID is the resource ID of the snapshot.
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// KubernetesVersion is the Kubernetes version captured by the snapshot.
+	// +optional
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+
+	// NodeImageVersion is the node image version captured by the snapshot.
+	// +optional
+	NodeImageVersion string `json:"nodeImageVersion,omitempty"`
 }
 
 // ManagedMachinePoolScaling specifies scaling options.
@@ -480,6 +523,10 @@ type AzureManagedMachinePoolStatus struct {
 	// next reconciliation loop.
 	// +optional
 	LongRunningOperationStates Futures `json:"longRunningOperationStates,omitempty"`
+
+	// Snapshot is the most recent AKS node pool snapshot taken of this node pool.
+	// +optional
+	Snapshot *AgentPoolSnapshotStatus `json:"snapshot,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -43,6 +43,16 @@ import (
 
 var validNodePublicPrefixID = regexp.MustCompile(`(?i)^/?subscriptions/[0-9a-f]{8}-([0-9a-f]{4}-){3}[0-9a-f]{12}/resourcegroups/[^/]+/providers/microsoft\.network/publicipprefixes/[^/]+$`)
 
+var validSnapshotID = regexp.MustCompile(`(?i)^/?subscriptions/[0-9a-f]{8}-([0-9a-f]{4}-){3}[0-9a-f]{12}/resourcegroups/[^/]+/providers/microsoft\.containerservice/snapshots/[^/]+$`)
+
+var validProximityPlacementGroupID = regexp.MustCompile(`(?i)^/?subscriptions/[0-9a-f]{8}-([0-9a-f]{4}-){3}[0-9a-f]{12}/resourcegroups/[^/]+/providers/microsoft\.compute/proximityplacementgroups/[^/]+$`)
+
+var validCapacityReservationGroupID = regexp.MustCompile(`(?i)^/?subscriptions/[0-9a-f]{8}-([0-9a-f]{4}-){3}[0-9a-f]{12}/resourcegroups/[^/]+/providers/microsoft\.compute/capacityreservationgroups/[^/]+$`)
+
+var validHostGroupID = regexp.MustCompile(`(?i)^/?subscriptions/[0-9a-f]{8}-([0-9a-f]{4}-){3}[0-9a-f]{12}/resourcegroups/[^/]+/providers/microsoft\.compute/hostgroups/[^/]+$`)
+
+var validHostID = regexp.MustCompile(`(?i)^/?subscriptions/[0-9a-f]{8}-([0-9a-f]{4}-){3}[0-9a-f]{12}/resourcegroups/[^/]+/providers/microsoft\.compute/hostgroups/[^/]+/hosts/[^/]+$`)
+
 // SetupAzureManagedMachinePoolWebhookWithManager sets up and registers the webhook with the manager.
 func SetupAzureManagedMachinePoolWebhookWithManager(mgr ctrl.Manager) error {
 	mw := &azureManagedMachinePoolWebhook{Client: mgr.GetClient()}
@@ -129,6 +139,15 @@ func (mw *azureManagedMachinePoolWebhook) ValidateCreate(ctx context.Context, ob
 		m.Spec.NodePublicIPPrefixID,
 		field.NewPath("Spec", "EnableNodePublicIP")))
 
+	errs = append(errs, validateSnapshotID(
+		m.Spec.SnapshotID,
+		field.NewPath("Spec", "SnapshotID")))
+
+	errs = append(errs, validatePlacement(
+		m.Spec.AzureManagedMachinePoolClassSpec,
+		m.Spec.ProximityPlacementGroup,
+		field.NewPath("Spec")))
+
 	errs = append(errs, validateKubeletConfig(
 		m.Spec.KubeletConfig,
 		field.NewPath("Spec", "KubeletConfig")))
@@ -271,6 +290,41 @@ func (mw *azureManagedMachinePoolWebhook) ValidateUpdate(ctx context.Context, ol
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("Spec", "SnapshotID"),
+		old.Spec.SnapshotID,
+		m.Spec.SnapshotID); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("Spec", "ProximityPlacementGroupID"),
+		old.Spec.ProximityPlacementGroupID,
+		m.Spec.ProximityPlacementGroupID); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("Spec", "ProximityPlacementGroup"),
+		old.Spec.ProximityPlacementGroup,
+		m.Spec.ProximityPlacementGroup); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("Spec", "CapacityReservationGroupID"),
+		old.Spec.CapacityReservationGroupID,
+		m.Spec.CapacityReservationGroupID); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("Spec", "HostGroupID"),
+		old.Spec.HostGroupID,
+		m.Spec.HostGroupID); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("Spec", "KubeletConfig"),
 		old.Spec.KubeletConfig,
@@ -464,6 +518,71 @@ func validateNodePublicIPPrefixID(nodePublicIPPrefixID *string, fldPath *field.P
 	return nil
 }
 
+func validateSnapshotID(snapshotID *string, fldPath *field.Path) error {
+	if snapshotID != nil && !validSnapshotID.MatchString(*snapshotID) {
+		return field.Invalid(
+			fldPath,
+			snapshotID,
+			fmt.Sprintf("resource ID must match %q", validSnapshotID.String()))
+	}
+	return nil
+}
+
+// validatePlacement validates the proximity placement group, capacity reservation group and dedicated host group
+// references of a node pool, including their compatibility with the node pool's availability zones.
+func validatePlacement(spec AzureManagedMachinePoolClassSpec, proximityPlacementGroup *ProximityPlacementGroup, fldPath *field.Path) error {
+	var allErrs field.ErrorList
+
+	if spec.ProximityPlacementGroupID != nil && !validProximityPlacementGroupID.MatchString(*spec.ProximityPlacementGroupID) {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("ProximityPlacementGroupID"),
+			spec.ProximityPlacementGroupID,
+			fmt.Sprintf("resource ID must match %q", validProximityPlacementGroupID.String())))
+	}
+	if spec.CapacityReservationGroupID != nil && !validCapacityReservationGroupID.MatchString(*spec.CapacityReservationGroupID) {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("CapacityReservationGroupID"),
+			spec.CapacityReservationGroupID,
+			fmt.Sprintf("resource ID must match %q", validCapacityReservationGroupID.String())))
+	}
+	if spec.HostGroupID != nil && !validHostGroupID.MatchString(*spec.HostGroupID) {
+		allErrs = append(allErrs, field.Invalid(
+			fldPath.Child("HostGroupID"),
+			spec.HostGroupID,
+			fmt.Sprintf("resource ID must match %q", validHostGroupID.String())))
+	}
+
+	if spec.ProximityPlacementGroupID != nil && proximityPlacementGroup != nil {
+		allErrs = append(allErrs, field.Forbidden(
+			fldPath.Child("ProximityPlacementGroup"),
+			"cannot be set together with ProximityPlacementGroupID"))
+	}
+	if spec.HostGroupID != nil && spec.CapacityReservationGroupID != nil {
+		allErrs = append(allErrs, field.Forbidden(
+			fldPath.Child("CapacityReservationGroupID"),
+			"capacity reservations are not supported for node pools on dedicated hosts"))
+	}
+
+	// A proximity placement group co-locates VMs in a single datacenter and a dedicated host group lives in at
+	// most one availability zone, so neither can be used by a node pool spanning multiple zones.
+	if len(spec.AvailabilityZones) > 1 {
+		if spec.ProximityPlacementGroupID != nil || proximityPlacementGroup != nil {
+			allErrs = append(allErrs, field.Invalid(
+				fldPath.Child("AvailabilityZones"),
+				spec.AvailabilityZones,
+				"must not contain more than one availability zone when the node pool is in a proximity placement group"))
+		}
+		if spec.HostGroupID != nil {
+			allErrs = append(allErrs, field.Invalid(
+				fldPath.Child("AvailabilityZones"),
+				spec.AvailabilityZones,
+				"must not contain more than one availability zone when the node pool uses a dedicated host group"))
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
 func validateEnableNodePublicIP(enableNodePublicIP *bool, nodePublicIPPrefixID *string, fldPath *field.Path) error {
 	if (enableNodePublicIP == nil || !*enableNodePublicIP) &&
 		nodePublicIPPrefixID != nil {
@@ -633,6 +633,74 @@ func TestAzureManagedMachinePoolUpdatingWebhook(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "Cannot update proximityPlacementGroupID",
+			new: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						ProximityPlacementGroupID: ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/proximityPlacementGroups/ppg"),
+					},
+				},
+			},
+			old:     &AzureManagedMachinePool{},
+			wantErr: true,
+		},
+		{
+			name: "Cannot update proximityPlacementGroup",
+			new: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					ProximityPlacementGroup: &ProximityPlacementGroup{Name: "new"},
+				},
+			},
+			old: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					ProximityPlacementGroup: &ProximityPlacementGroup{Name: "old"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Cannot unset capacityReservationGroupID",
+			new:  &AzureManagedMachinePool{},
+			old: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						CapacityReservationGroupID: ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/capacityReservationGroups/crg"),
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "Cannot update hostGroupID",
+			new: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						HostGroupID: ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/hostGroups/hg"),
+					},
+				},
+			},
+			old:     &AzureManagedMachinePool{},
+			wantErr: true,
+		},
+		{
+			name: "Cannot update snapshotID",
+			new: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						SnapshotID: ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.ContainerService/snapshots/new"),
+					},
+				},
+			},
+			old: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						SnapshotID: ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.ContainerService/snapshots/old"),
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 	var client client.Client
 	for _, tc := range tests {
@@ -1271,6 +1339,115 @@ func TestAzureManagedMachinePool_ValidateCreate(t *testing.T) {
 			wantErr:  true,
 			errorLen: 1,
 		},
+		{
+			name: "valid placement references in a single zone",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						AvailabilityZones:          []string{"1"},
+						ProximityPlacementGroupID:  ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/proximityPlacementGroups/ppg"),
+						CapacityReservationGroupID: ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/capacityReservationGroups/crg"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "valid CAPZ-owned proximity placement group",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					ProximityPlacementGroup: &ProximityPlacementGroup{Name: "ppg"},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid HostGroupID",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						HostGroupID: ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/hosts/h"),
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "proximity placement group with multiple zones",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						AvailabilityZones: []string{"1", "2"},
+					},
+					ProximityPlacementGroup: &ProximityPlacementGroup{Name: "ppg"},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "host group with multiple zones",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						AvailabilityZones: []string{"1", "2", "3"},
+						HostGroupID:       ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/hostGroups/hg"),
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "proximity placement group set both by ID and as CAPZ-owned",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						ProximityPlacementGroupID: ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/proximityPlacementGroups/ppg"),
+					},
+					ProximityPlacementGroup: &ProximityPlacementGroup{Name: "ppg"},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "capacity reservation group with host group",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						CapacityReservationGroupID: ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/capacityReservationGroups/crg"),
+						HostGroupID:                ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/hostGroups/hg"),
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
+		{
+			name: "valid SnapshotID",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						SnapshotID: ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.ContainerService/snapshots/snap"),
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid SnapshotID",
+			ammp: &AzureManagedMachinePool{
+				Spec: AzureManagedMachinePoolSpec{
+					AzureManagedMachinePoolClassSpec: AzureManagedMachinePoolClassSpec{
+						SnapshotID: ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg/providers/Microsoft.Compute/snapshots/snap"),
+					},
+				},
+			},
+			wantErr:  true,
+			errorLen: 1,
+		},
 		{
 			name: "an invalid LinuxOSConfig Sysctls is set without disabling FailSwapOn",
 			ammp: &AzureManagedMachinePool{
@@ -115,6 +115,15 @@ func (mpw *azureManagedMachinePoolTemplateWebhook) ValidateCreate(ctx context.Co
 		mp.Spec.Template.Spec.NodePublicIPPrefixID,
 		field.NewPath("Spec", "Template", "Spec", "EnableNodePublicIP")))
 
+	errs = append(errs, validateSnapshotID(
+		mp.Spec.Template.Spec.SnapshotID,
+		field.NewPath("Spec", "Template", "Spec", "SnapshotID")))
+
+	errs = append(errs, validatePlacement(
+		mp.Spec.Template.Spec.AzureManagedMachinePoolClassSpec,
+		nil,
+		field.NewPath("Spec", "Template", "Spec")))
+
 	errs = append(errs, validateKubeletConfig(
 		mp.Spec.Template.Spec.KubeletConfig,
 		field.NewPath("Spec", "Template", "Spec", "KubeletConfig")))
@@ -246,6 +255,34 @@ func (mpw *azureManagedMachinePoolTemplateWebhook) ValidateUpdate(ctx context.Co
 		allErrs = append(allErrs, err)
 	}
 
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("Spec", "Template", "Spec", "SnapshotID"),
+		old.Spec.Template.Spec.SnapshotID,
+		mp.Spec.Template.Spec.SnapshotID); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("Spec", "Template", "Spec", "ProximityPlacementGroupID"),
+		old.Spec.Template.Spec.ProximityPlacementGroupID,
+		mp.Spec.Template.Spec.ProximityPlacementGroupID); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("Spec", "Template", "Spec", "CapacityReservationGroupID"),
+		old.Spec.Template.Spec.CapacityReservationGroupID,
+		mp.Spec.Template.Spec.CapacityReservationGroupID); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
+	if err := webhookutils.ValidateImmutable(
+		field.NewPath("Spec", "Template", "Spec", "HostGroupID"),
+		old.Spec.Template.Spec.HostGroupID,
+		mp.Spec.Template.Spec.HostGroupID); err != nil {
+		allErrs = append(allErrs, err)
+	}
+
 	if err := webhookutils.ValidateImmutable(
 		field.NewPath("Spec", "Template", "Spec", "KubeletConfig"),
 		old.Spec.Template.Spec.KubeletConfig,
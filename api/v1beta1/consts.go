@@ -24,6 +24,11 @@ const (
 	NetworkInfrastructureReadyCondition clusterv1.ConditionType = "NetworkInfrastructureReady"
 	// NamespaceNotAllowedByIdentity used to indicate cluster in a namespace not allowed by identity.
 	NamespaceNotAllowedByIdentity = "NamespaceNotAllowedByIdentity"
+	// VnetPeeringSyncedCondition reports whether the virtual network peerings are connected and in sync with the address
+	// spaces of both peered virtual networks.
+	VnetPeeringSyncedCondition clusterv1.ConditionType = "VnetPeeringSynced"
+	// VnetPeeringNotSyncedReason used when a virtual network peering is not connected or not fully in sync.
+	VnetPeeringNotSyncedReason = "VnetPeeringNotSynced"
 )
 
 // AzureMachine Conditions and Reasons.
@@ -32,6 +37,11 @@ const (
 	VMRunningCondition clusterv1.ConditionType = "VMRunning"
 	// VMIdentitiesReadyCondition reports on the readiness of the Azure VM identities.
 	VMIdentitiesReadyCondition clusterv1.ConditionType = "VMIdentitiesReady"
+	// VMSizeCompatibleCondition reports whether the VM size supports the features and availability zones requested
+	// for the machine. It is checked before the machine's Azure resources are created.
+	VMSizeCompatibleCondition clusterv1.ConditionType = "VMSizeCompatible"
+	// VMSizeIncompatibleReason used when the VM size doesn't support a requested feature or availability zone.
+	VMSizeIncompatibleReason = "VMSizeIncompatible"
 	// VMCreatingReason used when the vm creation is in progress.
 	VMCreatingReason = "VMCreating"
 	// VMUpdatingReason used when the vm updating is in progress.
@@ -52,6 +62,9 @@ const (
 	BootstrapInProgressReason = "BootstrapInProgress"
 	// BootstrapFailedReason is used to indicate the bootstrap process ran into an error.
 	BootstrapFailedReason = "BootstrapFailed"
+	// BootstrapDiagnosticsCollectedReason is used in events to indicate diagnostics were collected from a VM whose
+	// bootstrapping failed.
+	BootstrapDiagnosticsCollectedReason = "BootstrapDiagnosticsCollected"
 )
 
 // AzureMachinePool Conditions and Reasons.
@@ -78,6 +91,16 @@ const (
 	ScaleSetModelUpdatedCondition clusterv1.ConditionType = "ScaleSetModelUpdated"
 	// ScaleSetModelOutOfDateReason describes the machine pool model being out of date.
 	ScaleSetModelOutOfDateReason = "ScaleSetModelOutOfDate"
+
+	// CapacityReservationAvailableCondition reports whether the capacity reservation group of the machine pool has
+	// capacity left for the scale set's instances.
+	CapacityReservationAvailableCondition clusterv1.ConditionType = "CapacityReservationAvailable"
+	// CapacityReservationExhaustedReason describes the reserved capacity being fully allocated while the machine pool
+	// scales up, so that new instances are not backed by the capacity reservation.
+	CapacityReservationExhaustedReason = "CapacityReservationExhausted"
+	// CapacityReservationAllocationFailedReason describes Azure failing to allocate the scale set's instances
+	// from its capacity reservation group.
+	CapacityReservationAllocationFailedReason = "CapacityReservationAllocationFailed"
 )
 
 // AzureManagedCluster Conditions and Reasons.
@@ -86,6 +109,8 @@ const (
 	ManagedClusterRunningCondition clusterv1.ConditionType = "ManagedClusterRunning"
 	// AgentPoolsReadyCondition means the AKS agent pools exist and are ready to be used.
 	AgentPoolsReadyCondition clusterv1.ConditionType = "AgentPoolsReady"
+	// AgentPoolSnapshotReadyCondition means the AKS node pool snapshot of an agent pool exists and is ready to be used.
+	AgentPoolSnapshotReadyCondition clusterv1.ConditionType = "AgentPoolSnapshotReady"
 	// AzureResourceAvailableCondition means the AKS cluster is healthy according to Azure's Resource Health API.
 	AzureResourceAvailableCondition clusterv1.ConditionType = "AzureResourceAvailable"
 )
@@ -100,6 +125,10 @@ const (
 	VnetPeeringReadyCondition clusterv1.ConditionType = "VnetPeeringReady"
 	// SecurityGroupsReadyCondition means the security groups exist and are ready to be used.
 	SecurityGroupsReadyCondition clusterv1.ConditionType = "SecurityGroupsReady"
+	// FlowLogsReadyCondition means the flow logs of the security groups exist and are ready to be used.
+	FlowLogsReadyCondition clusterv1.ConditionType = "FlowLogsReady"
+	// ApplicationSecurityGroupsReadyCondition means the application security groups exist and are ready to be used.
+	ApplicationSecurityGroupsReadyCondition clusterv1.ConditionType = "ApplicationSecurityGroupsReady"
 	// RouteTablesReadyCondition means the route tables exist and are ready to be used.
 	RouteTablesReadyCondition clusterv1.ConditionType = "RouteTablesReady"
 	// PublicIPsReadyCondition means the public IPs exist and are ready to be used.
@@ -122,6 +151,8 @@ const (
 	InboundNATRulesReadyCondition clusterv1.ConditionType = "InboundNATRulesReady"
 	// AvailabilitySetReadyCondition means the availability set exists and is ready to be used.
 	AvailabilitySetReadyCondition clusterv1.ConditionType = "AvailabilitySetReady"
+	// ProximityPlacementGroupReadyCondition means the CAPZ-owned proximity placement group exists and is ready to be used.
+	ProximityPlacementGroupReadyCondition clusterv1.ConditionType = "ProximityPlacementGroupReady"
 	// RoleAssignmentReadyCondition means the role assignment exists and is ready to be used.
 	RoleAssignmentReadyCondition clusterv1.ConditionType = "RoleAssignmentReady"
 	// DisksReadyCondition means the disks exist and are ready to be used.
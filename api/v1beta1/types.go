@@ -17,8 +17,12 @@ limitations under the License.
 package v1beta1
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/net"
 )
 
@@ -31,6 +35,8 @@ const (
 	Bastion string = "bastion"
 	// Cluster subnet label.
 	Cluster string = "cluster"
+	// Delegated subnet label.
+	Delegated string = "delegated"
 )
 
 // SecurityEncryptionType represents the Encryption Type when the virtual machine is a
@@ -148,7 +154,20 @@ type VnetPeeringClassSpec struct {
 	ResourceGroup string `json:"resourceGroup,omitempty"`
 
 	// RemoteVnetName defines name of the remote virtual network.
-	RemoteVnetName string `json:"remoteVnetName"`
+	// Required unless RemoteVnetID is set.
+	// +optional
+	RemoteVnetName string `json:"remoteVnetName,omitempty"`
+
+	// RemoteVnetID is the full Azure resource ID of the remote virtual network, which may be in a different subscription
+	// than the cluster. ResourceGroup and RemoteVnetName default to the values in the ID.
+	// +optional
+	RemoteVnetID string `json:"remoteVnetID,omitempty"`
+
+	// ReversePeeringIdentityRef is a reference to an AzureClusterIdentity used to create the peering from the remote
+	// virtual network to the cluster's virtual network, for example when the remote virtual network is in a subscription
+	// the cluster's identity can't access. Defaults to the cluster's identity.
+	// +optional
+	ReversePeeringIdentityRef *corev1.ObjectReference `json:"reversePeeringIdentityRef,omitempty"`
 
 	// ForwardPeeringProperties specifies VnetPeeringProperties for peering from the cluster's virtual network to the
 	// remote virtual network.
@@ -204,6 +223,11 @@ type Subnets []SubnetSpec
 // +listMapKey=service
 type ServiceEndpoints []ServiceEndpointSpec
 
+// SubnetDelegations is a slice of SubnetDelegation.
+// +listType=map
+// +listMapKey=name
+type SubnetDelegations []SubnetDelegation
+
 // PrivateEndpoints is a slice of PrivateEndpointSpec.
 // +listType=map
 // +listMapKey=name
@@ -227,8 +251,47 @@ type RouteTable struct {
 	// +optional
 	ID   string `json:"id,omitempty"`
 	Name string `json:"name"`
+	// Routes are the user-defined routes of the route table.
+	// Routes added to the route table by other means, such as the pod routes managed by the Azure cloud provider, are preserved.
+	// +optional
+	Routes Routes `json:"routes,omitempty"`
+}
+
+// RouteNextHopType defines the type of Azure hop a route sends packets to.
+type RouteNextHopType string
+
+const (
+	// RouteNextHopTypeVirtualNetworkGateway sends packets to the virtual network gateway.
+	RouteNextHopTypeVirtualNetworkGateway = RouteNextHopType("VirtualNetworkGateway")
+	// RouteNextHopTypeVnetLocal routes packets within the virtual network.
+	RouteNextHopTypeVnetLocal = RouteNextHopType("VnetLocal")
+	// RouteNextHopTypeInternet sends packets to the Internet.
+	RouteNextHopTypeInternet = RouteNextHopType("Internet")
+	// RouteNextHopTypeVirtualAppliance sends packets to a virtual appliance, such as a firewall.
+	RouteNextHopTypeVirtualAppliance = RouteNextHopType("VirtualAppliance")
+	// RouteNextHopTypeNone drops packets.
+	RouteNextHopTypeNone = RouteNextHopType("None")
+)
+
+// Route defines a user-defined route of an Azure route table.
+type Route struct {
+	// Name is a unique name within the route table.
+	Name string `json:"name"`
+	// AddressPrefix is the destination CIDR the route applies to.
+	AddressPrefix string `json:"addressPrefix"`
+	// NextHopType is the type of Azure hop packets are sent to. "VirtualNetworkGateway", "VnetLocal", "Internet", "VirtualAppliance" or "None".
+	// +kubebuilder:validation:Enum=VirtualNetworkGateway;VnetLocal;Internet;VirtualAppliance;None
+	NextHopType RouteNextHopType `json:"nextHopType"`
+	// NextHopIPAddress is the IP address packets are forwarded to. Only allowed, and required, when NextHopType is "VirtualAppliance".
+	// +optional
+	NextHopIPAddress string `json:"nextHopIPAddress,omitempty"`
 }
 
+// Routes is a slice of user-defined routes for route tables.
+// +listType=map
+// +listMapKey=name
+type Routes []Route
+
 // NatGateway defines an Azure NAT gateway.
 // NAT gateway resources are part of Vnet NAT and provide outbound Internet connectivity for subnets of a virtual network.
 type NatGateway struct {
@@ -238,6 +301,10 @@ type NatGateway struct {
 	ID string `json:"id,omitempty"`
 	// +optional
 	NatGatewayIP PublicIPSpec `json:"ip,omitempty"`
+	// AdditionalIPs is a list of public IPs attached to the NAT gateway in addition to IP.
+	// Each public IP provides 64,512 SNAT ports.
+	// +optional
+	AdditionalIPs []PublicIPSpec `json:"additionalIPs,omitempty"`
 
 	NatGatewayClassSpec `json:",inline"`
 }
@@ -245,6 +312,48 @@ type NatGateway struct {
 // NatGatewayClassSpec defines a NAT gateway class specification.
 type NatGatewayClassSpec struct {
 	Name string `json:"name"`
+	// IPsCount is the total number of public IPs attached to the NAT gateway, including IP.
+	// Public IPs with generated names are added to AdditionalIPs until the count is reached.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=16
+	// +optional
+	IPsCount *int32 `json:"ipsCount,omitempty"`
+	// IPPrefix is a public IP prefix CAPZ creates, or an existing one, attached to the NAT gateway.
+	// +optional
+	IPPrefix *PublicIPPrefixSpec `json:"ipPrefix,omitempty"`
+	// IdleTimeoutInMinutes specifies the timeout for idle outbound connections, between 4 and 120 minutes.
+	// +kubebuilder:validation:Minimum=4
+	// +kubebuilder:validation:Maximum=120
+	// +optional
+	IdleTimeoutInMinutes *int32 `json:"idleTimeoutInMinutes,omitempty"`
+}
+
+// PublicIPPrefixSpec defines the inputs to create an Azure public IP prefix.
+type PublicIPPrefixSpec struct {
+	// Name is the name of the public IP prefix. Defaults to the name in ID if set, or to pipp-<NAT gateway name>.
+	// +optional
+	Name string `json:"name,omitempty"`
+	// ID is the resource ID of an existing public IP prefix to attach instead of creating one.
+	// CAPZ neither creates nor deletes a referenced public IP prefix, which must be a Standard SKU IPv4 prefix
+	// in the cluster's subscription and location with the given PrefixLength.
+	// +optional
+	ID string `json:"id,omitempty"`
+	// PrefixLength is the length of the prefix, between 28 (16 addresses) and 31 (2 addresses).
+	// +kubebuilder:validation:Minimum=28
+	// +kubebuilder:validation:Maximum=31
+	PrefixLength int32 `json:"prefixLength"`
+}
+
+// NatGatewayStatus defines the observed egress addresses of a NAT gateway.
+type NatGatewayStatus struct {
+	// Name is the name of the NAT gateway.
+	Name string `json:"name"`
+	// EgressIPs are the public IP addresses attached to the NAT gateway.
+	// +optional
+	EgressIPs []string `json:"egressIPs,omitempty"`
+	// EgressIPPrefixes are the CIDRs of the public IP prefixes attached to the NAT gateway.
+	// +optional
+	EgressIPPrefixes []string `json:"egressIPPrefixes,omitempty"`
 }
 
 // SecurityGroupProtocol defines the protocol type for a security group rule.
@@ -312,6 +421,16 @@ type SecurityRule struct {
 	// Destination is the destination address prefix. CIDR or destination IP range. Asterix '*' can also be used to match all source IPs. Default tags such as 'VirtualNetwork', 'AzureLoadBalancer' and 'Internet' can also be used.
 	// +optional
 	Destination *string `json:"destination,omitempty"`
+	// SourceApplicationSecurityGroups are the application security groups the traffic originates from, referenced by
+	// the name of one of the cluster's application security groups or by the resource ID of an existing one.
+	// Mutually exclusive with Source and Sources.
+	// +optional
+	SourceApplicationSecurityGroups []string `json:"sourceApplicationSecurityGroups,omitempty"`
+	// DestinationApplicationSecurityGroups are the application security groups the traffic is destined to, referenced by
+	// the name of one of the cluster's application security groups or by the resource ID of an existing one.
+	// Mutually exclusive with Destination.
+	// +optional
+	DestinationApplicationSecurityGroups []string `json:"destinationApplicationSecurityGroups,omitempty"`
 	// Action specifies whether network traffic is allowed or denied. Can either be "Allow" or "Deny". Defaults to "Allow".
 	// +kubebuilder:default=Allow
 	// +kubebuilder:validation:Enum=Allow;Deny
@@ -362,12 +481,39 @@ const (
 	Public = LBType("Public")
 )
 
+// LoadBalancerProbeProtocol defines the protocol of a load balancer health probe.
+type LoadBalancerProbeProtocol string
+
+const (
+	// LoadBalancerProbeProtocolHTTP is the value for an HTTP health probe.
+	LoadBalancerProbeProtocolHTTP = LoadBalancerProbeProtocol("Http")
+	// LoadBalancerProbeProtocolHTTPS is the value for an HTTPS health probe.
+	LoadBalancerProbeProtocolHTTPS = LoadBalancerProbeProtocol("Https")
+	// LoadBalancerProbeProtocolTCP is the value for a TCP health probe.
+	LoadBalancerProbeProtocolTCP = LoadBalancerProbeProtocol("Tcp")
+)
+
+// LoadBalancingRuleProtocol defines the transport protocol of a load balancing rule.
+type LoadBalancingRuleProtocol string
+
+const (
+	// LoadBalancingRuleProtocolTCP is the value for a TCP load balancing rule.
+	LoadBalancingRuleProtocolTCP = LoadBalancingRuleProtocol("Tcp")
+	// LoadBalancingRuleProtocolUDP is the value for a UDP load balancing rule.
+	LoadBalancingRuleProtocolUDP = LoadBalancingRuleProtocol("Udp")
+)
+
 // FrontendIP defines a load balancer frontend IP configuration.
 type FrontendIP struct {
 	// +kubebuilder:validation:MinLength=1
 	Name string `json:"name"`
 	// +optional
 	PublicIP *PublicIPSpec `json:"publicIP,omitempty"`
+	// GatewayLoadBalancerFrontendIPID is the resource ID of a Gateway Load Balancer frontend IP configuration to chain
+	// this frontend IP to, so that its traffic is sent through the network virtual appliances behind the Gateway Load
+	// Balancer. It can only be set on public frontend IPs of Standard SKU load balancers.
+	// +optional
+	GatewayLoadBalancerFrontendIPID string `json:"gatewayLoadBalancerFrontendIPID,omitempty"`
 
 	FrontendIPClass `json:",inline"`
 }
@@ -379,6 +525,15 @@ type PublicIPSpec struct {
 	DNSName string `json:"dnsName,omitempty"`
 	// +optional
 	IPTags []IPTag `json:"ipTags,omitempty"`
+	// ID is the resource ID of an existing public IP to use instead of creating one, e.g. a reserved static IP.
+	// CAPZ neither creates nor deletes a referenced public IP, which must be a Standard SKU public IP in the
+	// cluster's subscription and location. Name must match the name of the public IP in the ID.
+	// +optional
+	ID string `json:"id,omitempty"`
+	// PublicIPPrefixID is the resource ID of an existing public IP prefix the public IP is allocated from.
+	// It cannot be set together with ID.
+	// +optional
+	PublicIPPrefixID string `json:"publicIPPrefixID,omitempty"`
 }
 
 // IPTag contains the IpTag associated with the object.
@@ -618,10 +773,29 @@ type DataDisk struct {
 	// Each disk name will be in format <machineName>_<nameSuffix>.
 	NameSuffix string `json:"nameSuffix"`
 	// DiskSizeGB is the size in GB to assign to the data disk.
-	DiskSizeGB int32 `json:"diskSizeGB"`
+	// Required unless ManagedDiskID is set. On AzureMachines it can be increased after the machine is created,
+	// in which case the disk is expanded online without restarting the VM. Disks cannot be shrunk.
+	// +optional
+	DiskSizeGB int32 `json:"diskSizeGB,omitempty"`
 	// ManagedDisk specifies the Managed Disk parameters for the data disk.
 	// +optional
 	ManagedDisk *ManagedDiskParameters `json:"managedDisk,omitempty"`
+	// ManagedDiskID is the resource ID of an existing managed disk to attach as this data disk instead of
+	// creating a new one. The disk must be in the same subscription and location as the machine.
+	// Disks attached this way are detached but not deleted when the machine is deleted.
+	// DiskSizeGB and ManagedDisk must not be set together with ManagedDiskID.
+	// Only supported on AzureMachines.
+	// +optional
+	ManagedDiskID *string `json:"managedDiskID,omitempty"`
+	// MaxShares is the maximum number of machines that can attach the existing disk referenced by ManagedDiskID
+	// at the same time, which makes it a shared disk. If the disk currently allows fewer shares and is not attached
+	// to any VM, its maxShares is raised to this value before it is attached.
+	// See also [Azure doc].
+	//
+	// [Azure doc]: https://learn.microsoft.com/azure/virtual-machines/disks-shared
+	// +kubebuilder:validation:Minimum=2
+	// +optional
+	MaxShares *int32 `json:"maxShares,omitempty"`
 	// Lun Specifies the logical unit number of the data disk. This value is used to identify data disks within the VM and therefore must be unique for each data disk attached to a VM.
 	// The value must be between 0 and 63.
 	// +optional
@@ -630,8 +804,26 @@ type DataDisk struct {
 	// +optional
 	// +kubebuilder:validation:Enum=None;ReadOnly;ReadWrite
 	CachingType string `json:"cachingType,omitempty"`
+	// DeletionPolicy specifies what happens to the data disk when the machine is deleted.
+	// Defaults to Delete. Must not be set together with ManagedDiskID, as existing disks are never deleted.
+	// Only supported on AzureMachines.
+	// +optional
+	DeletionPolicy DiskDeletionPolicyType `json:"deletionPolicy,omitempty"`
 }
 
+// DiskDeletionPolicyType defines what happens to a data disk when its machine is deleted.
+// +kubebuilder:validation:Enum=Delete;Retain;Snapshot
+type DiskDeletionPolicyType string
+
+const (
+	// DiskDeletionPolicyDelete deletes the data disk along with the machine.
+	DiskDeletionPolicyDelete DiskDeletionPolicyType = "Delete"
+	// DiskDeletionPolicyRetain detaches the data disk from the machine and keeps it.
+	DiskDeletionPolicyRetain DiskDeletionPolicyType = "Retain"
+	// DiskDeletionPolicySnapshot creates an incremental snapshot of the data disk before deleting it along with the machine.
+	DiskDeletionPolicySnapshot DiskDeletionPolicyType = "Snapshot"
+)
+
 // VMExtension specifies the parameters for a custom VM extension.
 type VMExtension struct {
 	// Name is the name of the extension.
@@ -693,8 +885,28 @@ type DiffDiskSettings struct {
 	// See https://learn.microsoft.com/azure/virtual-machines/ephemeral-os-disks for full details
 	// +kubebuilder:validation:Enum=Local
 	Option string `json:"option"`
+
+	// Placement specifies the local storage the ephemeral OS disk is placed on: the VM's cache disk, its temporary
+	// resource disk, or its local NVMe disk. The VM size must support the placement and its local storage must be
+	// large enough for the OS disk.
+	// If unset, the cache disk is used if it is large enough, then the resource disk, then the NVMe disk.
+	// +optional
+	Placement *DiffDiskPlacement `json:"placement,omitempty"`
 }
 
+// DiffDiskPlacement is the local storage an ephemeral OS disk is placed on.
+// +kubebuilder:validation:Enum=CacheDisk;ResourceDisk;NvmeDisk
+type DiffDiskPlacement string
+
+const (
+	// DiffDiskPlacementCacheDisk places the ephemeral OS disk on the VM's cache disk.
+	DiffDiskPlacementCacheDisk DiffDiskPlacement = "CacheDisk"
+	// DiffDiskPlacementResourceDisk places the ephemeral OS disk on the VM's temporary resource disk.
+	DiffDiskPlacementResourceDisk DiffDiskPlacement = "ResourceDisk"
+	// DiffDiskPlacementNvmeDisk places the ephemeral OS disk on the VM's local NVMe disk.
+	DiffDiskPlacementNvmeDisk DiffDiskPlacement = "NvmeDisk"
+)
+
 // SubnetRole defines the unique role of a subnet.
 type SubnetRole string
 
@@ -710,6 +922,9 @@ const (
 
 	// SubnetCluster defines a role that can be used for both Kubernetes control plane node and Kubernetes workload node.
 	SubnetCluster = SubnetRole(Cluster)
+
+	// SubnetDelegated defines a role for subnets delegated to Azure services, which cannot be used by machines.
+	SubnetDelegated = SubnetRole(Delegated)
 )
 
 // SubnetSpec configures an Azure subnet.
@@ -741,6 +956,26 @@ type ServiceEndpointSpec struct {
 	Locations []string `json:"locations"`
 }
 
+// SubnetDelegation delegates a subnet to an Azure service.
+type SubnetDelegation struct {
+	// Name is the name of the delegation, unique within the subnet.
+	Name string `json:"name"`
+
+	// ServiceName is the name of the service the subnet is delegated to, e.g. Microsoft.ContainerInstance/containerGroups.
+	ServiceName string `json:"serviceName"`
+}
+
+// SubnetNetworkPolicies defines whether network policies apply to private endpoints or private link services in a subnet.
+// +kubebuilder:validation:Enum=Enabled;Disabled
+type SubnetNetworkPolicies string
+
+const (
+	// SubnetNetworkPoliciesEnabled enables network policies.
+	SubnetNetworkPoliciesEnabled SubnetNetworkPolicies = "Enabled"
+	// SubnetNetworkPoliciesDisabled disables network policies.
+	SubnetNetworkPoliciesDisabled SubnetNetworkPolicies = "Disabled"
+)
+
 // PrivateLinkServiceConnection defines the specification for a private link service connection associated with a private endpoint.
 type PrivateLinkServiceConnection struct {
 	// Name specifies the name of the private link service.
@@ -1182,3 +1417,123 @@ const (
 	// AKSAssignedIdentityUserAssigned ...
 	AKSAssignedIdentityUserAssigned AKSAssignedIdentity = "UserAssigned"
 )
+
+// ProximityPlacementGroup defines a proximity placement group that is created and owned by CAPZ.
+type ProximityPlacementGroup struct {
+	// Name is the name of the proximity placement group.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=80
+	Name string `json:"name"`
+
+	// ResourceGroup is the name of the resource group the proximity placement group is created in.
+	// Defaults to the resource group of the cluster.
+	// +optional
+	ResourceGroup string `json:"resourceGroup,omitempty"`
+
+	// VMSizes is the list of VM sizes that are intended to be deployed in the proximity placement group.
+	// Specifying them lets Azure pick a datacenter that can host all of them.
+	// +optional
+	VMSizes []string `json:"vmSizes,omitempty"`
+}
+
+// BootstrapTransportType is the mechanism used to deliver the bootstrap data to a VM.
+// +kubebuilder:validation:Enum=CustomData;UserData;StorageBlob;KeyVaultSecret
+type BootstrapTransportType string
+
+const (
+	// BootstrapTransportCustomData passes the bootstrap data to the VM as custom data.
+	BootstrapTransportCustomData BootstrapTransportType = "CustomData"
+	// BootstrapTransportUserData passes the bootstrap data to the VM as user data, which the VM reads from the
+	// Instance Metadata Service during boot. Unlike custom data, user data can be updated on existing VMs and scale sets.
+	BootstrapTransportUserData BootstrapTransportType = "UserData"
+	// BootstrapTransportStorageBlob stores the bootstrap data in an Azure Storage blob that the VM downloads during boot.
+	BootstrapTransportStorageBlob BootstrapTransportType = "StorageBlob"
+	// BootstrapTransportKeyVaultSecret stores the bootstrap data in an Azure Key Vault secret that the VM reads during boot.
+	BootstrapTransportKeyVaultSecret BootstrapTransportType = "KeyVaultSecret"
+)
+
+// BootstrapTransport defines how the bootstrap data is delivered to a VM.
+// With the UserData, StorageBlob and KeyVaultSecret transports, the custom data only contains a small cloud-init
+// loader that fetches the bootstrap data during boot, so these transports require a cloud-init based image.
+// The StorageBlob and KeyVaultSecret transports additionally require a system-assigned or user-assigned identity on
+// the VM, and the stored bootstrap data is deleted once the machine has bootstrapped successfully.
+type BootstrapTransport struct {
+	// Type is the mechanism used to deliver the bootstrap data to the VM.
+	// +kubebuilder:default=CustomData
+	Type BootstrapTransportType `json:"type"`
+
+	// StorageBlob specifies the storage account the bootstrap data is uploaded to.
+	// Required when Type is StorageBlob.
+	// +optional
+	StorageBlob *StorageBlobBootstrapTransport `json:"storageBlob,omitempty"`
+
+	// KeyVaultSecret specifies the key vault the bootstrap data is stored in.
+	// Required when Type is KeyVaultSecret.
+	// +optional
+	KeyVaultSecret *KeyVaultSecretBootstrapTransport `json:"keyVaultSecret,omitempty"`
+}
+
+// StorageBlobBootstrapTransport defines the Azure Storage blob container the bootstrap data is uploaded to.
+// CAPZ's identity needs the Storage Blob Data Contributor role on the storage account, and the VM's managed identity
+// needs the Storage Blob Data Reader role.
+type StorageBlobBootstrapTransport struct {
+	// StorageAccountName is the name of an existing storage account in the cluster's subscription.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]{3,24}$`
+	StorageAccountName string `json:"storageAccountName"`
+
+	// ContainerName is the name of the blob container the bootstrap data is uploaded to.
+	// The container is created if it doesn't exist. Defaults to the cluster name.
+	// +kubebuilder:validation:Pattern=`^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`
+	// +optional
+	ContainerName string `json:"containerName,omitempty"`
+}
+
+// KeyVaultSecretBootstrapTransport defines the Azure Key Vault the bootstrap data is stored in.
+// Key Vault secrets are limited to 25KB, so the bootstrap data is compressed before it is stored.
+// CAPZ's identity needs the Key Vault Secrets Officer role on the key vault, and the VM's managed identity
+// needs the Key Vault Secrets User role.
+type KeyVaultSecretBootstrapTransport struct {
+	// VaultName is the name of an existing key vault.
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z][a-zA-Z0-9-]{1,22}[a-zA-Z0-9]$`
+	VaultName string `json:"vaultName"`
+}
+
+// MaxBootstrapCheckTimeout is the longest time a VM extension is allowed to run before Azure fails it.
+const MaxBootstrapCheckTimeout = 90 * time.Minute
+
+// BootstrapCheck defines how CAPZ verifies that a VM has bootstrapped successfully.
+// By default, the CAPZ bootstrapping VM extension waits up to 5 minutes for the bootstrap sentinel file written by
+// the bootstrap provider, and the BootstrapSucceeded condition reflects the result of the extension.
+type BootstrapCheck struct {
+	// Disabled disables the bootstrap check VM extension, for images that report their readiness in another way.
+	// When disabled, the BootstrapSucceeded condition is set once the machine's Node has registered with the cluster.
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Command overrides the command run by the bootstrap check VM extension. The VM has bootstrapped successfully
+	// when the command exits with a zero exit code. Defaults to a command that waits for the bootstrap sentinel file.
+	// +optional
+	Command string `json:"command,omitempty"`
+
+	// Timeout is how long the default command waits for the bootstrap sentinel file before reporting a failure.
+	// It can't be set together with Command, which is expected to enforce its own timeout.
+	// Azure fails VM extensions that run for longer than 90 minutes. Defaults to 5 minutes.
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty"`
+
+	// Extension overrides the VM extension handler that runs the bootstrap check command. The handler must accept the
+	// command in the commandToExecute protected setting, like the Azure Custom Script extension does.
+	// Defaults to the CAPZ bootstrapping extension, which is only available in the Azure public cloud.
+	// +optional
+	Extension *BootstrapCheckExtension `json:"extension,omitempty"`
+}
+
+// BootstrapCheckExtension defines the VM extension handler that runs the bootstrap check command.
+type BootstrapCheckExtension struct {
+	// Publisher is the name of the extension handler publisher, for example Microsoft.Azure.Extensions.
+	Publisher string `json:"publisher"`
+	// Type is the type of the extension handler, for example CustomScript.
+	Type string `json:"type"`
+	// Version is the version of the extension handler, for example 2.1.
+	Version string `json:"version"`
+}
@@ -17,6 +17,8 @@ limitations under the License.
 package v1beta1
 
 import (
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -240,6 +242,22 @@ type AzureManagedControlPlaneClassSpec struct {
 	// +optional
 	SecurityProfile *ManagedClusterSecurityProfile `json:"securityProfile,omitempty"`
 
+	// ServiceMeshProfile defines the service mesh profile for the cluster.
+	// +optional
+	ServiceMeshProfile *ServiceMeshProfile `json:"serviceMeshProfile,omitempty"`
+
+	// WorkloadAutoScalerProfile defines the workload autoscaler profile for the cluster.
+	// +optional
+	WorkloadAutoScalerProfile *ManagedClusterWorkloadAutoScalerProfile `json:"workloadAutoScalerProfile,omitempty"`
+
+	// AzureMonitorProfile defines the Azure Monitor add-on profiles for monitoring the cluster.
+	// +optional
+	AzureMonitorProfile *ManagedClusterAzureMonitorProfile `json:"azureMonitorProfile,omitempty"`
+
+	// StorageProfile defines the CSI drivers and storage features enabled on the cluster.
+	// +optional
+	StorageProfile *ManagedClusterStorageProfile `json:"storageProfile,omitempty"`
+
 	// ASOManagedClusterPatches defines JSON merge patches to be applied to the generated ASO ManagedCluster resource.
 	// WARNING: This is meant to be used sparingly to enable features for development and testing that are not
 	// otherwise represented in the CAPZ API. Misconfiguration that conflicts with CAPZ's normal mode of
@@ -409,6 +427,41 @@ type AzureManagedMachinePoolClassSpec struct {
 	// +optional
 	EnableEncryptionAtHost *bool `json:"enableEncryptionAtHost,omitempty"`
 
+	// SnapshotID is the resource ID of an AKS node pool snapshot to create the node pool from. The node pool
+	// uses the Kubernetes version and node image version captured by the snapshot.
+	// Immutable.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/node-pool-snapshot
+	// +optional
+	SnapshotID *string `json:"snapshotID,omitempty"`
+
+	// ProximityPlacementGroupID is the resource ID of an existing proximity placement group to place the node pool in.
+	// The node pool must not span more than one availability zone.
+	// Immutable.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/reduce-latency-ppg
+	// +optional
+	ProximityPlacementGroupID *string `json:"proximityPlacementGroupID,omitempty"`
+
+	// CapacityReservationGroupID is the resource ID of a capacity reservation group to associate the node pool with.
+	// Immutable.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/manage-node-pools#associate-capacity-reservation-groups-to-node-pools
+	// +optional
+	CapacityReservationGroupID *string `json:"capacityReservationGroupID,omitempty"`
+
+	// HostGroupID is the resource ID of a dedicated host group to provision the node pool's VMs on.
+	// The node pool must not span more than one availability zone.
+	// Immutable.
+	// See also [AKS doc].
+	//
+	// [AKS doc]: https://learn.microsoft.com/azure/aks/use-azure-dedicated-hosts
+	// +optional
+	HostGroupID *string `json:"hostGroupID,omitempty"`
+
 	// ASOManagedClustersAgentPoolPatches defines JSON merge patches to be applied to the generated ASO ManagedClustersAgentPool resource.
 	// WARNING: This is meant to be used sparingly to enable features for development and testing that are not
 	// otherwise represented in the CAPZ API. Misconfiguration that conflicts with CAPZ's normal mode of
@@ -454,6 +507,33 @@ type NetworkClassSpec struct {
 	// PrivateDNSZoneName defines the zone name for the Azure Private DNS.
 	// +optional
 	PrivateDNSZoneName string `json:"privateDNSZoneName,omitempty"`
+
+	// ApplicationSecurityGroups are the application security groups created in the cluster's resource group.
+	// Security rules can reference them by name as source or destination, and the network interfaces of the
+	// machines join the application security groups of their role.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	ApplicationSecurityGroups []ApplicationSecurityGroup `json:"applicationSecurityGroups,omitempty"`
+}
+
+// ApplicationSecurityGroup defines an Azure application security group.
+type ApplicationSecurityGroup struct {
+	// Name is the name of the application security group.
+	Name string `json:"name"`
+
+	// Role is the role of the machines whose network interfaces join the application security group,
+	// "control-plane" or "node", or "bastion" for the network interfaces in the cluster's bastion subnets.
+	// Other machines can join it through the ApplicationSecurityGroups of their AzureMachine.
+	// +kubebuilder:validation:Enum=control-plane;node;bastion
+	// +optional
+	Role string `json:"role,omitempty"`
+}
+
+// IsApplicationSecurityGroupID returns true if ref references an application security group by resource ID rather
+// than by the name of one of the cluster's application security groups.
+func IsApplicationSecurityGroupID(ref string) bool {
+	return strings.HasPrefix(strings.ToLower(ref), "/subscriptions/")
 }
 
 // VnetClassSpec defines the VnetSpec properties that may be shared across several Azure clusters.
@@ -465,6 +545,17 @@ type VnetClassSpec struct {
 	// Tags is a collection of tags describing the resource.
 	// +optional
 	Tags Tags `json:"tags,omitempty"`
+
+	// DDoSProtectionPlanID is the resource ID of an existing DDoS protection plan to associate with the virtual network.
+	// The plan may be in another subscription, as long as the cluster identity can read it.
+	// Only applies to virtual networks managed by CAPZ.
+	// +optional
+	DDoSProtectionPlanID string `json:"ddosProtectionPlanID,omitempty"`
+
+	// DNSServers is a list of IP addresses of custom DNS servers for the virtual network.
+	// Azure-provided DNS is used if empty. Only applies to virtual networks managed by CAPZ.
+	// +optional
+	DNSServers []string `json:"dnsServers,omitempty"`
 }
 
 // SubnetClassSpec defines the SubnetSpec properties that may be shared across several Azure clusters.
@@ -473,7 +564,7 @@ type SubnetClassSpec struct {
 	Name string `json:"name"`
 
 	// Role defines the subnet role (eg. Node, ControlPlane)
-	// +kubebuilder:validation:Enum=node;control-plane;bastion;all
+	// +kubebuilder:validation:Enum=node;control-plane;bastion;all;delegated
 	Role SubnetRole `json:"role"`
 
 	// CIDRBlocks defines the subnet's address space, specified as one or more address prefixes in CIDR notation.
@@ -487,6 +578,26 @@ type SubnetClassSpec struct {
 	// PrivateEndpoints defines a list of private endpoints that should be attached to this subnet.
 	// +optional
 	PrivateEndpoints PrivateEndpoints `json:"privateEndpoints,omitempty"`
+
+	// Delegations is a list of Azure services the subnet is delegated to, e.g. Microsoft.DBforPostgreSQL/flexibleServers.
+	// A delegated subnet can only host resources of the services it is delegated to, so machines cannot be placed in it.
+	// +optional
+	Delegations SubnetDelegations `json:"delegations,omitempty"`
+
+	// ServiceEndpointPolicyIDs is a list of resource IDs of existing service endpoint policies
+	// that filter the traffic of the subnet's service endpoints.
+	// +optional
+	ServiceEndpointPolicyIDs []string `json:"serviceEndpointPolicyIDs,omitempty"`
+
+	// PrivateEndpointNetworkPolicies enables or disables network security group and route table
+	// policies for private endpoints in the subnet. Azure defaults to Disabled.
+	// +optional
+	PrivateEndpointNetworkPolicies SubnetNetworkPolicies `json:"privateEndpointNetworkPolicies,omitempty"`
+
+	// PrivateLinkServiceNetworkPolicies enables or disables network policies for private link services in the subnet.
+	// They must be Disabled to create a private link service in the subnet. Azure defaults to Enabled.
+	// +optional
+	PrivateLinkServiceNetworkPolicies SubnetNetworkPolicies `json:"privateLinkServiceNetworkPolicies,omitempty"`
 }
 
 // LoadBalancerClassSpec defines the LoadBalancerSpec properties that may be shared across several Azure clusters.
@@ -498,6 +609,67 @@ type LoadBalancerClassSpec struct {
 	// IdleTimeoutInMinutes specifies the timeout for the TCP idle connection.
 	// +optional
 	IdleTimeoutInMinutes *int32 `json:"idleTimeoutInMinutes,omitempty"`
+	// HealthProbe configures the health probe of the API server load balancing rule.
+	// Defaults to an HTTPS probe of the /readyz path on the API server port.
+	// Only supported on the API server load balancer.
+	// +optional
+	HealthProbe *LoadBalancerProbe `json:"healthProbe,omitempty"`
+	// AdditionalRules is a list of load balancing rules to create in addition to the API server rule,
+	// e.g. for konnectivity or ingress traffic.
+	// Only supported on the API server load balancer.
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	AdditionalRules []LoadBalancingRule `json:"additionalRules,omitempty"`
+}
+
+// LoadBalancerProbe defines a load balancer health probe.
+type LoadBalancerProbe struct {
+	// Protocol is the protocol of the health probe.
+	// +kubebuilder:validation:Enum=Http;Https;Tcp
+	// +optional
+	Protocol LoadBalancerProbeProtocol `json:"protocol,omitempty"`
+	// Port is the backend port the health probe connects to.
+	// Defaults to the API server port for the API server probe and to the backend port of the rule otherwise.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	// +optional
+	Port *int32 `json:"port,omitempty"`
+	// RequestPath is the URI requested for the health status of Http and Https probes.
+	// Defaults to /readyz for the API server probe and to / otherwise. Not allowed for Tcp probes.
+	// +optional
+	RequestPath string `json:"requestPath,omitempty"`
+	// IntervalInSeconds is the interval between two probe attempts. Defaults to 15.
+	// +kubebuilder:validation:Minimum=5
+	// +optional
+	IntervalInSeconds *int32 `json:"intervalInSeconds,omitempty"`
+	// NumberOfProbes is the number of consecutive failed probes after which a backend is considered unhealthy. Defaults to 4.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	NumberOfProbes *int32 `json:"numberOfProbes,omitempty"`
+}
+
+// LoadBalancingRule defines an additional load balancing rule.
+type LoadBalancingRule struct {
+	// Name is the name of the load balancing rule.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+	// Protocol is the transport protocol of the rule. Defaults to Tcp.
+	// +kubebuilder:validation:Enum=Tcp;Udp
+	// +optional
+	Protocol LoadBalancingRuleProtocol `json:"protocol,omitempty"`
+	// FrontendPort is the port on the load balancer frontend IP.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65534
+	FrontendPort int32 `json:"frontendPort"`
+	// BackendPort is the port on the backend pool members.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	BackendPort int32 `json:"backendPort"`
+	// HealthProbe configures the health probe of the rule.
+	// Defaults to a Tcp probe on the backend port.
+	// +optional
+	HealthProbe *LoadBalancerProbe `json:"healthProbe,omitempty"`
 }
 
 // FleetsMemberClassSpec defines the FleetsMemberSpec properties that may be shared across several Azure clusters.
@@ -519,6 +691,63 @@ type SecurityGroupClass struct {
 	SecurityRules SecurityRules `json:"securityRules,omitempty"`
 	// +optional
 	Tags Tags `json:"tags,omitempty"`
+	// FlowLog is the configuration of the NSG flow log of the security group.
+	// Once set, the flow log cannot be removed but it can be disabled.
+	// +optional
+	FlowLog *FlowLog `json:"flowLog,omitempty"`
+}
+
+// FlowLog defines an NSG flow log, which records the IP traffic flowing through a network security group.
+type FlowLog struct {
+	// Name is the name of the flow log. Defaults to <security group name>-flowlog.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Enabled specifies whether the flow log records traffic. Defaults to true.
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// NetworkWatcherName is the name of the Network Watcher of the cluster's region, which hosts the flow log.
+	// Defaults to NetworkWatcher_<location>, the name of the Network Watcher created automatically by Azure.
+	// +optional
+	NetworkWatcherName string `json:"networkWatcherName,omitempty"`
+
+	// NetworkWatcherResourceGroup is the resource group of the Network Watcher. Defaults to NetworkWatcherRG.
+	// +optional
+	NetworkWatcherResourceGroup string `json:"networkWatcherResourceGroup,omitempty"`
+
+	// StorageAccountID is the resource ID of the storage account the flow log records are written to.
+	// The storage account must be in the cluster's region.
+	StorageAccountID string `json:"storageAccountID"`
+
+	// RetentionDays is the number of days the flow log records are retained in the storage account.
+	// 0 retains them indefinitely. If not set, the retention policy is disabled.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=365
+	// +optional
+	RetentionDays *int32 `json:"retentionDays,omitempty"`
+
+	// TrafficAnalytics is the configuration of traffic analytics for the flow log.
+	// +optional
+	TrafficAnalytics *TrafficAnalytics `json:"trafficAnalytics,omitempty"`
+}
+
+// TrafficAnalytics defines the traffic analytics configuration of a flow log.
+type TrafficAnalytics struct {
+	// WorkspaceResourceID is the resource ID of the Log Analytics workspace traffic analytics writes to.
+	WorkspaceResourceID string `json:"workspaceResourceID"`
+
+	// WorkspaceID is the workspace ID, a GUID, of the Log Analytics workspace.
+	WorkspaceID string `json:"workspaceID"`
+
+	// WorkspaceRegion is the region of the Log Analytics workspace. Defaults to the cluster's location.
+	// +optional
+	WorkspaceRegion string `json:"workspaceRegion,omitempty"`
+
+	// IntervalInMinutes is how often, in minutes, traffic analytics processes the flow logs, 10 or 60. Defaults to 60.
+	// +kubebuilder:validation:Enum=10;60
+	// +optional
+	IntervalInMinutes *int32 `json:"intervalInMinutes,omitempty"`
 }
 
 // FrontendIPClass defines the FrontendIP properties that may be shared across several Azure clusters.
@@ -223,6 +223,36 @@ func (in *AddressRecord) DeepCopy() *AddressRecord {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolSnapshot) DeepCopyInto(out *AgentPoolSnapshot) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolSnapshot.
+func (in *AgentPoolSnapshot) DeepCopy() *AgentPoolSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AgentPoolSnapshotStatus) DeepCopyInto(out *AgentPoolSnapshotStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AgentPoolSnapshotStatus.
+func (in *AgentPoolSnapshotStatus) DeepCopy() *AgentPoolSnapshotStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AgentPoolSnapshotStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AllowedNamespaces) DeepCopyInto(out *AllowedNamespaces) {
 	*out = *in
@@ -248,6 +278,21 @@ func (in *AllowedNamespaces) DeepCopy() *AllowedNamespaces {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplicationSecurityGroup) DeepCopyInto(out *ApplicationSecurityGroup) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplicationSecurityGroup.
+func (in *ApplicationSecurityGroup) DeepCopy() *ApplicationSecurityGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplicationSecurityGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AutoScalerProfile) DeepCopyInto(out *AutoScalerProfile) {
 	*out = *in
@@ -627,6 +672,13 @@ func (in *AzureClusterStatus) DeepCopyInto(out *AzureClusterStatus) {
 		*out = make(Futures, len(*in))
 		copy(*out, *in)
 	}
+	if in.NatGateways != nil {
+		in, out := &in.NatGateways, &out.NatGateways
+		*out = make([]NatGatewayStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureClusterStatus.
@@ -948,11 +1000,46 @@ func (in *AzureMachineSpec) DeepCopyInto(out *AzureMachineSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.ApplicationSecurityGroups != nil {
+		in, out := &in.ApplicationSecurityGroups, &out.ApplicationSecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	if in.CapacityReservationGroupID != nil {
 		in, out := &in.CapacityReservationGroupID, &out.CapacityReservationGroupID
 		*out = new(string)
 		**out = **in
 	}
+	if in.ProximityPlacementGroupID != nil {
+		in, out := &in.ProximityPlacementGroupID, &out.ProximityPlacementGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProximityPlacementGroup != nil {
+		in, out := &in.ProximityPlacementGroup, &out.ProximityPlacementGroup
+		*out = new(ProximityPlacementGroup)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HostGroupID != nil {
+		in, out := &in.HostGroupID, &out.HostGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostID != nil {
+		in, out := &in.HostID, &out.HostID
+		*out = new(string)
+		**out = **in
+	}
+	if in.BootstrapTransport != nil {
+		in, out := &in.BootstrapTransport, &out.BootstrapTransport
+		*out = new(BootstrapTransport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootstrapCheck != nil {
+		in, out := &in.BootstrapCheck, &out.BootstrapCheck
+		*out = new(BootstrapCheck)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMachineSpec.
@@ -1000,6 +1087,11 @@ func (in *AzureMachineStatus) DeepCopyInto(out *AzureMachineStatus) {
 		*out = make(Futures, len(*in))
 		copy(*out, *in)
 	}
+	if in.BootstrapDiagnostics != nil {
+		in, out := &in.BootstrapDiagnostics, &out.BootstrapDiagnostics
+		*out = new(BootstrapDiagnostics)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMachineStatus.
@@ -1455,6 +1547,26 @@ func (in *AzureManagedControlPlaneClassSpec) DeepCopyInto(out *AzureManagedContr
 		*out = new(ManagedClusterSecurityProfile)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ServiceMeshProfile != nil {
+		in, out := &in.ServiceMeshProfile, &out.ServiceMeshProfile
+		*out = new(ServiceMeshProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadAutoScalerProfile != nil {
+		in, out := &in.WorkloadAutoScalerProfile, &out.WorkloadAutoScalerProfile
+		*out = new(ManagedClusterWorkloadAutoScalerProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AzureMonitorProfile != nil {
+		in, out := &in.AzureMonitorProfile, &out.AzureMonitorProfile
+		*out = new(ManagedClusterAzureMonitorProfile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.StorageProfile != nil {
+		in, out := &in.StorageProfile, &out.StorageProfile
+		*out = new(ManagedClusterStorageProfile)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ASOManagedClusterPatches != nil {
 		in, out := &in.ASOManagedClusterPatches, &out.ASOManagedClusterPatches
 		*out = make([]string, len(*in))
@@ -1561,6 +1673,11 @@ func (in *AzureManagedControlPlaneStatus) DeepCopyInto(out *AzureManagedControlP
 		*out = new(OIDCIssuerProfileStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StorageProfile != nil {
+		in, out := &in.StorageProfile, &out.StorageProfile
+		*out = new(ManagedClusterStorageProfileStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureManagedControlPlaneStatus.
@@ -1838,6 +1955,26 @@ func (in *AzureManagedMachinePoolClassSpec) DeepCopyInto(out *AzureManagedMachin
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SnapshotID != nil {
+		in, out := &in.SnapshotID, &out.SnapshotID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProximityPlacementGroupID != nil {
+		in, out := &in.ProximityPlacementGroupID, &out.ProximityPlacementGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.CapacityReservationGroupID != nil {
+		in, out := &in.CapacityReservationGroupID, &out.CapacityReservationGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostGroupID != nil {
+		in, out := &in.HostGroupID, &out.HostGroupID
+		*out = new(string)
+		**out = **in
+	}
 	if in.ASOManagedClustersAgentPoolPatches != nil {
 		in, out := &in.ASOManagedClustersAgentPoolPatches, &out.ASOManagedClustersAgentPoolPatches
 		*out = make([]string, len(*in))
@@ -1896,6 +2033,16 @@ func (in *AzureManagedMachinePoolSpec) DeepCopyInto(out *AzureManagedMachinePool
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(AgentPoolSnapshot)
+		**out = **in
+	}
+	if in.ProximityPlacementGroup != nil {
+		in, out := &in.ProximityPlacementGroup, &out.ProximityPlacementGroup
+		*out = new(ProximityPlacementGroup)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureManagedMachinePoolSpec.
@@ -1933,6 +2080,11 @@ func (in *AzureManagedMachinePoolStatus) DeepCopyInto(out *AzureManagedMachinePo
 		*out = make(Futures, len(*in))
 		copy(*out, *in)
 	}
+	if in.Snapshot != nil {
+		in, out := &in.Snapshot, &out.Snapshot
+		*out = new(AgentPoolSnapshotStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureManagedMachinePoolStatus.
@@ -2197,6 +2349,87 @@ func (in *BootDiagnostics) DeepCopy() *BootDiagnostics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapCheck) DeepCopyInto(out *BootstrapCheck) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Extension != nil {
+		in, out := &in.Extension, &out.Extension
+		*out = new(BootstrapCheckExtension)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapCheck.
+func (in *BootstrapCheck) DeepCopy() *BootstrapCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapCheckExtension) DeepCopyInto(out *BootstrapCheckExtension) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapCheckExtension.
+func (in *BootstrapCheckExtension) DeepCopy() *BootstrapCheckExtension {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapCheckExtension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapDiagnostics) DeepCopyInto(out *BootstrapDiagnostics) {
+	*out = *in
+	in.CollectionTime.DeepCopyInto(&out.CollectionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapDiagnostics.
+func (in *BootstrapDiagnostics) DeepCopy() *BootstrapDiagnostics {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapDiagnostics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BootstrapTransport) DeepCopyInto(out *BootstrapTransport) {
+	*out = *in
+	if in.StorageBlob != nil {
+		in, out := &in.StorageBlob, &out.StorageBlob
+		*out = new(StorageBlobBootstrapTransport)
+		**out = **in
+	}
+	if in.KeyVaultSecret != nil {
+		in, out := &in.KeyVaultSecret, &out.KeyVaultSecret
+		*out = new(KeyVaultSecretBootstrapTransport)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BootstrapTransport.
+func (in *BootstrapTransport) DeepCopy() *BootstrapTransport {
+	if in == nil {
+		return nil
+	}
+	out := new(BootstrapTransport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BuildParams) DeepCopyInto(out *BuildParams) {
 	*out = *in
@@ -2260,6 +2493,16 @@ func (in *DataDisk) DeepCopyInto(out *DataDisk) {
 		*out = new(ManagedDiskParameters)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ManagedDiskID != nil {
+		in, out := &in.ManagedDiskID, &out.ManagedDiskID
+		*out = new(string)
+		**out = **in
+	}
+	if in.MaxShares != nil {
+		in, out := &in.MaxShares, &out.MaxShares
+		*out = new(int32)
+		**out = **in
+	}
 	if in.Lun != nil {
 		in, out := &in.Lun, &out.Lun
 		*out = new(int32)
@@ -2300,6 +2543,11 @@ func (in *Diagnostics) DeepCopy() *Diagnostics {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiffDiskSettings) DeepCopyInto(out *DiffDiskSettings) {
 	*out = *in
+	if in.Placement != nil {
+		in, out := &in.Placement, &out.Placement
+		*out = new(DiffDiskPlacement)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiffDiskSettings.
@@ -2403,6 +2651,36 @@ func (in *FleetsMemberClassSpec) DeepCopy() *FleetsMemberClassSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowLog) DeepCopyInto(out *FlowLog) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.TrafficAnalytics != nil {
+		in, out := &in.TrafficAnalytics, &out.TrafficAnalytics
+		*out = new(TrafficAnalytics)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLog.
+func (in *FlowLog) DeepCopy() *FlowLog {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowLog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FrontendIP) DeepCopyInto(out *FrontendIP) {
 	*out = *in
@@ -2589,118 +2867,274 @@ func (in *ImagePlan) DeepCopy() *ImagePlan {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
+func (in *IstioCertificateAuthority) DeepCopyInto(out *IstioCertificateAuthority) {
 	*out = *in
-	if in.CPUManagerPolicy != nil {
-		in, out := &in.CPUManagerPolicy, &out.CPUManagerPolicy
-		*out = new(CPUManagerPolicy)
-		**out = **in
-	}
-	if in.CPUCfsQuota != nil {
-		in, out := &in.CPUCfsQuota, &out.CPUCfsQuota
-		*out = new(bool)
-		**out = **in
-	}
-	if in.CPUCfsQuotaPeriod != nil {
-		in, out := &in.CPUCfsQuotaPeriod, &out.CPUCfsQuotaPeriod
-		*out = new(string)
-		**out = **in
-	}
-	if in.ImageGcHighThreshold != nil {
-		in, out := &in.ImageGcHighThreshold, &out.ImageGcHighThreshold
-		*out = new(int)
-		**out = **in
-	}
-	if in.ImageGcLowThreshold != nil {
-		in, out := &in.ImageGcLowThreshold, &out.ImageGcLowThreshold
-		*out = new(int)
-		**out = **in
-	}
-	if in.TopologyManagerPolicy != nil {
-		in, out := &in.TopologyManagerPolicy, &out.TopologyManagerPolicy
-		*out = new(TopologyManagerPolicy)
-		**out = **in
-	}
-	if in.AllowedUnsafeSysctls != nil {
-		in, out := &in.AllowedUnsafeSysctls, &out.AllowedUnsafeSysctls
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.FailSwapOn != nil {
-		in, out := &in.FailSwapOn, &out.FailSwapOn
-		*out = new(bool)
-		**out = **in
-	}
-	if in.ContainerLogMaxSizeMB != nil {
-		in, out := &in.ContainerLogMaxSizeMB, &out.ContainerLogMaxSizeMB
-		*out = new(int)
-		**out = **in
-	}
-	if in.ContainerLogMaxFiles != nil {
-		in, out := &in.ContainerLogMaxFiles, &out.ContainerLogMaxFiles
-		*out = new(int)
-		**out = **in
-	}
-	if in.PodMaxPids != nil {
-		in, out := &in.PodMaxPids, &out.PodMaxPids
-		*out = new(int)
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(IstioPluginCertificateAuthority)
 		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
-func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioCertificateAuthority.
+func (in *IstioCertificateAuthority) DeepCopy() *IstioCertificateAuthority {
 	if in == nil {
 		return nil
 	}
-	out := new(KubeletConfig)
+	out := new(IstioCertificateAuthority)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LinuxOSConfig) DeepCopyInto(out *LinuxOSConfig) {
+func (in *IstioComponents) DeepCopyInto(out *IstioComponents) {
 	*out = *in
-	if in.SwapFileSizeMB != nil {
-		in, out := &in.SwapFileSizeMB, &out.SwapFileSizeMB
-		*out = new(int)
-		**out = **in
-	}
-	if in.Sysctls != nil {
-		in, out := &in.Sysctls, &out.Sysctls
-		*out = new(SysctlConfig)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.TransparentHugePageDefrag != nil {
-		in, out := &in.TransparentHugePageDefrag, &out.TransparentHugePageDefrag
-		*out = new(TransparentHugePageOption)
-		**out = **in
+	if in.IngressGateways != nil {
+		in, out := &in.IngressGateways, &out.IngressGateways
+		*out = make([]IstioIngressGateway, len(*in))
+		copy(*out, *in)
 	}
-	if in.TransparentHugePageEnabled != nil {
-		in, out := &in.TransparentHugePageEnabled, &out.TransparentHugePageEnabled
-		*out = new(TransparentHugePageOption)
-		**out = **in
+	if in.EgressGateways != nil {
+		in, out := &in.EgressGateways, &out.EgressGateways
+		*out = make([]IstioEgressGateway, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinuxOSConfig.
-func (in *LinuxOSConfig) DeepCopy() *LinuxOSConfig {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioComponents.
+func (in *IstioComponents) DeepCopy() *IstioComponents {
 	if in == nil {
 		return nil
 	}
-	out := new(LinuxOSConfig)
+	out := new(IstioComponents)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerClassSpec) DeepCopyInto(out *LoadBalancerClassSpec) {
+func (in *IstioEgressGateway) DeepCopyInto(out *IstioEgressGateway) {
 	*out = *in
-	if in.IdleTimeoutInMinutes != nil {
-		in, out := &in.IdleTimeoutInMinutes, &out.IdleTimeoutInMinutes
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioEgressGateway.
+func (in *IstioEgressGateway) DeepCopy() *IstioEgressGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioEgressGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioIngressGateway) DeepCopyInto(out *IstioIngressGateway) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioIngressGateway.
+func (in *IstioIngressGateway) DeepCopy() *IstioIngressGateway {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioIngressGateway)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioPluginCertificateAuthority) DeepCopyInto(out *IstioPluginCertificateAuthority) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioPluginCertificateAuthority.
+func (in *IstioPluginCertificateAuthority) DeepCopy() *IstioPluginCertificateAuthority {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioPluginCertificateAuthority)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IstioServiceMesh) DeepCopyInto(out *IstioServiceMesh) {
+	*out = *in
+	if in.Revisions != nil {
+		in, out := &in.Revisions, &out.Revisions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = new(IstioComponents)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CertificateAuthority != nil {
+		in, out := &in.CertificateAuthority, &out.CertificateAuthority
+		*out = new(IstioCertificateAuthority)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IstioServiceMesh.
+func (in *IstioServiceMesh) DeepCopy() *IstioServiceMesh {
+	if in == nil {
+		return nil
+	}
+	out := new(IstioServiceMesh)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KeyVaultSecretBootstrapTransport) DeepCopyInto(out *KeyVaultSecretBootstrapTransport) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KeyVaultSecretBootstrapTransport.
+func (in *KeyVaultSecretBootstrapTransport) DeepCopy() *KeyVaultSecretBootstrapTransport {
+	if in == nil {
+		return nil
+	}
+	out := new(KeyVaultSecretBootstrapTransport)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
+	*out = *in
+	if in.CPUManagerPolicy != nil {
+		in, out := &in.CPUManagerPolicy, &out.CPUManagerPolicy
+		*out = new(CPUManagerPolicy)
+		**out = **in
+	}
+	if in.CPUCfsQuota != nil {
+		in, out := &in.CPUCfsQuota, &out.CPUCfsQuota
+		*out = new(bool)
+		**out = **in
+	}
+	if in.CPUCfsQuotaPeriod != nil {
+		in, out := &in.CPUCfsQuotaPeriod, &out.CPUCfsQuotaPeriod
+		*out = new(string)
+		**out = **in
+	}
+	if in.ImageGcHighThreshold != nil {
+		in, out := &in.ImageGcHighThreshold, &out.ImageGcHighThreshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.ImageGcLowThreshold != nil {
+		in, out := &in.ImageGcLowThreshold, &out.ImageGcLowThreshold
+		*out = new(int)
+		**out = **in
+	}
+	if in.TopologyManagerPolicy != nil {
+		in, out := &in.TopologyManagerPolicy, &out.TopologyManagerPolicy
+		*out = new(TopologyManagerPolicy)
+		**out = **in
+	}
+	if in.AllowedUnsafeSysctls != nil {
+		in, out := &in.AllowedUnsafeSysctls, &out.AllowedUnsafeSysctls
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FailSwapOn != nil {
+		in, out := &in.FailSwapOn, &out.FailSwapOn
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ContainerLogMaxSizeMB != nil {
+		in, out := &in.ContainerLogMaxSizeMB, &out.ContainerLogMaxSizeMB
+		*out = new(int)
+		**out = **in
+	}
+	if in.ContainerLogMaxFiles != nil {
+		in, out := &in.ContainerLogMaxFiles, &out.ContainerLogMaxFiles
+		*out = new(int)
+		**out = **in
+	}
+	if in.PodMaxPids != nil {
+		in, out := &in.PodMaxPids, &out.PodMaxPids
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
+func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LinuxOSConfig) DeepCopyInto(out *LinuxOSConfig) {
+	*out = *in
+	if in.SwapFileSizeMB != nil {
+		in, out := &in.SwapFileSizeMB, &out.SwapFileSizeMB
+		*out = new(int)
+		**out = **in
+	}
+	if in.Sysctls != nil {
+		in, out := &in.Sysctls, &out.Sysctls
+		*out = new(SysctlConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TransparentHugePageDefrag != nil {
+		in, out := &in.TransparentHugePageDefrag, &out.TransparentHugePageDefrag
+		*out = new(TransparentHugePageOption)
+		**out = **in
+	}
+	if in.TransparentHugePageEnabled != nil {
+		in, out := &in.TransparentHugePageEnabled, &out.TransparentHugePageEnabled
+		*out = new(TransparentHugePageOption)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LinuxOSConfig.
+func (in *LinuxOSConfig) DeepCopy() *LinuxOSConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LinuxOSConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerClassSpec) DeepCopyInto(out *LoadBalancerClassSpec) {
+	*out = *in
+	if in.IdleTimeoutInMinutes != nil {
+		in, out := &in.IdleTimeoutInMinutes, &out.IdleTimeoutInMinutes
 		*out = new(int32)
 		**out = **in
 	}
+	if in.HealthProbe != nil {
+		in, out := &in.HealthProbe, &out.HealthProbe
+		*out = new(LoadBalancerProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdditionalRules != nil {
+		in, out := &in.AdditionalRules, &out.AdditionalRules
+		*out = make([]LoadBalancingRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerClassSpec.
@@ -2713,6 +3147,36 @@ func (in *LoadBalancerClassSpec) DeepCopy() *LoadBalancerClassSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerProbe) DeepCopyInto(out *LoadBalancerProbe) {
+	*out = *in
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IntervalInSeconds != nil {
+		in, out := &in.IntervalInSeconds, &out.IntervalInSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.NumberOfProbes != nil {
+		in, out := &in.NumberOfProbes, &out.NumberOfProbes
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerProbe.
+func (in *LoadBalancerProbe) DeepCopy() *LoadBalancerProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LoadBalancerProfile) DeepCopyInto(out *LoadBalancerProfile) {
 	*out = *in
@@ -2743,162 +3207,412 @@ func (in *LoadBalancerProfile) DeepCopyInto(out *LoadBalancerProfile) {
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerProfile.
-func (in *LoadBalancerProfile) DeepCopy() *LoadBalancerProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerProfile.
+func (in *LoadBalancerProfile) DeepCopy() *LoadBalancerProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
+	*out = *in
+	if in.FrontendIPs != nil {
+		in, out := &in.FrontendIPs, &out.FrontendIPs
+		*out = make([]FrontendIP, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.FrontendIPsCount != nil {
+		in, out := &in.FrontendIPsCount, &out.FrontendIPsCount
+		*out = new(int32)
+		**out = **in
+	}
+	out.BackendPool = in.BackendPool
+	in.LoadBalancerClassSpec.DeepCopyInto(&out.LoadBalancerClassSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
+func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancingRule) DeepCopyInto(out *LoadBalancingRule) {
+	*out = *in
+	if in.HealthProbe != nil {
+		in, out := &in.HealthProbe, &out.HealthProbe
+		*out = new(LoadBalancerProbe)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancingRule.
+func (in *LoadBalancingRule) DeepCopy() *LoadBalancingRule {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancingRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterAutoUpgradeProfile) DeepCopyInto(out *ManagedClusterAutoUpgradeProfile) {
+	*out = *in
+	if in.UpgradeChannel != nil {
+		in, out := &in.UpgradeChannel, &out.UpgradeChannel
+		*out = new(UpgradeChannel)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAutoUpgradeProfile.
+func (in *ManagedClusterAutoUpgradeProfile) DeepCopy() *ManagedClusterAutoUpgradeProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterAutoUpgradeProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterAzureMonitorProfile) DeepCopyInto(out *ManagedClusterAzureMonitorProfile) {
+	*out = *in
+	if in.Metrics != nil {
+		in, out := &in.Metrics, &out.Metrics
+		*out = new(ManagedClusterAzureMonitorProfileMetrics)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAzureMonitorProfile.
+func (in *ManagedClusterAzureMonitorProfile) DeepCopy() *ManagedClusterAzureMonitorProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterAzureMonitorProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterAzureMonitorProfileKubeStateMetrics) DeepCopyInto(out *ManagedClusterAzureMonitorProfileKubeStateMetrics) {
+	*out = *in
+	if in.MetricAnnotationsAllowList != nil {
+		in, out := &in.MetricAnnotationsAllowList, &out.MetricAnnotationsAllowList
+		*out = new(string)
+		**out = **in
+	}
+	if in.MetricLabelsAllowlist != nil {
+		in, out := &in.MetricLabelsAllowlist, &out.MetricLabelsAllowlist
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAzureMonitorProfileKubeStateMetrics.
+func (in *ManagedClusterAzureMonitorProfileKubeStateMetrics) DeepCopy() *ManagedClusterAzureMonitorProfileKubeStateMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterAzureMonitorProfileKubeStateMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterAzureMonitorProfileMetrics) DeepCopyInto(out *ManagedClusterAzureMonitorProfileMetrics) {
+	*out = *in
+	if in.KubeStateMetrics != nil {
+		in, out := &in.KubeStateMetrics, &out.KubeStateMetrics
+		*out = new(ManagedClusterAzureMonitorProfileKubeStateMetrics)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAzureMonitorProfileMetrics.
+func (in *ManagedClusterAzureMonitorProfileMetrics) DeepCopy() *ManagedClusterAzureMonitorProfileMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterAzureMonitorProfileMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterSecurityProfile) DeepCopyInto(out *ManagedClusterSecurityProfile) {
+	*out = *in
+	if in.AzureKeyVaultKms != nil {
+		in, out := &in.AzureKeyVaultKms, &out.AzureKeyVaultKms
+		*out = new(AzureKeyVaultKms)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Defender != nil {
+		in, out := &in.Defender, &out.Defender
+		*out = new(ManagedClusterSecurityProfileDefender)
+		**out = **in
+	}
+	if in.ImageCleaner != nil {
+		in, out := &in.ImageCleaner, &out.ImageCleaner
+		*out = new(ManagedClusterSecurityProfileImageCleaner)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WorkloadIdentity != nil {
+		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
+		*out = new(ManagedClusterSecurityProfileWorkloadIdentity)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfile.
+func (in *ManagedClusterSecurityProfile) DeepCopy() *ManagedClusterSecurityProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterSecurityProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterSecurityProfileDefender) DeepCopyInto(out *ManagedClusterSecurityProfileDefender) {
+	*out = *in
+	out.SecurityMonitoring = in.SecurityMonitoring
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileDefender.
+func (in *ManagedClusterSecurityProfileDefender) DeepCopy() *ManagedClusterSecurityProfileDefender {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterSecurityProfileDefender)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterSecurityProfileDefenderSecurityMonitoring) DeepCopyInto(out *ManagedClusterSecurityProfileDefenderSecurityMonitoring) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileDefenderSecurityMonitoring.
+func (in *ManagedClusterSecurityProfileDefenderSecurityMonitoring) DeepCopy() *ManagedClusterSecurityProfileDefenderSecurityMonitoring {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterSecurityProfileDefenderSecurityMonitoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterSecurityProfileImageCleaner) DeepCopyInto(out *ManagedClusterSecurityProfileImageCleaner) {
+	*out = *in
+	if in.IntervalHours != nil {
+		in, out := &in.IntervalHours, &out.IntervalHours
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileImageCleaner.
+func (in *ManagedClusterSecurityProfileImageCleaner) DeepCopy() *ManagedClusterSecurityProfileImageCleaner {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterSecurityProfileImageCleaner)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterSecurityProfileWorkloadIdentity) DeepCopyInto(out *ManagedClusterSecurityProfileWorkloadIdentity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileWorkloadIdentity.
+func (in *ManagedClusterSecurityProfileWorkloadIdentity) DeepCopy() *ManagedClusterSecurityProfileWorkloadIdentity {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterSecurityProfileWorkloadIdentity)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterStorageProfile) DeepCopyInto(out *ManagedClusterStorageProfile) {
+	*out = *in
+	if in.BlobCSIDriver != nil {
+		in, out := &in.BlobCSIDriver, &out.BlobCSIDriver
+		*out = new(ManagedClusterStorageProfileBlobCSIDriver)
+		**out = **in
+	}
+	if in.DiskCSIDriver != nil {
+		in, out := &in.DiskCSIDriver, &out.DiskCSIDriver
+		*out = new(ManagedClusterStorageProfileDiskCSIDriver)
+		**out = **in
+	}
+	if in.FileCSIDriver != nil {
+		in, out := &in.FileCSIDriver, &out.FileCSIDriver
+		*out = new(ManagedClusterStorageProfileFileCSIDriver)
+		**out = **in
+	}
+	if in.SnapshotController != nil {
+		in, out := &in.SnapshotController, &out.SnapshotController
+		*out = new(ManagedClusterStorageProfileSnapshotController)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterStorageProfile.
+func (in *ManagedClusterStorageProfile) DeepCopy() *ManagedClusterStorageProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ManagedClusterStorageProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ManagedClusterStorageProfileBlobCSIDriver) DeepCopyInto(out *ManagedClusterStorageProfileBlobCSIDriver) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterStorageProfileBlobCSIDriver.
+func (in *ManagedClusterStorageProfileBlobCSIDriver) DeepCopy() *ManagedClusterStorageProfileBlobCSIDriver {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerProfile)
+	out := new(ManagedClusterStorageProfileBlobCSIDriver)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LoadBalancerSpec) DeepCopyInto(out *LoadBalancerSpec) {
+func (in *ManagedClusterStorageProfileDiskCSIDriver) DeepCopyInto(out *ManagedClusterStorageProfileDiskCSIDriver) {
 	*out = *in
-	if in.FrontendIPs != nil {
-		in, out := &in.FrontendIPs, &out.FrontendIPs
-		*out = make([]FrontendIP, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
-	}
-	if in.FrontendIPsCount != nil {
-		in, out := &in.FrontendIPsCount, &out.FrontendIPsCount
-		*out = new(int32)
-		**out = **in
-	}
-	out.BackendPool = in.BackendPool
-	in.LoadBalancerClassSpec.DeepCopyInto(&out.LoadBalancerClassSpec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerSpec.
-func (in *LoadBalancerSpec) DeepCopy() *LoadBalancerSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterStorageProfileDiskCSIDriver.
+func (in *ManagedClusterStorageProfileDiskCSIDriver) DeepCopy() *ManagedClusterStorageProfileDiskCSIDriver {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancerSpec)
+	out := new(ManagedClusterStorageProfileDiskCSIDriver)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedClusterAutoUpgradeProfile) DeepCopyInto(out *ManagedClusterAutoUpgradeProfile) {
+func (in *ManagedClusterStorageProfileFileCSIDriver) DeepCopyInto(out *ManagedClusterStorageProfileFileCSIDriver) {
 	*out = *in
-	if in.UpgradeChannel != nil {
-		in, out := &in.UpgradeChannel, &out.UpgradeChannel
-		*out = new(UpgradeChannel)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterAutoUpgradeProfile.
-func (in *ManagedClusterAutoUpgradeProfile) DeepCopy() *ManagedClusterAutoUpgradeProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterStorageProfileFileCSIDriver.
+func (in *ManagedClusterStorageProfileFileCSIDriver) DeepCopy() *ManagedClusterStorageProfileFileCSIDriver {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedClusterAutoUpgradeProfile)
+	out := new(ManagedClusterStorageProfileFileCSIDriver)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedClusterSecurityProfile) DeepCopyInto(out *ManagedClusterSecurityProfile) {
+func (in *ManagedClusterStorageProfileSnapshotController) DeepCopyInto(out *ManagedClusterStorageProfileSnapshotController) {
 	*out = *in
-	if in.AzureKeyVaultKms != nil {
-		in, out := &in.AzureKeyVaultKms, &out.AzureKeyVaultKms
-		*out = new(AzureKeyVaultKms)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.Defender != nil {
-		in, out := &in.Defender, &out.Defender
-		*out = new(ManagedClusterSecurityProfileDefender)
-		**out = **in
-	}
-	if in.ImageCleaner != nil {
-		in, out := &in.ImageCleaner, &out.ImageCleaner
-		*out = new(ManagedClusterSecurityProfileImageCleaner)
-		(*in).DeepCopyInto(*out)
-	}
-	if in.WorkloadIdentity != nil {
-		in, out := &in.WorkloadIdentity, &out.WorkloadIdentity
-		*out = new(ManagedClusterSecurityProfileWorkloadIdentity)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfile.
-func (in *ManagedClusterSecurityProfile) DeepCopy() *ManagedClusterSecurityProfile {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterStorageProfileSnapshotController.
+func (in *ManagedClusterStorageProfileSnapshotController) DeepCopy() *ManagedClusterStorageProfileSnapshotController {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedClusterSecurityProfile)
+	out := new(ManagedClusterStorageProfileSnapshotController)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedClusterSecurityProfileDefender) DeepCopyInto(out *ManagedClusterSecurityProfileDefender) {
+func (in *ManagedClusterStorageProfileStatus) DeepCopyInto(out *ManagedClusterStorageProfileStatus) {
 	*out = *in
-	out.SecurityMonitoring = in.SecurityMonitoring
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileDefender.
-func (in *ManagedClusterSecurityProfileDefender) DeepCopy() *ManagedClusterSecurityProfileDefender {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterStorageProfileStatus.
+func (in *ManagedClusterStorageProfileStatus) DeepCopy() *ManagedClusterStorageProfileStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedClusterSecurityProfileDefender)
+	out := new(ManagedClusterStorageProfileStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedClusterSecurityProfileDefenderSecurityMonitoring) DeepCopyInto(out *ManagedClusterSecurityProfileDefenderSecurityMonitoring) {
+func (in *ManagedClusterWorkloadAutoScalerProfile) DeepCopyInto(out *ManagedClusterWorkloadAutoScalerProfile) {
 	*out = *in
+	if in.Keda != nil {
+		in, out := &in.Keda, &out.Keda
+		*out = new(ManagedClusterWorkloadAutoScalerProfileKeda)
+		**out = **in
+	}
+	if in.VerticalPodAutoscaler != nil {
+		in, out := &in.VerticalPodAutoscaler, &out.VerticalPodAutoscaler
+		*out = new(ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileDefenderSecurityMonitoring.
-func (in *ManagedClusterSecurityProfileDefenderSecurityMonitoring) DeepCopy() *ManagedClusterSecurityProfileDefenderSecurityMonitoring {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterWorkloadAutoScalerProfile.
+func (in *ManagedClusterWorkloadAutoScalerProfile) DeepCopy() *ManagedClusterWorkloadAutoScalerProfile {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedClusterSecurityProfileDefenderSecurityMonitoring)
+	out := new(ManagedClusterWorkloadAutoScalerProfile)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedClusterSecurityProfileImageCleaner) DeepCopyInto(out *ManagedClusterSecurityProfileImageCleaner) {
+func (in *ManagedClusterWorkloadAutoScalerProfileKeda) DeepCopyInto(out *ManagedClusterWorkloadAutoScalerProfileKeda) {
 	*out = *in
-	if in.IntervalHours != nil {
-		in, out := &in.IntervalHours, &out.IntervalHours
-		*out = new(int)
-		**out = **in
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileImageCleaner.
-func (in *ManagedClusterSecurityProfileImageCleaner) DeepCopy() *ManagedClusterSecurityProfileImageCleaner {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterWorkloadAutoScalerProfileKeda.
+func (in *ManagedClusterWorkloadAutoScalerProfileKeda) DeepCopy() *ManagedClusterWorkloadAutoScalerProfileKeda {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedClusterSecurityProfileImageCleaner)
+	out := new(ManagedClusterWorkloadAutoScalerProfileKeda)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ManagedClusterSecurityProfileWorkloadIdentity) DeepCopyInto(out *ManagedClusterSecurityProfileWorkloadIdentity) {
+func (in *ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler) DeepCopyInto(out *ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler) {
 	*out = *in
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterSecurityProfileWorkloadIdentity.
-func (in *ManagedClusterSecurityProfileWorkloadIdentity) DeepCopy() *ManagedClusterSecurityProfileWorkloadIdentity {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler.
+func (in *ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler) DeepCopy() *ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler {
 	if in == nil {
 		return nil
 	}
-	out := new(ManagedClusterSecurityProfileWorkloadIdentity)
+	out := new(ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -3018,7 +3732,14 @@ func (in *ManagedMachinePoolScaling) DeepCopy() *ManagedMachinePoolScaling {
 func (in *NatGateway) DeepCopyInto(out *NatGateway) {
 	*out = *in
 	in.NatGatewayIP.DeepCopyInto(&out.NatGatewayIP)
-	out.NatGatewayClassSpec = in.NatGatewayClassSpec
+	if in.AdditionalIPs != nil {
+		in, out := &in.AdditionalIPs, &out.AdditionalIPs
+		*out = make([]PublicIPSpec, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.NatGatewayClassSpec.DeepCopyInto(&out.NatGatewayClassSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGateway.
@@ -3034,6 +3755,21 @@ func (in *NatGateway) DeepCopy() *NatGateway {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NatGatewayClassSpec) DeepCopyInto(out *NatGatewayClassSpec) {
 	*out = *in
+	if in.IPsCount != nil {
+		in, out := &in.IPsCount, &out.IPsCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IPPrefix != nil {
+		in, out := &in.IPPrefix, &out.IPPrefix
+		*out = new(PublicIPPrefixSpec)
+		**out = **in
+	}
+	if in.IdleTimeoutInMinutes != nil {
+		in, out := &in.IdleTimeoutInMinutes, &out.IdleTimeoutInMinutes
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayClassSpec.
@@ -3046,9 +3782,39 @@ func (in *NatGatewayClassSpec) DeepCopy() *NatGatewayClassSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NatGatewayStatus) DeepCopyInto(out *NatGatewayStatus) {
+	*out = *in
+	if in.EgressIPs != nil {
+		in, out := &in.EgressIPs, &out.EgressIPs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressIPPrefixes != nil {
+		in, out := &in.EgressIPPrefixes, &out.EgressIPPrefixes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NatGatewayStatus.
+func (in *NatGatewayStatus) DeepCopy() *NatGatewayStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NatGatewayStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkClassSpec) DeepCopyInto(out *NetworkClassSpec) {
 	*out = *in
+	if in.ApplicationSecurityGroups != nil {
+		in, out := &in.ApplicationSecurityGroups, &out.ApplicationSecurityGroups
+		*out = make([]ApplicationSecurityGroup, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkClassSpec.
@@ -3103,7 +3869,7 @@ func (in *NetworkSpec) DeepCopyInto(out *NetworkSpec) {
 		*out = new(LoadBalancerSpec)
 		(*in).DeepCopyInto(*out)
 	}
-	out.NetworkClassSpec = in.NetworkClassSpec
+	in.NetworkClassSpec.DeepCopyInto(&out.NetworkClassSpec)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NetworkSpec.
@@ -3119,7 +3885,7 @@ func (in *NetworkSpec) DeepCopy() *NetworkSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NetworkTemplateSpec) DeepCopyInto(out *NetworkTemplateSpec) {
 	*out = *in
-	out.NetworkClassSpec = in.NetworkClassSpec
+	in.NetworkClassSpec.DeepCopyInto(&out.NetworkClassSpec)
 	in.Vnet.DeepCopyInto(&out.Vnet)
 	if in.Subnets != nil {
 		in, out := &in.Subnets, &out.Subnets
@@ -3207,7 +3973,7 @@ func (in *OSDisk) DeepCopyInto(out *OSDisk) {
 	if in.DiffDiskSettings != nil {
 		in, out := &in.DiffDiskSettings, &out.DiffDiskSettings
 		*out = new(DiffDiskSettings)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -3294,6 +4060,41 @@ func (in *PrivateLinkServiceConnection) DeepCopy() *PrivateLinkServiceConnection
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProximityPlacementGroup) DeepCopyInto(out *ProximityPlacementGroup) {
+	*out = *in
+	if in.VMSizes != nil {
+		in, out := &in.VMSizes, &out.VMSizes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProximityPlacementGroup.
+func (in *ProximityPlacementGroup) DeepCopy() *ProximityPlacementGroup {
+	if in == nil {
+		return nil
+	}
+	out := new(ProximityPlacementGroup)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PublicIPPrefixSpec) DeepCopyInto(out *PublicIPPrefixSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PublicIPPrefixSpec.
+func (in *PublicIPPrefixSpec) DeepCopy() *PublicIPPrefixSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(PublicIPPrefixSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PublicIPSpec) DeepCopyInto(out *PublicIPSpec) {
 	*out = *in
@@ -3355,9 +4156,29 @@ func (in *RateLimitSpec) DeepCopy() *RateLimitSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Route) DeepCopyInto(out *Route) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Route.
+func (in *Route) DeepCopy() *Route {
+	if in == nil {
+		return nil
+	}
+	out := new(Route)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RouteTable) DeepCopyInto(out *RouteTable) {
 	*out = *in
+	if in.Routes != nil {
+		in, out := &in.Routes, &out.Routes
+		*out = make(Routes, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RouteTable.
@@ -3370,6 +4191,25 @@ func (in *RouteTable) DeepCopy() *RouteTable {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in Routes) DeepCopyInto(out *Routes) {
+	{
+		in := &in
+		*out = make(Routes, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Routes.
+func (in Routes) DeepCopy() Routes {
+	if in == nil {
+		return nil
+	}
+	out := new(Routes)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SecurityGroup) DeepCopyInto(out *SecurityGroup) {
 	*out = *in
@@ -3403,6 +4243,11 @@ func (in *SecurityGroupClass) DeepCopyInto(out *SecurityGroupClass) {
 			(*out)[key] = val
 		}
 	}
+	if in.FlowLog != nil {
+		in, out := &in.FlowLog, &out.FlowLog
+		*out = new(FlowLog)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityGroupClass.
@@ -3474,6 +4319,16 @@ func (in *SecurityRule) DeepCopyInto(out *SecurityRule) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.SourceApplicationSecurityGroups != nil {
+		in, out := &in.SourceApplicationSecurityGroups, &out.SourceApplicationSecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DestinationApplicationSecurityGroups != nil {
+		in, out := &in.DestinationApplicationSecurityGroups, &out.DestinationApplicationSecurityGroups
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SecurityRule.
@@ -3548,6 +4403,26 @@ func (in ServiceEndpoints) DeepCopy() ServiceEndpoints {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ServiceMeshProfile) DeepCopyInto(out *ServiceMeshProfile) {
+	*out = *in
+	if in.Istio != nil {
+		in, out := &in.Istio, &out.Istio
+		*out = new(IstioServiceMesh)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ServiceMeshProfile.
+func (in *ServiceMeshProfile) DeepCopy() *ServiceMeshProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(ServiceMeshProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SpotVMOptions) DeepCopyInto(out *SpotVMOptions) {
 	*out = *in
@@ -3573,6 +4448,21 @@ func (in *SpotVMOptions) DeepCopy() *SpotVMOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageBlobBootstrapTransport) DeepCopyInto(out *StorageBlobBootstrapTransport) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageBlobBootstrapTransport.
+func (in *StorageBlobBootstrapTransport) DeepCopy() *StorageBlobBootstrapTransport {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageBlobBootstrapTransport)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetClassSpec) DeepCopyInto(out *SubnetClassSpec) {
 	*out = *in
@@ -3595,6 +4485,16 @@ func (in *SubnetClassSpec) DeepCopyInto(out *SubnetClassSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Delegations != nil {
+		in, out := &in.Delegations, &out.Delegations
+		*out = make(SubnetDelegations, len(*in))
+		copy(*out, *in)
+	}
+	if in.ServiceEndpointPolicyIDs != nil {
+		in, out := &in.ServiceEndpointPolicyIDs, &out.ServiceEndpointPolicyIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetClassSpec.
@@ -3607,11 +4507,45 @@ func (in *SubnetClassSpec) DeepCopy() *SubnetClassSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SubnetDelegation) DeepCopyInto(out *SubnetDelegation) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetDelegation.
+func (in *SubnetDelegation) DeepCopy() *SubnetDelegation {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetDelegation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in SubnetDelegations) DeepCopyInto(out *SubnetDelegations) {
+	{
+		in := &in
+		*out = make(SubnetDelegations, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetDelegations.
+func (in SubnetDelegations) DeepCopy() SubnetDelegations {
+	if in == nil {
+		return nil
+	}
+	out := new(SubnetDelegations)
+	in.DeepCopyInto(out)
+	return *out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SubnetSpec) DeepCopyInto(out *SubnetSpec) {
 	*out = *in
 	in.SecurityGroup.DeepCopyInto(&out.SecurityGroup)
-	out.RouteTable = in.RouteTable
+	in.RouteTable.DeepCopyInto(&out.RouteTable)
 	in.NatGateway.DeepCopyInto(&out.NatGateway)
 	in.SubnetClassSpec.DeepCopyInto(&out.SubnetClassSpec)
 }
@@ -3631,7 +4565,7 @@ func (in *SubnetTemplateSpec) DeepCopyInto(out *SubnetTemplateSpec) {
 	*out = *in
 	in.SubnetClassSpec.DeepCopyInto(&out.SubnetClassSpec)
 	in.SecurityGroup.DeepCopyInto(&out.SecurityGroup)
-	out.NatGateway = in.NatGateway
+	in.NatGateway.DeepCopyInto(&out.NatGateway)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SubnetTemplateSpec.
@@ -3911,6 +4845,26 @@ func (in Taints) DeepCopy() Taints {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TrafficAnalytics) DeepCopyInto(out *TrafficAnalytics) {
+	*out = *in
+	if in.IntervalInMinutes != nil {
+		in, out := &in.IntervalInMinutes, &out.IntervalInMinutes
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficAnalytics.
+func (in *TrafficAnalytics) DeepCopy() *TrafficAnalytics {
+	if in == nil {
+		return nil
+	}
+	out := new(TrafficAnalytics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UefiSettings) DeepCopyInto(out *UefiSettings) {
 	*out = *in
@@ -4030,6 +4984,11 @@ func (in *VnetClassSpec) DeepCopyInto(out *VnetClassSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.DNSServers != nil {
+		in, out := &in.DNSServers, &out.DNSServers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VnetClassSpec.
@@ -4045,6 +5004,11 @@ func (in *VnetClassSpec) DeepCopy() *VnetClassSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VnetPeeringClassSpec) DeepCopyInto(out *VnetPeeringClassSpec) {
 	*out = *in
+	if in.ReversePeeringIdentityRef != nil {
+		in, out := &in.ReversePeeringIdentityRef, &out.ReversePeeringIdentityRef
+		*out = new(corev1.ObjectReference)
+		**out = **in
+	}
 	in.ForwardPeeringProperties.DeepCopyInto(&out.ForwardPeeringProperties)
 	in.ReversePeeringProperties.DeepCopyInto(&out.ReversePeeringProperties)
 }
@@ -41,6 +41,36 @@ const (
 	// for annotation formatting rules.
 	SecurityRuleLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-security-rules"
 
+	// RouteLastAppliedAnnotation is the key for the Azure Cluster
+	// object annotation which tracks the routes for route tables.
+	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
+	// for annotation formatting rules.
+	RouteLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-routes"
+
+	// LoadBalancingRuleLastAppliedAnnotation is the key for the Azure Cluster
+	// object annotation which tracks the additional load balancing rules for load balancers.
+	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
+	// for annotation formatting rules.
+	LoadBalancingRuleLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-lb-rules"
+
+	// DiskSizeLastAppliedAnnotation is the key for the machine object annotation
+	// which tracks the sizes of the data disks created by CAPZ.
+	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
+	// for annotation formatting rules.
+	DiskSizeLastAppliedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-last-applied-disk-sizes"
+
+	// BootstrapDataHashAnnotation is the key for the machine object annotation
+	// which tracks the hash of the bootstrap data stored outside of the VM's custom data.
+	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
+	// for annotation formatting rules.
+	BootstrapDataHashAnnotation = "sigs.k8s.io/cluster-api-provider-azure-bootstrap-data-hash"
+
+	// VMSizeValidatedAnnotation is the key for the machine pool object annotation
+	// which tracks a hash of the VM size and the requirements it was last found compatible with.
+	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
+	// for annotation formatting rules.
+	VMSizeValidatedAnnotation = "sigs.k8s.io/cluster-api-provider-azure-validated-vm-size"
+
 	// CustomDataHashAnnotation is the key for the machine object annotation
 	// which tracks the hash of the custom data.
 	// See https://kubernetes.io/docs/concepts/overview/working-with-objects/annotations/
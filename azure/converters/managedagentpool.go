@@ -53,6 +53,7 @@ func AgentPoolToManagedClusterAgentPoolProfile(pool *asocontainerservicev1hub.Ma
 		LinuxOSConfig:               properties.LinuxOSConfig,
 		EnableFIPS:                  properties.EnableFIPS,
 		EnableEncryptionAtHost:      properties.EnableEncryptionAtHost,
+		CreationData:                properties.CreationData,
 	}
 	if properties.KubeletConfig != nil {
 		agentPool.KubeletConfig = properties.KubeletConfig
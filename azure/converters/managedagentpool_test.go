@@ -62,6 +62,11 @@ func Test_AgentPoolToManagedClusterAgentPoolProfile(t *testing.T) {
 					},
 					EnableFIPS:             ptr.To(true),
 					EnableEncryptionAtHost: ptr.To(true),
+					CreationData: &asocontainerservicev1hub.CreationData{
+						SourceResourceReference: &genruntime.ResourceReference{
+							ARMID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-123/providers/Microsoft.ContainerService/snapshots/snapshot-123",
+						},
+					},
 				},
 			},
 
@@ -93,6 +98,11 @@ func Test_AgentPoolToManagedClusterAgentPoolProfile(t *testing.T) {
 					},
 					EnableFIPS:             ptr.To(true),
 					EnableEncryptionAtHost: ptr.To(true),
+					CreationData: &asocontainerservicev1hub.CreationData{
+						SourceResourceReference: &genruntime.ResourceReference{
+							ARMID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/rg-123/providers/Microsoft.ContainerService/snapshots/snapshot-123",
+						},
+					},
 				}))
 			},
 		},
@@ -0,0 +1,38 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converters
+
+import (
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+// RouteToSDK converts a CAPZ route to an Azure route.
+func RouteToSDK(route infrav1.Route) *armnetwork.Route {
+	sdkRoute := &armnetwork.Route{
+		Name: ptr.To(route.Name),
+		Properties: &armnetwork.RoutePropertiesFormat{
+			AddressPrefix: ptr.To(route.AddressPrefix),
+			NextHopType:   ptr.To(armnetwork.RouteNextHopType(route.NextHopType)),
+		},
+	}
+	if route.NextHopIPAddress != "" {
+		sdkRoute.Properties.NextHopIPAddress = ptr.To(route.NextHopIPAddress)
+	}
+	return sdkRoute
+}
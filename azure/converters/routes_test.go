@@ -0,0 +1,73 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package converters
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+func TestRouteToSDK(t *testing.T) {
+	tests := []struct {
+		name  string
+		route infrav1.Route
+		want  *armnetwork.Route
+	}{
+		{
+			name: "virtual appliance route",
+			route: infrav1.Route{
+				Name:             "to-nva",
+				AddressPrefix:    "0.0.0.0/0",
+				NextHopType:      infrav1.RouteNextHopTypeVirtualAppliance,
+				NextHopIPAddress: "10.0.0.4",
+			},
+			want: &armnetwork.Route{
+				Name: ptr.To("to-nva"),
+				Properties: &armnetwork.RoutePropertiesFormat{
+					AddressPrefix:    ptr.To("0.0.0.0/0"),
+					NextHopType:      ptr.To(armnetwork.RouteNextHopTypeVirtualAppliance),
+					NextHopIPAddress: ptr.To("10.0.0.4"),
+				},
+			},
+		},
+		{
+			name: "virtual network gateway route",
+			route: infrav1.Route{
+				Name:          "to-onprem",
+				AddressPrefix: "192.168.0.0/16",
+				NextHopType:   infrav1.RouteNextHopTypeVirtualNetworkGateway,
+			},
+			want: &armnetwork.Route{
+				Name: ptr.To("to-onprem"),
+				Properties: &armnetwork.RoutePropertiesFormat{
+					AddressPrefix: ptr.To("192.168.0.0/16"),
+					NextHopType:   ptr.To(armnetwork.RouteNextHopTypeVirtualNetworkGateway),
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			g.Expect(RouteToSDK(tt.route)).To(Equal(tt.want))
+		})
+	}
+}
@@ -38,6 +38,13 @@ func SecurityRuleToSDK(rule infrav1.SecurityRule) *armnetwork.SecurityRule {
 		},
 	}
 
+	for _, id := range rule.SourceApplicationSecurityGroups {
+		secRule.Properties.SourceApplicationSecurityGroups = append(secRule.Properties.SourceApplicationSecurityGroups, &armnetwork.ApplicationSecurityGroup{ID: ptr.To(id)})
+	}
+	for _, id := range rule.DestinationApplicationSecurityGroups {
+		secRule.Properties.DestinationApplicationSecurityGroups = append(secRule.Properties.DestinationApplicationSecurityGroups, &armnetwork.ApplicationSecurityGroup{ID: ptr.To(id)})
+	}
+
 	switch rule.Protocol {
 	case infrav1.SecurityGroupProtocolAll:
 		secRule.Properties.Protocol = ptr.To(armnetwork.SecurityRuleProtocolAsterisk)
@@ -18,6 +18,7 @@ package azure
 
 import (
 	"fmt"
+	"math"
 	"net/http"
 	"regexp"
 	"strings"
@@ -26,6 +27,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	"sigs.k8s.io/cluster-api-provider-azure/version"
 )
@@ -89,6 +91,11 @@ const (
 	ControlPlaneNodeGroup = "control-plane"
 )
 
+const (
+	// DefaultNetworkWatcherResourceGroup is the resource group of the Network Watchers created automatically by Azure.
+	DefaultNetworkWatcherResourceGroup = "NetworkWatcherRG"
+)
+
 const (
 	// bootstrapExtensionRetries is the number of retries in the BootstrapExtensionCommand.
 	// NOTE: the overall timeout will be number of retries * retry sleep, in this case 60 * 5s = 300s.
@@ -98,6 +105,8 @@ const (
 	// bootstrapSentinelFile is the file written by bootstrap provider on machines to indicate successful bootstrapping,
 	// as defined by the Cluster API Bootstrap Provider contract (https://cluster-api.sigs.k8s.io/developer/providers/bootstrap.html).
 	bootstrapSentinelFile = "/run/cluster-api/bootstrap-success.complete"
+	// bootstrapExtensionPublisher is the publisher of the CAPZ bootstrapping VM extension.
+	bootstrapExtensionPublisher = "Microsoft.Azure.ContainerUpstream"
 )
 
 const (
@@ -110,12 +119,20 @@ const (
 
 var (
 	// LinuxBootstrapExtensionCommand is the command the VM bootstrap extension will execute to verify Linux nodes bootstrap completes successfully.
-	LinuxBootstrapExtensionCommand = fmt.Sprintf("for i in $(seq 1 %d); do test -f %s && break; if [ $i -eq %d ]; then exit 1; else sleep %d; fi; done", bootstrapExtensionRetries, bootstrapSentinelFile, bootstrapExtensionRetries, bootstrapExtensionSleep)
+	LinuxBootstrapExtensionCommand = linuxBootstrapExtensionCommand(bootstrapExtensionRetries)
 	// WindowsBootstrapExtensionCommand is the command the VM bootstrap extension will execute to verify Windows nodes bootstrap completes successfully.
-	WindowsBootstrapExtensionCommand = fmt.Sprintf("powershell.exe -Command \"for ($i = 0; $i -lt %d; $i++) {if (Test-Path '%s') {exit 0} else {Start-Sleep -Seconds %d}} exit -2\"",
-		bootstrapExtensionRetries, bootstrapSentinelFile, bootstrapExtensionSleep)
+	WindowsBootstrapExtensionCommand = windowsBootstrapExtensionCommand(bootstrapExtensionRetries)
 )
 
+func linuxBootstrapExtensionCommand(retries int) string {
+	return fmt.Sprintf("for i in $(seq 1 %d); do test -f %s && break; if [ $i -eq %d ]; then exit 1; else sleep %d; fi; done", retries, bootstrapSentinelFile, retries, bootstrapExtensionSleep)
+}
+
+func windowsBootstrapExtensionCommand(retries int) string {
+	return fmt.Sprintf("powershell.exe -Command \"for ($i = 0; $i -lt %d; $i++) {if (Test-Path '%s') {exit 0} else {Start-Sleep -Seconds %d}} exit -2\"",
+		retries, bootstrapSentinelFile, bootstrapExtensionSleep)
+}
+
 // GenerateBackendAddressPoolName generates a load balancer backend address pool name.
 func GenerateBackendAddressPoolName(lbName string) string {
 	return fmt.Sprintf("%s-%s", lbName, "backendPool")
@@ -202,6 +219,25 @@ func GenerateAvailabilitySetName(clusterName, nodeGroup string) string {
 	return fmt.Sprintf("%s_%s-as", clusterName, nodeGroup)
 }
 
+// GenerateProximityPlacementGroupName generates the name of the proximity placement group used in a failure domain.
+// Proximity placement groups can't span availability zones, so each failure domain gets its own.
+func GenerateProximityPlacementGroupName(name, failureDomain string) string {
+	if failureDomain == "" {
+		return name
+	}
+	return fmt.Sprintf("%s-%s", name, failureDomain)
+}
+
+// GenerateNetworkWatcherName generates the name of the Network Watcher created automatically by Azure in a location.
+func GenerateNetworkWatcherName(location string) string {
+	return fmt.Sprintf("NetworkWatcher_%s", location)
+}
+
+// GenerateFlowLogName generates the name of the flow log of a network security group.
+func GenerateFlowLogName(nsgName string) string {
+	return fmt.Sprintf("%s-flowlog", nsgName)
+}
+
 // WithIndex appends the index as suffix to a generated name.
 func WithIndex(name string, n int) string {
 	return fmt.Sprintf("%s-%d", name, n)
@@ -232,6 +268,15 @@ func PublicIPID(subscriptionID, resourceGroup, ipName string) string {
 	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicIPAddresses/%s", subscriptionID, resourceGroup, ipName)
 }
 
+// PublicIPSpecID returns the azure resource ID of the public IP described by a PublicIPSpec: the ID of the existing
+// public IP it references if any, or the ID of the public IP CAPZ creates in the given resource group.
+func PublicIPSpecID(ip infrav1.PublicIPSpec, subscriptionID, resourceGroup string) string {
+	if ip.ID != "" {
+		return ip.ID
+	}
+	return PublicIPID(subscriptionID, resourceGroup, ip.Name)
+}
+
 // PublicIPPrefixID returns the azure resource ID for a given public IP prefix.
 func PublicIPPrefixID(subscriptionID, resourceGroup, ipName string) string {
 	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/publicipprefixes/%s", subscriptionID, resourceGroup, ipName)
@@ -247,6 +292,11 @@ func SecurityGroupID(subscriptionID, resourceGroup, nsgName string) string {
 	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/networkSecurityGroups/%s", subscriptionID, resourceGroup, nsgName)
 }
 
+// ApplicationSecurityGroupID returns the azure resource ID for a given application security group.
+func ApplicationSecurityGroupID(subscriptionID, resourceGroup, asgName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/applicationSecurityGroups/%s", subscriptionID, resourceGroup, asgName)
+}
+
 // NatGatewayID returns the azure resource ID for a given NAT gateway.
 func NatGatewayID(subscriptionID, resourceGroup, natgatewayName string) string {
 	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/natGateways/%s", subscriptionID, resourceGroup, natgatewayName)
@@ -282,6 +332,11 @@ func AvailabilitySetID(subscriptionID, resourceGroup, availabilitySetName string
 	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/availabilitySets/%s", subscriptionID, resourceGroup, availabilitySetName)
 }
 
+// ProximityPlacementGroupID returns the azure resource ID for a given proximity placement group.
+func ProximityPlacementGroupID(subscriptionID, resourceGroup, proximityPlacementGroupName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Compute/proximityPlacementGroups/%s", subscriptionID, resourceGroup, proximityPlacementGroupName)
+}
+
 // PrivateDNSZoneID returns the azure resource ID for a given private DNS zone.
 func PrivateDNSZoneID(subscriptionID, resourceGroup, privateDNSZoneName string) string {
 	return fmt.Sprintf("subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/privateDnsZones/%s", subscriptionID, resourceGroup, privateDNSZoneName)
@@ -297,6 +352,11 @@ func ManagedClusterID(subscriptionID, resourceGroup, managedClusterName string)
 	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s", subscriptionID, resourceGroup, managedClusterName)
 }
 
+// AgentPoolID returns the azure resource ID for a given managed cluster agent pool.
+func AgentPoolID(subscriptionID, resourceGroup, managedClusterName, agentPoolName string) string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/managedClusters/%s/agentPools/%s", subscriptionID, resourceGroup, managedClusterName, agentPoolName)
+}
+
 // FleetID returns the azure resource ID for a given fleet manager.
 func FleetID(subscriptionID, resourceGroup, fleetName string) string {
 	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ContainerService/fleets/%s", subscriptionID, resourceGroup, fleetName)
@@ -307,9 +367,20 @@ func FleetID(subscriptionID, resourceGroup, fleetName string) string {
 // https://learn.microsoft.com/azure/virtual-machines/extensions/custom-script-windows for Windows.
 // This extension allows running arbitrary scripts on the VM.
 // Its role is to detect and report Kubernetes bootstrap failure or success.
-func GetBootstrappingVMExtension(osType string, cloud string, vmName string, cpuArchitectureType string) *ExtensionSpec {
-	// currently, the bootstrap extension is only available in AzurePublicCloud.
-	if osType == LinuxOS && cloud == PublicCloudName {
+// The command, timeout and extension handler can be overridden with a BootstrapCheck, which can also disable the extension.
+func GetBootstrappingVMExtension(osType string, cloud string, vmName string, cpuArchitectureType string, check *infrav1.BootstrapCheck) *ExtensionSpec {
+	if check != nil && check.Disabled {
+		return nil
+	}
+
+	retries := bootstrapExtensionRetries
+	if check != nil && check.Timeout != nil {
+		retries = int(math.Ceil(check.Timeout.Seconds() / bootstrapExtensionSleep))
+	}
+
+	var extension *ExtensionSpec
+	switch osType {
+	case LinuxOS:
 		// The command checks for the existence of the bootstrapSentinelFile on the machine, with retries and sleep between retries.
 		// We set the version to 1.1 (will target 1.1.1) for arm64 machines and 1.0 for x64. This is due to a known issue with newer versions of
 		// Go on Ubuntu 20.04. The issue is being tracked here: https://github.com/golang/go/issues/58550
@@ -319,30 +390,49 @@ func GetBootstrappingVMExtension(osType string, cloud string, vmName string, cpu
 		if cpuArchitectureType == string(armcompute.ArchitectureTypesArm64) {
 			extensionVersion = "1.1"
 		}
-		return &ExtensionSpec{
+		extension = &ExtensionSpec{
 			Name:      BootstrappingExtensionLinux,
 			VMName:    vmName,
-			Publisher: "Microsoft.Azure.ContainerUpstream",
+			Publisher: bootstrapExtensionPublisher,
 			Version:   extensionVersion,
 			ProtectedSettings: map[string]string{
-				"commandToExecute": LinuxBootstrapExtensionCommand,
+				"commandToExecute": linuxBootstrapExtensionCommand(retries),
 			},
 		}
-	} else if osType == WindowsOS && cloud == PublicCloudName {
+	case WindowsOS:
 		// This command for the existence of the bootstrapSentinelFile on the machine, with retries and sleep between reties.
 		// If the file is not present after the retries are exhausted the extension fails with return code '-2' - ERROR_FILE_NOT_FOUND.
-		return &ExtensionSpec{
+		extension = &ExtensionSpec{
 			Name:      BootstrappingExtensionWindows,
 			VMName:    vmName,
-			Publisher: "Microsoft.Azure.ContainerUpstream",
+			Publisher: bootstrapExtensionPublisher,
 			Version:   "1.0",
 			ProtectedSettings: map[string]string{
-				"commandToExecute": WindowsBootstrapExtensionCommand,
+				"commandToExecute": windowsBootstrapExtensionCommand(retries),
 			},
 		}
+	default:
+		return nil
+	}
+
+	if check != nil && check.Command != "" {
+		extension.ProtectedSettings["commandToExecute"] = check.Command
+	}
+
+	// The extension keeps its CAPZ name when another handler runs the check, so its status can still be found on the VM.
+	if check != nil && check.Extension != nil {
+		extension.Publisher = check.Extension.Publisher
+		extension.Type = check.Extension.Type
+		extension.Version = check.Extension.Version
+		return extension
+	}
+
+	// currently, the bootstrap extension is only available in AzurePublicCloud.
+	if cloud != PublicCloudName {
+		return nil
 	}
 
-	return nil
+	return extension
 }
 
 // UserAgent specifies a string to append to the agent identifier.
@@ -22,12 +22,15 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/cloud"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/mock_azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
@@ -218,7 +221,9 @@ func TestGetBootstrappingVMExtension(t *testing.T) {
 		cloud           string
 		vmName          string
 		cpuArchitecture string
+		check           *infrav1.BootstrapCheck
 		expectedVersion string
+		expectedCommand string
 		expectNil       bool
 	}{
 		{
@@ -263,16 +268,79 @@ func TestGetBootstrappingVMExtension(t *testing.T) {
 			expectedVersion: "1.0",
 			expectNil:       true,
 		},
+		{
+			name:            "Disabled bootstrap check",
+			osType:          LinuxOS,
+			cloud:           PublicCloudName,
+			vmName:          "test-vm",
+			cpuArchitecture: "x64",
+			check:           &infrav1.BootstrapCheck{Disabled: true},
+			expectNil:       true,
+		},
+		{
+			name:            "Linux OS, custom command",
+			osType:          LinuxOS,
+			cloud:           PublicCloudName,
+			vmName:          "test-vm",
+			cpuArchitecture: "x64",
+			check:           &infrav1.BootstrapCheck{Command: "systemctl is-active kubelet"},
+			expectedVersion: "1.0",
+			expectedCommand: "systemctl is-active kubelet",
+		},
+		{
+			name:            "Linux OS, custom timeout",
+			osType:          LinuxOS,
+			cloud:           PublicCloudName,
+			vmName:          "test-vm",
+			cpuArchitecture: "x64",
+			check:           &infrav1.BootstrapCheck{Timeout: &metav1.Duration{Duration: 21 * time.Minute}},
+			expectedVersion: "1.0",
+			expectedCommand: "for i in $(seq 1 252); do test -f /run/cluster-api/bootstrap-success.complete && break; if [ $i -eq 252 ]; then exit 1; else sleep 5; fi; done",
+		},
+		{
+			name:            "Windows OS, custom timeout",
+			osType:          WindowsOS,
+			cloud:           PublicCloudName,
+			vmName:          "test-vm",
+			cpuArchitecture: "x64",
+			check:           &infrav1.BootstrapCheck{Timeout: &metav1.Duration{Duration: 62 * time.Second}},
+			expectedVersion: "1.0",
+			expectedCommand: "powershell.exe -Command \"for ($i = 0; $i -lt 13; $i++) {if (Test-Path '/run/cluster-api/bootstrap-success.complete') {exit 0} else {Start-Sleep -Seconds 5}} exit -2\"",
+		},
+		{
+			name:            "Custom extension handler outside of Public Cloud",
+			osType:          LinuxOS,
+			cloud:           USGovernmentCloudName,
+			vmName:          "test-vm",
+			cpuArchitecture: "x64",
+			check: &infrav1.BootstrapCheck{
+				Extension: &infrav1.BootstrapCheckExtension{
+					Publisher: "Microsoft.Azure.Extensions",
+					Type:      "CustomScript",
+					Version:   "2.1",
+				},
+			},
+			expectedVersion: "2.1",
+			expectedCommand: LinuxBootstrapExtensionCommand,
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
 			g := NewWithT(t)
-			actualExtension := GetBootstrappingVMExtension(tc.osType, tc.cloud, tc.vmName, tc.cpuArchitecture)
+			actualExtension := GetBootstrappingVMExtension(tc.osType, tc.cloud, tc.vmName, tc.cpuArchitecture, tc.check)
 			if tc.expectNil {
 				g.Expect(actualExtension).To(BeNil())
 			} else {
 				g.Expect(actualExtension.Version).To(Equal(tc.expectedVersion))
+				if tc.expectedCommand != "" {
+					g.Expect(actualExtension.ProtectedSettings).To(HaveKeyWithValue("commandToExecute", tc.expectedCommand))
+				}
+				if tc.check != nil && tc.check.Extension != nil {
+					g.Expect(actualExtension.Name).To(Equal(BootstrappingExtensionLinux))
+					g.Expect(actualExtension.Publisher).To(Equal(tc.check.Extension.Publisher))
+					g.Expect(actualExtension.ExtensionType()).To(Equal(tc.check.Extension.Type))
+				}
 			}
 		})
 	}
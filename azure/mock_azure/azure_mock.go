@@ -392,6 +392,20 @@ func (mr *MockNetworkDescriberMockRecorder) APIServerLBPoolName() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "APIServerLBPoolName", reflect.TypeOf((*MockNetworkDescriber)(nil).APIServerLBPoolName))
 }
 
+// ApplicationSecurityGroups mocks base method.
+func (m *MockNetworkDescriber) ApplicationSecurityGroups() []v1beta1.ApplicationSecurityGroup {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationSecurityGroups")
+	ret0, _ := ret[0].([]v1beta1.ApplicationSecurityGroup)
+	return ret0
+}
+
+// ApplicationSecurityGroups indicates an expected call of ApplicationSecurityGroups.
+func (mr *MockNetworkDescriberMockRecorder) ApplicationSecurityGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationSecurityGroups", reflect.TypeOf((*MockNetworkDescriber)(nil).ApplicationSecurityGroups))
+}
+
 // ControlPlaneRouteTable mocks base method.
 func (m *MockNetworkDescriber) ControlPlaneRouteTable() v1beta1.RouteTable {
 	m.ctrl.T.Helper()
@@ -1144,6 +1158,20 @@ func (mr *MockClusterScoperMockRecorder) AdditionalTags() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdditionalTags", reflect.TypeOf((*MockClusterScoper)(nil).AdditionalTags))
 }
 
+// ApplicationSecurityGroups mocks base method.
+func (m *MockClusterScoper) ApplicationSecurityGroups() []v1beta1.ApplicationSecurityGroup {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationSecurityGroups")
+	ret0, _ := ret[0].([]v1beta1.ApplicationSecurityGroup)
+	return ret0
+}
+
+// ApplicationSecurityGroups indicates an expected call of ApplicationSecurityGroups.
+func (mr *MockClusterScoperMockRecorder) ApplicationSecurityGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationSecurityGroups", reflect.TypeOf((*MockClusterScoper)(nil).ApplicationSecurityGroups))
+}
+
 // AvailabilitySetEnabled mocks base method.
 func (m *MockClusterScoper) AvailabilitySetEnabled() bool {
 	m.ctrl.T.Helper()
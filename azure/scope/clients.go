@@ -43,6 +43,11 @@ func (c *AzureClients) CloudEnvironment() string {
 	return c.Environment.Name
 }
 
+// BaseURI returns the Azure ResourceManagerEndpoint.
+func (c *AzureClients) BaseURI() string {
+	return c.ResourceManagerEndpoint
+}
+
 // TenantID returns the Azure tenant id the controller runs in.
 func (c *AzureClients) TenantID() string {
 	return c.Values["AZURE_TENANT_ID"]
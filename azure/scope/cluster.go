@@ -29,12 +29,15 @@ import (
 	asonetworkv1api20220701 "github.com/Azure/azure-service-operator/v2/api/network/v1api20220701"
 	asoresourcesv1 "github.com/Azure/azure-service-operator/v2/api/resources/v1api20200601"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/net"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/applicationsecuritygroups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bastionhosts"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/flowlogs"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/groups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/loadbalancers"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/natgateways"
@@ -46,6 +49,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/subnets"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/virtualnetworks"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/vnetpeerings"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/futures"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -121,6 +125,10 @@ type ClusterScope struct {
 // ClusterCache stores ClusterCache data locally so we don't have to hit the API multiple times within the same reconcile loop.
 type ClusterCache struct {
 	isVnetManaged *bool
+	// publicIPAddresses and publicIPPrefixes map the names of the public IPs and public IP prefixes
+	// reconciled in this loop to their address or prefix.
+	publicIPAddresses map[string]string
+	publicIPPrefixes  map[string]string
 }
 
 // BaseURI returns the Azure ResourceManagerEndpoint.
@@ -155,7 +163,7 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 			for _, ip := range s.ControlPlaneOutboundLB().FrontendIPs {
 				controlPlaneOutboundIPSpecs = append(controlPlaneOutboundIPSpecs, &publicips.PublicIPSpec{
 					Name:             ip.PublicIP.Name,
-					ResourceGroup:    s.ResourceGroup(),
+					ResourceGroup:    s.publicIPResourceGroup(ip.PublicIP.ID),
 					ClusterName:      s.ClusterName(),
 					DNSName:          "",    // Set to default value
 					IsIPv6:           false, // Set to default value
@@ -163,6 +171,8 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 					ExtendedLocation: s.ExtendedLocation(),
 					FailureDomains:   s.FailureDomains(),
 					AdditionalTags:   s.AdditionalTags(),
+					ID:               ip.PublicIP.ID,
+					PublicIPPrefixID: ip.PublicIP.PublicIPPrefixID,
 				})
 			}
 		}
@@ -170,7 +180,7 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 		controlPlaneOutboundIPSpecs = []azure.ResourceSpecGetter{
 			&publicips.PublicIPSpec{
 				Name:             s.APIServerPublicIP().Name,
-				ResourceGroup:    s.ResourceGroup(),
+				ResourceGroup:    s.publicIPResourceGroup(s.APIServerPublicIP().ID),
 				DNSName:          s.APIServerPublicIP().DNSName,
 				IsIPv6:           false, // Currently azure requires an IPv4 lb rule to enable IPv6
 				ClusterName:      s.ClusterName(),
@@ -179,6 +189,8 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 				FailureDomains:   s.FailureDomains(),
 				AdditionalTags:   s.AdditionalTags(),
 				IPTags:           s.APIServerPublicIP().IPTags,
+				ID:               s.APIServerPublicIP().ID,
+				PublicIPPrefixID: s.APIServerPublicIP().PublicIPPrefixID,
 			},
 		}
 	}
@@ -189,7 +201,7 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 		for _, ip := range s.NodeOutboundLB().FrontendIPs {
 			publicIPSpecs = append(publicIPSpecs, &publicips.PublicIPSpec{
 				Name:             ip.PublicIP.Name,
-				ResourceGroup:    s.ResourceGroup(),
+				ResourceGroup:    s.publicIPResourceGroup(ip.PublicIP.ID),
 				ClusterName:      s.ClusterName(),
 				DNSName:          "",    // Set to default value
 				IsIPv6:           false, // Set to default value
@@ -197,6 +209,8 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 				ExtendedLocation: s.ExtendedLocation(),
 				FailureDomains:   s.FailureDomains(),
 				AdditionalTags:   s.AdditionalTags(),
+				ID:               ip.PublicIP.ID,
+				PublicIPPrefixID: ip.PublicIP.PublicIPPrefixID,
 			})
 		}
 	}
@@ -205,17 +219,21 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 	var nodeNatGatewayIPSpecs []azure.ResourceSpecGetter
 	for _, subnet := range s.NodeSubnets() {
 		if subnet.IsNatGatewayEnabled() {
-			nodeNatGatewayIPSpecs = append(nodeNatGatewayIPSpecs, &publicips.PublicIPSpec{
-				Name:           subnet.NatGateway.NatGatewayIP.Name,
-				ResourceGroup:  s.ResourceGroup(),
-				DNSName:        subnet.NatGateway.NatGatewayIP.DNSName,
-				IsIPv6:         false, // Public IP is IPv4 by default
-				ClusterName:    s.ClusterName(),
-				Location:       s.Location(),
-				FailureDomains: s.FailureDomains(),
-				AdditionalTags: s.AdditionalTags(),
-				IPTags:         subnet.NatGateway.NatGatewayIP.IPTags,
-			})
+			for _, ip := range append([]infrav1.PublicIPSpec{subnet.NatGateway.NatGatewayIP}, subnet.NatGateway.AdditionalIPs...) {
+				nodeNatGatewayIPSpecs = append(nodeNatGatewayIPSpecs, &publicips.PublicIPSpec{
+					Name:             ip.Name,
+					ResourceGroup:    s.publicIPResourceGroup(ip.ID),
+					DNSName:          ip.DNSName,
+					IsIPv6:           false, // Public IP is IPv4 by default
+					ClusterName:      s.ClusterName(),
+					Location:         s.Location(),
+					FailureDomains:   s.FailureDomains(),
+					AdditionalTags:   s.AdditionalTags(),
+					IPTags:           ip.IPTags,
+					ID:               ip.ID,
+					PublicIPPrefixID: ip.PublicIPPrefixID,
+				})
+			}
 		}
 		publicIPSpecs = append(publicIPSpecs, nodeNatGatewayIPSpecs...)
 	}
@@ -223,15 +241,17 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 	if azureBastion := s.AzureBastion(); azureBastion != nil {
 		// public IP for Azure Bastion.
 		azureBastionPublicIP := &publicips.PublicIPSpec{
-			Name:           azureBastion.PublicIP.Name,
-			ResourceGroup:  s.ResourceGroup(),
-			DNSName:        azureBastion.PublicIP.DNSName,
-			IsIPv6:         false, // Public IP is IPv4 by default
-			ClusterName:    s.ClusterName(),
-			Location:       s.Location(),
-			FailureDomains: s.FailureDomains(),
-			AdditionalTags: s.AdditionalTags(),
-			IPTags:         azureBastion.PublicIP.IPTags,
+			Name:             azureBastion.PublicIP.Name,
+			ResourceGroup:    s.publicIPResourceGroup(azureBastion.PublicIP.ID),
+			DNSName:          azureBastion.PublicIP.DNSName,
+			IsIPv6:           false, // Public IP is IPv4 by default
+			ClusterName:      s.ClusterName(),
+			Location:         s.Location(),
+			FailureDomains:   s.FailureDomains(),
+			AdditionalTags:   s.AdditionalTags(),
+			IPTags:           azureBastion.PublicIP.IPTags,
+			ID:               azureBastion.PublicIP.ID,
+			PublicIPPrefixID: azureBastion.PublicIP.PublicIPPrefixID,
 		}
 		publicIPSpecs = append(publicIPSpecs, azureBastionPublicIP)
 	}
@@ -239,6 +259,95 @@ func (s *ClusterScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 	return publicIPSpecs
 }
 
+// publicIPResourceGroup returns the resource group of the existing public IP or public IP prefix with the given
+// resource ID, or the cluster's resource group if there is none.
+func (s *ClusterScope) publicIPResourceGroup(id string) string {
+	if resourceID, err := azureutil.ParseResourceID(id); err == nil {
+		return resourceID.ResourceGroupName
+	}
+	return s.ResourceGroup()
+}
+
+// PublicIPPrefixSpecs returns the public IP prefix specs of the node NAT gateways.
+func (s *ClusterScope) PublicIPPrefixSpecs() []azure.ResourceSpecGetter {
+	prefixSet := make(map[string]struct{})
+	var specs []azure.ResourceSpecGetter
+	for _, subnet := range s.NodeSubnets() {
+		if !subnet.IsNatGatewayEnabled() || subnet.NatGateway.IPPrefix == nil {
+			continue
+		}
+		if _, ok := prefixSet[subnet.NatGateway.IPPrefix.Name]; ok {
+			continue
+		}
+		prefixSet[subnet.NatGateway.IPPrefix.Name] = struct{}{}
+		specs = append(specs, &publicips.PublicIPPrefixSpec{
+			Name:             subnet.NatGateway.IPPrefix.Name,
+			ResourceGroup:    s.publicIPResourceGroup(subnet.NatGateway.IPPrefix.ID),
+			ClusterName:      s.ClusterName(),
+			Location:         s.Location(),
+			ExtendedLocation: s.ExtendedLocation(),
+			PrefixLength:     subnet.NatGateway.IPPrefix.PrefixLength,
+			FailureDomains:   s.FailureDomains(),
+			AdditionalTags:   s.AdditionalTags(),
+			ID:               subnet.NatGateway.IPPrefix.ID,
+		})
+	}
+	return specs
+}
+
+// SetPublicIPAddress records the address of a reconciled public IP and updates the NAT gateways status.
+func (s *ClusterScope) SetPublicIPAddress(name, address string) {
+	if s.cache == nil {
+		s.cache = &ClusterCache{}
+	}
+	if s.cache.publicIPAddresses == nil {
+		s.cache.publicIPAddresses = make(map[string]string)
+	}
+	s.cache.publicIPAddresses[name] = address
+	s.updateNatGatewaysStatus()
+}
+
+// SetPublicIPPrefix records the prefix of a reconciled public IP prefix and updates the NAT gateways status.
+func (s *ClusterScope) SetPublicIPPrefix(name, prefix string) {
+	if s.cache == nil {
+		s.cache = &ClusterCache{}
+	}
+	if s.cache.publicIPPrefixes == nil {
+		s.cache.publicIPPrefixes = make(map[string]string)
+	}
+	s.cache.publicIPPrefixes[name] = prefix
+	s.updateNatGatewaysStatus()
+}
+
+// updateNatGatewaysStatus rebuilds the NAT gateways status from the public IPs and public IP prefixes
+// reconciled so far in this loop.
+func (s *ClusterScope) updateNatGatewaysStatus() {
+	natGatewaySet := make(map[string]struct{})
+	var statuses []infrav1.NatGatewayStatus
+	for _, subnet := range s.NodeSubnets() {
+		if !subnet.IsNatGatewayEnabled() {
+			continue
+		}
+		if _, ok := natGatewaySet[subnet.NatGateway.Name]; ok {
+			continue
+		}
+		natGatewaySet[subnet.NatGateway.Name] = struct{}{}
+		status := infrav1.NatGatewayStatus{Name: subnet.NatGateway.Name}
+		for _, ip := range append([]infrav1.PublicIPSpec{subnet.NatGateway.NatGatewayIP}, subnet.NatGateway.AdditionalIPs...) {
+			if address, ok := s.cache.publicIPAddresses[ip.Name]; ok {
+				status.EgressIPs = append(status.EgressIPs, address)
+			}
+		}
+		if subnet.NatGateway.IPPrefix != nil {
+			if prefix, ok := s.cache.publicIPPrefixes[subnet.NatGateway.IPPrefix.Name]; ok {
+				status.EgressIPPrefixes = append(status.EgressIPPrefixes, prefix)
+			}
+		}
+		statuses = append(statuses, status)
+	}
+	s.AzureCluster.Status.NatGateways = statuses
+}
+
 // LBSpecs returns the load balancer specs.
 func (s *ClusterScope) LBSpecs() []azure.ResourceSpecGetter {
 	specs := []azure.ResourceSpecGetter{
@@ -260,6 +369,9 @@ func (s *ClusterScope) LBSpecs() []azure.ResourceSpecGetter {
 			Role:                 infrav1.APIServerRole,
 			BackendPoolName:      s.APIServerLB().BackendPool.Name,
 			IdleTimeoutInMinutes: s.APIServerLB().IdleTimeoutInMinutes,
+			HealthProbe:          s.APIServerLB().HealthProbe,
+			AdditionalRules:      s.APIServerLB().AdditionalRules,
+			LastAppliedRules:     s.getLastAppliedLoadBalancingRules(s.APIServerLB().Name),
 			AdditionalTags:       s.AdditionalTags(),
 		},
 	}
@@ -315,11 +427,13 @@ func (s *ClusterScope) RouteTableSpecs() []azure.ResourceSpecGetter {
 	for _, subnet := range s.AzureCluster.Spec.NetworkSpec.Subnets {
 		if subnet.RouteTable.Name != "" {
 			specs = append(specs, &routetables.RouteTableSpec{
-				Name:           subnet.RouteTable.Name,
-				Location:       s.Location(),
-				ResourceGroup:  s.Vnet().ResourceGroup,
-				ClusterName:    s.ClusterName(),
-				AdditionalTags: s.AdditionalTags(),
+				Name:              subnet.RouteTable.Name,
+				Location:          s.Location(),
+				ResourceGroup:     s.Vnet().ResourceGroup,
+				ClusterName:       s.ClusterName(),
+				AdditionalTags:    s.AdditionalTags(),
+				Routes:            subnet.RouteTable.Routes,
+				LastAppliedRoutes: s.getLastAppliedRoutes(subnet.RouteTable.Name),
 			})
 		}
 	}
@@ -337,6 +451,10 @@ func (s *ClusterScope) NatGatewaySpecs() []azure.ASOResourceSpecGetter[*asonetwo
 		if subnet.IsNatGatewayEnabled() {
 			if _, ok := natGatewaySet[subnet.NatGateway.Name]; !ok {
 				natGatewaySet[subnet.NatGateway.Name] = struct{}{} // empty struct to represent hash set
+				var ipPrefixName, ipPrefixID string
+				if subnet.NatGateway.IPPrefix != nil {
+					ipPrefixName, ipPrefixID = subnet.NatGateway.IPPrefix.Name, subnet.NatGateway.IPPrefix.ID
+				}
 				natGateways = append(natGateways, &natgateways.NatGatewaySpec{
 					Name:           subnet.NatGateway.Name,
 					ResourceGroup:  s.ResourceGroup(),
@@ -345,7 +463,12 @@ func (s *ClusterScope) NatGatewaySpecs() []azure.ASOResourceSpecGetter[*asonetwo
 					ClusterName:    s.ClusterName(),
 					NatGatewayIP: infrav1.PublicIPSpec{
 						Name: subnet.NatGateway.NatGatewayIP.Name,
+						ID:   subnet.NatGateway.NatGatewayIP.ID,
 					},
+					AdditionalIPs:  subnet.NatGateway.AdditionalIPs,
+					IPPrefixName:   ipPrefixName,
+					IPPrefixID:     ipPrefixID,
+					IdleTimeout:    subnet.NatGateway.IdleTimeoutInMinutes,
 					AdditionalTags: s.AdditionalTags(),
 					// We need to know if the VNet is managed to decide if this NAT Gateway was-managed or not.
 					IsVnetManaged: s.IsVnetManaged(),
@@ -359,22 +482,130 @@ func (s *ClusterScope) NatGatewaySpecs() []azure.ASOResourceSpecGetter[*asonetwo
 
 // NSGSpecs returns the security group specs.
 func (s *ClusterScope) NSGSpecs() []azure.ResourceSpecGetter {
-	nsgspecs := make([]azure.ResourceSpecGetter, len(s.AzureCluster.Spec.NetworkSpec.Subnets))
-	for i, subnet := range s.AzureCluster.Spec.NetworkSpec.Subnets {
-		nsgspecs[i] = &securitygroups.NSGSpec{
+	nsgspecs := make([]azure.ResourceSpecGetter, 0, len(s.AzureCluster.Spec.NetworkSpec.Subnets))
+	for _, subnet := range s.AzureCluster.Spec.NetworkSpec.Subnets {
+		// Subnets without a security group, such as delegated subnets, are skipped.
+		if subnet.SecurityGroup.Name == "" {
+			continue
+		}
+		nsgspecs = append(nsgspecs, &securitygroups.NSGSpec{
 			Name:                     subnet.SecurityGroup.Name,
-			SecurityRules:            subnet.SecurityGroup.SecurityRules,
+			SecurityRules:            s.resolveSecurityRuleApplicationSecurityGroups(subnet.SecurityGroup.SecurityRules),
 			ResourceGroup:            s.Vnet().ResourceGroup,
 			Location:                 s.Location(),
 			ClusterName:              s.ClusterName(),
 			AdditionalTags:           s.AdditionalTags(),
 			LastAppliedSecurityRules: s.getLastAppliedSecurityRules(subnet.SecurityGroup.Name),
-		}
+		})
 	}
 
 	return nsgspecs
 }
 
+// FlowLogSpecs returns the flow log specs of the network security groups.
+func (s *ClusterScope) FlowLogSpecs() []azure.ResourceSpecGetter {
+	var specs []azure.ResourceSpecGetter
+	seen := make(map[string]struct{})
+	for _, subnet := range s.AzureCluster.Spec.NetworkSpec.Subnets {
+		flowLog := subnet.SecurityGroup.FlowLog
+		if flowLog == nil || subnet.SecurityGroup.Name == "" {
+			continue
+		}
+		// Subnets can share a security group, which only has one flow log.
+		if _, ok := seen[subnet.SecurityGroup.Name]; ok {
+			continue
+		}
+		seen[subnet.SecurityGroup.Name] = struct{}{}
+
+		spec := &flowlogs.FlowLogSpec{
+			Name:               flowLog.Name,
+			ResourceGroup:      flowLog.NetworkWatcherResourceGroup,
+			NetworkWatcherName: flowLog.NetworkWatcherName,
+			Location:           s.Location(),
+			SecurityGroupID:    azure.SecurityGroupID(s.SubscriptionID(), s.Vnet().ResourceGroup, subnet.SecurityGroup.Name),
+			StorageAccountID:   flowLog.StorageAccountID,
+			Enabled:            ptr.Deref(flowLog.Enabled, true),
+			RetentionDays:      flowLog.RetentionDays,
+			ClusterName:        s.ClusterName(),
+			AdditionalTags:     s.AdditionalTags(),
+		}
+		if spec.Name == "" {
+			spec.Name = azure.GenerateFlowLogName(subnet.SecurityGroup.Name)
+		}
+		if spec.ResourceGroup == "" {
+			spec.ResourceGroup = azure.DefaultNetworkWatcherResourceGroup
+		}
+		if spec.NetworkWatcherName == "" {
+			spec.NetworkWatcherName = azure.GenerateNetworkWatcherName(s.Location())
+		}
+		if flowLog.TrafficAnalytics != nil {
+			spec.TrafficAnalytics = flowLog.TrafficAnalytics.DeepCopy()
+			if spec.TrafficAnalytics.WorkspaceRegion == "" {
+				spec.TrafficAnalytics.WorkspaceRegion = s.Location()
+			}
+			if spec.TrafficAnalytics.IntervalInMinutes == nil {
+				spec.TrafficAnalytics.IntervalInMinutes = ptr.To[int32](60)
+			}
+		}
+		specs = append(specs, spec)
+	}
+
+	return specs
+}
+
+// ApplicationSecurityGroupSpecs returns the application security group specs.
+func (s *ClusterScope) ApplicationSecurityGroupSpecs() []azure.ResourceSpecGetter {
+	var specs []azure.ResourceSpecGetter
+	for _, asg := range s.ApplicationSecurityGroups() {
+		specs = append(specs, &applicationsecuritygroups.ApplicationSecurityGroupSpec{
+			Name:           asg.Name,
+			ResourceGroup:  s.ResourceGroup(),
+			Location:       s.Location(),
+			ClusterName:    s.ClusterName(),
+			AdditionalTags: s.AdditionalTags(),
+		})
+	}
+
+	return specs
+}
+
+// resolveSecurityRuleApplicationSecurityGroups returns the security rules with the application security groups
+// referenced by name replaced by their resource IDs.
+func (s *ClusterScope) resolveSecurityRuleApplicationSecurityGroups(rules infrav1.SecurityRules) infrav1.SecurityRules {
+	var resolved infrav1.SecurityRules
+	for i, rule := range rules {
+		if len(rule.SourceApplicationSecurityGroups) == 0 && len(rule.DestinationApplicationSecurityGroups) == 0 {
+			continue
+		}
+		if resolved == nil {
+			resolved = rules.DeepCopy()
+		}
+		resolved[i].SourceApplicationSecurityGroups = applicationSecurityGroupIDs(s.SubscriptionID(), s.ResourceGroup(), rule.SourceApplicationSecurityGroups)
+		resolved[i].DestinationApplicationSecurityGroups = applicationSecurityGroupIDs(s.SubscriptionID(), s.ResourceGroup(), rule.DestinationApplicationSecurityGroups)
+	}
+	if resolved == nil {
+		return rules
+	}
+	return resolved
+}
+
+// applicationSecurityGroupIDs returns the resource IDs of the referenced application security groups. References by name
+// are to the cluster's application security groups, which are in the cluster's resource group.
+func applicationSecurityGroupIDs(subscriptionID, resourceGroup string, refs []string) []string {
+	if len(refs) == 0 {
+		return nil
+	}
+	ids := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		if infrav1.IsApplicationSecurityGroupID(ref) {
+			ids = append(ids, ref)
+			continue
+		}
+		ids = append(ids, azure.ApplicationSecurityGroupID(subscriptionID, resourceGroup, ref))
+	}
+	return ids
+}
+
 // SubnetSpecs returns the subnets specs.
 func (s *ClusterScope) SubnetSpecs() []azure.ASOResourceSpecGetter[*asonetworkv1api20201101.VirtualNetworksSubnet] {
 	numberOfSubnets := len(s.AzureCluster.Spec.NetworkSpec.Subnets)
@@ -386,17 +617,21 @@ func (s *ClusterScope) SubnetSpecs() []azure.ASOResourceSpecGetter[*asonetworkv1
 
 	for _, subnet := range s.AzureCluster.Spec.NetworkSpec.Subnets {
 		subnetSpec := &subnets.SubnetSpec{
-			Name:              subnet.Name,
-			ResourceGroup:     s.ResourceGroup(),
-			SubscriptionID:    s.SubscriptionID(),
-			CIDRs:             subnet.CIDRBlocks,
-			VNetName:          s.Vnet().Name,
-			VNetResourceGroup: s.Vnet().ResourceGroup,
-			IsVNetManaged:     s.IsVnetManaged(),
-			RouteTableName:    subnet.RouteTable.Name,
-			SecurityGroupName: subnet.SecurityGroup.Name,
-			NatGatewayName:    subnet.NatGateway.Name,
-			ServiceEndpoints:  subnet.ServiceEndpoints,
+			Name:                              subnet.Name,
+			ResourceGroup:                     s.ResourceGroup(),
+			SubscriptionID:                    s.SubscriptionID(),
+			CIDRs:                             subnet.CIDRBlocks,
+			VNetName:                          s.Vnet().Name,
+			VNetResourceGroup:                 s.Vnet().ResourceGroup,
+			IsVNetManaged:                     s.IsVnetManaged(),
+			RouteTableName:                    subnet.RouteTable.Name,
+			SecurityGroupName:                 subnet.SecurityGroup.Name,
+			NatGatewayName:                    subnet.NatGateway.Name,
+			ServiceEndpoints:                  subnet.ServiceEndpoints,
+			Delegations:                       subnet.Delegations,
+			ServiceEndpointPolicyIDs:          subnet.ServiceEndpointPolicyIDs,
+			PrivateEndpointNetworkPolicies:    subnet.PrivateEndpointNetworkPolicies,
+			PrivateLinkServiceNetworkPolicies: subnet.PrivateLinkServiceNetworkPolicies,
 		}
 		subnetSpecs = append(subnetSpecs, subnetSpec)
 	}
@@ -404,16 +639,19 @@ func (s *ClusterScope) SubnetSpecs() []azure.ASOResourceSpecGetter[*asonetworkv1
 	if s.IsAzureBastionEnabled() {
 		azureBastionSubnet := s.AzureCluster.Spec.BastionSpec.AzureBastion.Subnet
 		subnetSpecs = append(subnetSpecs, &subnets.SubnetSpec{
-			Name:              azureBastionSubnet.Name,
-			ResourceGroup:     s.ResourceGroup(),
-			SubscriptionID:    s.SubscriptionID(),
-			CIDRs:             azureBastionSubnet.CIDRBlocks,
-			VNetName:          s.Vnet().Name,
-			VNetResourceGroup: s.Vnet().ResourceGroup,
-			IsVNetManaged:     s.IsVnetManaged(),
-			SecurityGroupName: azureBastionSubnet.SecurityGroup.Name,
-			RouteTableName:    azureBastionSubnet.RouteTable.Name,
-			ServiceEndpoints:  azureBastionSubnet.ServiceEndpoints,
+			Name:                              azureBastionSubnet.Name,
+			ResourceGroup:                     s.ResourceGroup(),
+			SubscriptionID:                    s.SubscriptionID(),
+			CIDRs:                             azureBastionSubnet.CIDRBlocks,
+			VNetName:                          s.Vnet().Name,
+			VNetResourceGroup:                 s.Vnet().ResourceGroup,
+			IsVNetManaged:                     s.IsVnetManaged(),
+			SecurityGroupName:                 azureBastionSubnet.SecurityGroup.Name,
+			RouteTableName:                    azureBastionSubnet.RouteTable.Name,
+			ServiceEndpoints:                  azureBastionSubnet.ServiceEndpoints,
+			ServiceEndpointPolicyIDs:          azureBastionSubnet.ServiceEndpointPolicyIDs,
+			PrivateEndpointNetworkPolicies:    azureBastionSubnet.PrivateEndpointNetworkPolicies,
+			PrivateLinkServiceNetworkPolicies: azureBastionSubnet.PrivateLinkServiceNetworkPolicies,
 		})
 	}
 
@@ -447,13 +685,14 @@ func (s *ClusterScope) GroupSpecs() []azure.ASOResourceSpecGetter[*asoresourcesv
 func (s *ClusterScope) VnetPeeringSpecs() []azure.ResourceSpecGetter {
 	peeringSpecs := make([]azure.ResourceSpecGetter, 2*len(s.Vnet().Peerings))
 	for i, peering := range s.Vnet().Peerings {
+		remoteSubscriptionID := s.remoteVnetSubscriptionID(peering)
 		forwardPeering := &vnetpeerings.VnetPeeringSpec{
 			PeeringName:               azure.GenerateVnetPeeringName(s.Vnet().Name, peering.RemoteVnetName),
 			SourceVnetName:            s.Vnet().Name,
 			SourceResourceGroup:       s.Vnet().ResourceGroup,
 			RemoteVnetName:            peering.RemoteVnetName,
 			RemoteResourceGroup:       peering.ResourceGroup,
-			SubscriptionID:            s.SubscriptionID(),
+			SubscriptionID:            remoteSubscriptionID,
 			AllowForwardedTraffic:     peering.ForwardPeeringProperties.AllowForwardedTraffic,
 			AllowGatewayTransit:       peering.ForwardPeeringProperties.AllowGatewayTransit,
 			AllowVirtualNetworkAccess: peering.ForwardPeeringProperties.AllowVirtualNetworkAccess,
@@ -466,6 +705,8 @@ func (s *ClusterScope) VnetPeeringSpecs() []azure.ResourceSpecGetter {
 			RemoteVnetName:            s.Vnet().Name,
 			RemoteResourceGroup:       s.Vnet().ResourceGroup,
 			SubscriptionID:            s.SubscriptionID(),
+			SourceSubscriptionID:      remoteSubscriptionID,
+			IdentityRef:               peering.ReversePeeringIdentityRef,
 			AllowForwardedTraffic:     peering.ReversePeeringProperties.AllowForwardedTraffic,
 			AllowGatewayTransit:       peering.ReversePeeringProperties.AllowGatewayTransit,
 			AllowVirtualNetworkAccess: peering.ReversePeeringProperties.AllowVirtualNetworkAccess,
@@ -478,16 +719,59 @@ func (s *ClusterScope) VnetPeeringSpecs() []azure.ResourceSpecGetter {
 	return peeringSpecs
 }
 
+// remoteVnetSubscriptionID returns the subscription of a peered virtual network, which defaults to the cluster's.
+func (s *ClusterScope) remoteVnetSubscriptionID(peering infrav1.VnetPeeringSpec) string {
+	if resourceID, err := azureutil.ParseResourceID(peering.RemoteVnetID); err == nil {
+		return resourceID.SubscriptionID
+	}
+	return s.SubscriptionID()
+}
+
+// VnetPeeringAuthorizer returns an authorizer for the given subscription using the given AzureClusterIdentity, which
+// defaults to the cluster's identity. It is used to create peerings from remote virtual networks in other
+// subscriptions.
+func (s *ClusterScope) VnetPeeringAuthorizer(ctx context.Context, subscriptionID string, identityRef *corev1.ObjectReference) (azure.Authorizer, error) {
+	azureCluster := s.AzureCluster.DeepCopy()
+	if identityRef != nil {
+		azureCluster.Spec.IdentityRef = identityRef
+	}
+	credentialsProvider, err := NewAzureClusterCredentialsProvider(ctx, s.Client, azureCluster)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to init credentials provider")
+	}
+	if subscriptionID == "" {
+		subscriptionID = s.SubscriptionID()
+	}
+	clients := &AzureClients{}
+	if err := clients.setCredentialsWithProvider(ctx, subscriptionID, s.AzureCluster.Spec.AzureEnvironment, credentialsProvider); err != nil {
+		return nil, errors.Wrap(err, "failed to configure azure settings and credentials for Identity")
+	}
+	return clients, nil
+}
+
+// SetVnetPeeringSyncStatus marks the virtual network peerings as synced, or as not synced with the status of the
+// peerings that aren't connected or fully in sync.
+func (s *ClusterScope) SetVnetPeeringSyncStatus(notSynced []string) {
+	if len(notSynced) > 0 {
+		conditions.MarkFalse(s.AzureCluster, infrav1.VnetPeeringSyncedCondition, infrav1.VnetPeeringNotSyncedReason, clusterv1.ConditionSeverityInfo,
+			"%s", strings.Join(notSynced, "; "))
+		return
+	}
+	conditions.MarkTrue(s.AzureCluster, infrav1.VnetPeeringSyncedCondition)
+}
+
 // VNetSpec returns the virtual network spec.
 func (s *ClusterScope) VNetSpec() azure.ASOResourceSpecGetter[*asonetworkv1api20201101.VirtualNetwork] {
 	return &virtualnetworks.VNetSpec{
-		ResourceGroup:    s.Vnet().ResourceGroup,
-		Name:             s.Vnet().Name,
-		CIDRs:            s.Vnet().CIDRBlocks,
-		ExtendedLocation: s.ExtendedLocation(),
-		Location:         s.Location(),
-		ClusterName:      s.ClusterName(),
-		AdditionalTags:   s.AdditionalTags(),
+		ResourceGroup:        s.Vnet().ResourceGroup,
+		Name:                 s.Vnet().Name,
+		CIDRs:                s.Vnet().CIDRBlocks,
+		ExtendedLocation:     s.ExtendedLocation(),
+		Location:             s.Location(),
+		ClusterName:          s.ClusterName(),
+		AdditionalTags:       s.AdditionalTags(),
+		DDoSProtectionPlanID: s.Vnet().DDoSProtectionPlanID,
+		DNSServers:           s.Vnet().DNSServers,
 	}
 }
 
@@ -516,7 +800,7 @@ func (s *ClusterScope) PrivateDNSSpec() (zoneSpec azure.ResourceSpecGetter, link
 			links[i+1] = privatedns.LinkSpec{
 				Name:              azure.GenerateVNetLinkName(peering.RemoteVnetName),
 				ZoneName:          s.GetPrivateDNSZoneName(),
-				SubscriptionID:    s.SubscriptionID(),
+				SubscriptionID:    s.remoteVnetSubscriptionID(peering),
 				VNetResourceGroup: peering.ResourceGroup,
 				VNetName:          peering.RemoteVnetName,
 				ResourceGroup:     s.ResourceGroup(),
@@ -555,7 +839,7 @@ func (s *ClusterScope) AzureBastion() *infrav1.AzureBastion {
 func (s *ClusterScope) AzureBastionSpec() azure.ASOResourceSpecGetter[*asonetworkv1api20220701.BastionHost] {
 	if s.IsAzureBastionEnabled() {
 		subnetID := azure.SubnetID(s.SubscriptionID(), s.Vnet().ResourceGroup, s.Vnet().Name, s.AzureBastion().Subnet.Name)
-		publicIPID := azure.PublicIPID(s.SubscriptionID(), s.ResourceGroup(), s.AzureBastion().PublicIP.Name)
+		publicIPID := azure.PublicIPSpecID(s.AzureBastion().PublicIP, s.SubscriptionID(), s.ResourceGroup())
 
 		return &bastionhosts.AzureBastionSpec{
 			Name:            s.AzureBastion().Name,
@@ -665,6 +949,11 @@ func (s *ClusterScope) UpdateSubnetID(name string, id string) {
 	s.SetSubnet(subnetSpecInfra)
 }
 
+// ApplicationSecurityGroups returns the cluster application security groups.
+func (s *ClusterScope) ApplicationSecurityGroups() []infrav1.ApplicationSecurityGroup {
+	return s.AzureCluster.Spec.NetworkSpec.ApplicationSecurityGroups
+}
+
 // ControlPlaneRouteTable returns the cluster controlplane routetable.
 func (s *ClusterScope) ControlPlaneRouteTable() infrav1.RouteTable {
 	subnet, _ := s.AzureCluster.Spec.NetworkSpec.GetControlPlaneSubnet()
@@ -839,7 +1128,14 @@ func (s *ClusterScope) PatchObject(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "scope.ClusterScope.PatchObject")
 	defer done()
 
-	conditions.SetSummary(s.AzureCluster)
+	// The sync status of vnet peerings also depends on the remote vnets, so it doesn't affect the readiness of the cluster.
+	summaryConditions := []clusterv1.ConditionType{}
+	for _, condition := range s.AzureCluster.GetConditions() {
+		if condition.Type != infrav1.VnetPeeringSyncedCondition {
+			summaryConditions = append(summaryConditions, condition.Type)
+		}
+	}
+	conditions.SetSummary(s.AzureCluster, conditions.WithConditions(summaryConditions...))
 
 	return s.patchHelper.Patch(
 		ctx,
@@ -847,9 +1143,12 @@ func (s *ClusterScope) PatchObject(ctx context.Context) error {
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 			clusterv1.ReadyCondition,
 			infrav1.ResourceGroupReadyCondition,
+			infrav1.ApplicationSecurityGroupsReadyCondition,
+			infrav1.FlowLogsReadyCondition,
 			infrav1.RouteTablesReadyCondition,
 			infrav1.NetworkInfrastructureReadyCondition,
 			infrav1.VnetPeeringReadyCondition,
+			infrav1.VnetPeeringSyncedCondition,
 			infrav1.DisksReadyCondition,
 			infrav1.NATGatewaysReadyCondition,
 			infrav1.LoadBalancersReadyCondition,
@@ -1124,6 +1423,36 @@ func (s *ClusterScope) PrivateEndpointSpecs() []azure.ASOResourceSpecGetter[*aso
 	return privateEndpointSpecs
 }
 
+func (s *ClusterScope) getLastAppliedRoutes(routeTableName string) map[string]interface{} {
+	// Retrieve the last applied routes for all route tables.
+	lastAppliedRoutesAll, err := s.AnnotationJSON(azure.RouteLastAppliedAnnotation)
+	if err != nil {
+		return map[string]interface{}{}
+	}
+
+	// Retrieve the last applied routes for this route table.
+	lastAppliedRoutes, ok := lastAppliedRoutesAll[routeTableName].(map[string]interface{})
+	if !ok {
+		lastAppliedRoutes = map[string]interface{}{}
+	}
+	return lastAppliedRoutes
+}
+
+func (s *ClusterScope) getLastAppliedLoadBalancingRules(lbName string) map[string]interface{} {
+	// Retrieve the last applied load balancing rules for all load balancers.
+	lastAppliedRulesAll, err := s.AnnotationJSON(azure.LoadBalancingRuleLastAppliedAnnotation)
+	if err != nil {
+		return map[string]interface{}{}
+	}
+
+	// Retrieve the last applied load balancing rules for this load balancer.
+	lastAppliedRules, ok := lastAppliedRulesAll[lbName].(map[string]interface{})
+	if !ok {
+		lastAppliedRules = map[string]interface{}{}
+	}
+	return lastAppliedRules
+}
+
 func (s *ClusterScope) getLastAppliedSecurityRules(nsgName string) map[string]interface{} {
 	// Retrieve the last applied security rules for all NSGs.
 	lastAppliedSecurityRulesAll, err := s.AnnotationJSON(azure.SecurityRuleLastAppliedAnnotation)
@@ -35,7 +35,9 @@ import (
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/applicationsecuritygroups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bastionhosts"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/flowlogs"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/groups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/loadbalancers"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/natgateways"
@@ -46,6 +48,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/subnets"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/vnetpeerings"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -283,6 +286,61 @@ func TestGettingSecurityRules(t *testing.T) {
 	g.Expect(subnet.SecurityGroup.SecurityRules).To(HaveLen(2))
 }
 
+func TestVnetPeeringSyncStatusIsNotPartOfReadySummary(t *testing.T) {
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	_ = clusterv1.AddToScheme(scheme)
+	_ = infrav1.AddToScheme(scheme)
+	_ = corev1.AddToScheme(scheme)
+
+	cluster := &clusterv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "my-cluster",
+			Namespace: "default",
+		},
+	}
+	azureCluster := &infrav1.AzureCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "my-azure-cluster",
+		},
+		Spec: infrav1.AzureClusterSpec{
+			AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+				SubscriptionID: "123",
+				IdentityRef: &corev1.ObjectReference{
+					Kind: infrav1.AzureClusterIdentityKind,
+				},
+			},
+		},
+	}
+	fakeIdentity := &infrav1.AzureClusterIdentity{
+		Spec: infrav1.AzureClusterIdentitySpec{
+			Type:     infrav1.ServicePrincipal,
+			ClientID: fakeClientID,
+			TenantID: fakeTenantID,
+		},
+	}
+	fakeSecret := &corev1.Secret{Data: map[string][]byte{"clientSecret": []byte("fooSecret")}}
+
+	initObjects := []runtime.Object{cluster, azureCluster, fakeIdentity, fakeSecret}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(initObjects...).WithStatusSubresource(azureCluster).Build()
+
+	clusterScope, err := NewClusterScope(context.TODO(), ClusterScopeParams{
+		Cluster:      cluster,
+		AzureCluster: azureCluster,
+		Client:       fakeClient,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	conditions.MarkTrue(clusterScope.AzureCluster, infrav1.VnetPeeringReadyCondition)
+	clusterScope.SetVnetPeeringSyncStatus([]string{"hub-to-spoke is RemoteNotInSync"})
+	g.Expect(clusterScope.PatchObject(context.TODO())).To(Succeed())
+
+	g.Expect(conditions.IsTrue(clusterScope.AzureCluster, clusterv1.ReadyCondition)).To(BeTrue())
+	g.Expect(conditions.IsFalse(clusterScope.AzureCluster, infrav1.VnetPeeringSyncedCondition)).To(BeTrue())
+	g.Expect(conditions.GetSeverity(clusterScope.AzureCluster, infrav1.VnetPeeringSyncedCondition)).To(HaveValue(Equal(clusterv1.ConditionSeverityInfo)))
+}
+
 func TestPublicIPSpecs(t *testing.T) {
 	tests := []struct {
 		name                 string
@@ -625,6 +683,58 @@ func TestPublicIPSpecs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Azure cluster with public type apiserver LB referencing an existing public IP",
+			azureCluster: &infrav1.AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-cluster",
+					OwnerReferences: []metav1.OwnerReference{
+						{
+							APIVersion: "cluster.x-k8s.io/v1beta1",
+							Kind:       "Cluster",
+							Name:       "my-cluster",
+						},
+					},
+				},
+				Spec: infrav1.AzureClusterSpec{
+					ResourceGroup: "my-rg",
+					AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+						SubscriptionID: "123",
+						Location:       "centralIndia",
+						IdentityRef: &corev1.ObjectReference{
+							Kind: infrav1.AzureClusterIdentityKind,
+						},
+					},
+					NetworkSpec: infrav1.NetworkSpec{
+						APIServerLB: infrav1.LoadBalancerSpec{
+							LoadBalancerClassSpec: infrav1.LoadBalancerClassSpec{},
+							FrontendIPs: []infrav1.FrontendIP{
+								{
+									PublicIP: &infrav1.PublicIPSpec{
+										Name:    "reserved-ip",
+										ID:      "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip",
+										DNSName: "my-cluster.example.com",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedPublicIPSpec: []azure.ResourceSpecGetter{
+				&publicips.PublicIPSpec{
+					Name:           "reserved-ip",
+					ResourceGroup:  "reserved-ips",
+					ID:             "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip",
+					DNSName:        "my-cluster.example.com",
+					IsIPv6:         false,
+					ClusterName:    "my-cluster",
+					Location:       "centralIndia",
+					FailureDomains: []*string{},
+					AdditionalTags: infrav1.Tags{},
+				},
+			},
+		},
 		{
 			name: "Azure cluster with public type apiserver LB and public node outbound lb",
 			azureCluster: &infrav1.AzureCluster{
@@ -869,6 +979,11 @@ func TestRouteTableSpecs(t *testing.T) {
 					},
 				},
 				AzureCluster: &infrav1.AzureCluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Annotations: map[string]string{
+							azure.RouteLastAppliedAnnotation: `{"fake-route-table-2":{"to-onprem":"192.168.0.0/16"}}`,
+						},
+					},
 					Spec: infrav1.AzureClusterSpec{
 						AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
 							Location: "centralIndia",
@@ -891,6 +1006,14 @@ func TestRouteTableSpecs(t *testing.T) {
 									RouteTable: infrav1.RouteTable{
 										ID:   "fake-route-table-id-2",
 										Name: "fake-route-table-2",
+										Routes: infrav1.Routes{
+											{
+												Name:             "to-nva",
+												AddressPrefix:    "0.0.0.0/0",
+												NextHopType:      infrav1.RouteNextHopTypeVirtualAppliance,
+												NextHopIPAddress: "10.0.0.4",
+											},
+										},
 									},
 								},
 							},
@@ -901,11 +1024,12 @@ func TestRouteTableSpecs(t *testing.T) {
 			},
 			want: []azure.ResourceSpecGetter{
 				&routetables.RouteTableSpec{
-					Name:           "fake-route-table-1",
-					ResourceGroup:  "my-rg",
-					Location:       "centralIndia",
-					ClusterName:    "my-cluster",
-					AdditionalTags: make(infrav1.Tags),
+					Name:              "fake-route-table-1",
+					ResourceGroup:     "my-rg",
+					Location:          "centralIndia",
+					ClusterName:       "my-cluster",
+					AdditionalTags:    make(infrav1.Tags),
+					LastAppliedRoutes: map[string]interface{}{},
 				},
 				&routetables.RouteTableSpec{
 					Name:           "fake-route-table-2",
@@ -913,6 +1037,17 @@ func TestRouteTableSpecs(t *testing.T) {
 					Location:       "centralIndia",
 					ClusterName:    "my-cluster",
 					AdditionalTags: make(infrav1.Tags),
+					Routes: infrav1.Routes{
+						{
+							Name:             "to-nva",
+							AddressPrefix:    "0.0.0.0/0",
+							NextHopType:      infrav1.RouteNextHopTypeVirtualAppliance,
+							NextHopIPAddress: "10.0.0.4",
+						},
+					},
+					LastAppliedRoutes: map[string]interface{}{
+						"to-onprem": "192.168.0.0/16",
+					},
 				},
 			},
 		},
@@ -1176,6 +1311,77 @@ func TestNatGatewaySpecs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "returns node NAT gateway with additional public IPs, a public IP prefix and an idle timeout",
+			clusterScope: ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-cluster",
+					},
+				},
+				AzureClients: AzureClients{
+					EnvironmentSettings: auth.EnvironmentSettings{
+						Values: map[string]string{
+							auth.SubscriptionID: "123",
+						},
+					},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						ResourceGroup: "my-rg",
+						AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+							Location: "centralIndia",
+							IdentityRef: &corev1.ObjectReference{
+								Kind: infrav1.AzureClusterIdentityKind,
+							},
+						},
+						NetworkSpec: infrav1.NetworkSpec{
+							Subnets: infrav1.Subnets{
+								{
+									SubnetClassSpec: infrav1.SubnetClassSpec{
+										Role: infrav1.SubnetNode,
+									},
+									NatGateway: infrav1.NatGateway{
+										NatGatewayIP: infrav1.PublicIPSpec{
+											Name: "pip-fake-nat-gateway-1",
+										},
+										AdditionalIPs: []infrav1.PublicIPSpec{
+											{Name: "pip-fake-nat-gateway-1-1"},
+										},
+										NatGatewayClassSpec: infrav1.NatGatewayClassSpec{
+											Name:                 "fake-nat-gateway-1",
+											IPsCount:             ptr.To[int32](2),
+											IPPrefix:             &infrav1.PublicIPPrefixSpec{Name: "pipp-fake-nat-gateway-1", PrefixLength: 31},
+											IdleTimeoutInMinutes: ptr.To[int32](10),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				cache: &ClusterCache{},
+			},
+			want: []azure.ASOResourceSpecGetter[*asonetworkv1api20220701.NatGateway]{
+				&natgateways.NatGatewaySpec{
+					Name:           "fake-nat-gateway-1",
+					ResourceGroup:  "my-rg",
+					Location:       "centralIndia",
+					SubscriptionID: "123",
+					ClusterName:    "my-cluster",
+					NatGatewayIP: infrav1.PublicIPSpec{
+						Name: "pip-fake-nat-gateway-1",
+					},
+					AdditionalIPs: []infrav1.PublicIPSpec{
+						{Name: "pip-fake-nat-gateway-1-1"},
+					},
+					IPPrefixName:   "pipp-fake-nat-gateway-1",
+					IdleTimeout:    ptr.To[int32](10),
+					AdditionalTags: make(infrav1.Tags),
+					IsVnetManaged:  true,
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1261,6 +1467,76 @@ func TestSetNatGatewayIDInSubnets(t *testing.T) {
 	}
 }
 
+func TestNatGatewaysStatus(t *testing.T) {
+	g := NewWithT(t)
+	natGateway := infrav1.NatGateway{
+		NatGatewayIP: infrav1.PublicIPSpec{
+			Name: "pip-fake-nat-gateway-1",
+		},
+		AdditionalIPs: []infrav1.PublicIPSpec{
+			{Name: "pip-fake-nat-gateway-1-1"},
+		},
+		NatGatewayClassSpec: infrav1.NatGatewayClassSpec{
+			Name:     "fake-nat-gateway-1",
+			IPPrefix: &infrav1.PublicIPPrefixSpec{Name: "pipp-fake-nat-gateway-1", PrefixLength: 31},
+		},
+	}
+	clusterScope := ClusterScope{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "my-cluster",
+			},
+		},
+		AzureCluster: &infrav1.AzureCluster{
+			Spec: infrav1.AzureClusterSpec{
+				ResourceGroup: "my-rg",
+				NetworkSpec: infrav1.NetworkSpec{
+					Subnets: infrav1.Subnets{
+						{
+							SubnetClassSpec: infrav1.SubnetClassSpec{
+								Name: "fake-subnet-1",
+								Role: infrav1.SubnetNode,
+							},
+							NatGateway: natGateway,
+						},
+						{
+							SubnetClassSpec: infrav1.SubnetClassSpec{
+								Name: "fake-subnet-2",
+								Role: infrav1.SubnetNode,
+							},
+							NatGateway: natGateway,
+						},
+					},
+				},
+			},
+		},
+		cache: &ClusterCache{},
+	}
+
+	g.Expect(clusterScope.PublicIPPrefixSpecs()).To(Equal([]azure.ResourceSpecGetter{
+		&publicips.PublicIPPrefixSpec{
+			Name:           "pipp-fake-nat-gateway-1",
+			ResourceGroup:  "my-rg",
+			ClusterName:    "my-cluster",
+			PrefixLength:   31,
+			FailureDomains: []*string{},
+			AdditionalTags: make(infrav1.Tags),
+		},
+	}))
+
+	clusterScope.SetPublicIPAddress("pip-fake-nat-gateway-1-1", "20.1.3.5")
+	clusterScope.SetPublicIPAddress("pip-apiserver", "20.1.3.6")
+	clusterScope.SetPublicIPAddress("pip-fake-nat-gateway-1", "20.1.3.4")
+	clusterScope.SetPublicIPPrefix("pipp-fake-nat-gateway-1", "20.1.2.0/31")
+	g.Expect(clusterScope.AzureCluster.Status.NatGateways).To(Equal([]infrav1.NatGatewayStatus{
+		{
+			Name:             "fake-nat-gateway-1",
+			EgressIPs:        []string{"20.1.3.4", "20.1.3.5"},
+			EgressIPPrefixes: []string{"20.1.2.0/31"},
+		},
+	}))
+}
+
 func TestNSGSpecs(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1281,7 +1557,7 @@ func TestNSGSpecs(t *testing.T) {
 			want: []azure.ResourceSpecGetter{},
 		},
 		{
-			name: "returns specified security groups if present",
+			name: "returns specified security groups if present and skips subnets without a security group",
 			clusterScope: ClusterScope{
 				Cluster: &clusterv1.Cluster{
 					ObjectMeta: metav1.ObjectMeta{
@@ -1313,6 +1589,12 @@ func TestNSGSpecs(t *testing.T) {
 										},
 									},
 								},
+								{
+									SubnetClassSpec: infrav1.SubnetClassSpec{
+										Name: "delegated-subnet",
+										Role: infrav1.SubnetDelegated,
+									},
+								},
 							},
 						},
 					},
@@ -1335,6 +1617,73 @@ func TestNSGSpecs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "resolves application security group references in security rules",
+			clusterScope: ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-cluster",
+					},
+				},
+				AzureClients: AzureClients{
+					EnvironmentSettings: auth.EnvironmentSettings{
+						Values: map[string]string{
+							auth.SubscriptionID: "123",
+						},
+					},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						ResourceGroup: "cluster-rg",
+						AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+							Location: "centralIndia",
+							IdentityRef: &corev1.ObjectReference{
+								Kind: infrav1.AzureClusterIdentityKind,
+							},
+						},
+						NetworkSpec: infrav1.NetworkSpec{
+							Vnet: infrav1.VnetSpec{
+								ResourceGroup: "my-rg",
+							},
+							Subnets: infrav1.Subnets{
+								{
+									SecurityGroup: infrav1.SecurityGroup{
+										Name: "fake-security-group-1",
+										SecurityGroupClass: infrav1.SecurityGroupClass{
+											SecurityRules: infrav1.SecurityRules{
+												{
+													Name:                                 "fake-rule-1",
+													SourceApplicationSecurityGroups:      []string{"web"},
+													DestinationApplicationSecurityGroups: []string{"/subscriptions/456/resourceGroups/other-rg/providers/Microsoft.Network/applicationSecurityGroups/db"},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				cache: &ClusterCache{},
+			},
+			want: []azure.ResourceSpecGetter{
+				&securitygroups.NSGSpec{
+					Name: "fake-security-group-1",
+					SecurityRules: infrav1.SecurityRules{
+						{
+							Name:                                 "fake-rule-1",
+							SourceApplicationSecurityGroups:      []string{"/subscriptions/123/resourceGroups/cluster-rg/providers/Microsoft.Network/applicationSecurityGroups/web"},
+							DestinationApplicationSecurityGroups: []string{"/subscriptions/456/resourceGroups/other-rg/providers/Microsoft.Network/applicationSecurityGroups/db"},
+						},
+					},
+					ResourceGroup:            "my-rg",
+					Location:                 "centralIndia",
+					ClusterName:              "my-cluster",
+					AdditionalTags:           make(infrav1.Tags),
+					LastAppliedSecurityRules: map[string]interface{}{},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1348,6 +1697,217 @@ func TestNSGSpecs(t *testing.T) {
 	}
 }
 
+func TestApplicationSecurityGroupSpecs(t *testing.T) {
+	tests := []struct {
+		name         string
+		clusterScope ClusterScope
+		want         []azure.ResourceSpecGetter
+	}{
+		{
+			name: "returns nil if no application security groups are specified",
+			clusterScope: ClusterScope{
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "returns specified application security groups if present",
+			clusterScope: ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-cluster",
+					},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						ResourceGroup: "my-rg",
+						AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+							Location: "centralIndia",
+						},
+						NetworkSpec: infrav1.NetworkSpec{
+							NetworkClassSpec: infrav1.NetworkClassSpec{
+								ApplicationSecurityGroups: []infrav1.ApplicationSecurityGroup{
+									{Name: "control-plane-asg", Role: "control-plane"},
+									{Name: "web"},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []azure.ResourceSpecGetter{
+				&applicationsecuritygroups.ApplicationSecurityGroupSpec{
+					Name:           "control-plane-asg",
+					ResourceGroup:  "my-rg",
+					Location:       "centralIndia",
+					ClusterName:    "my-cluster",
+					AdditionalTags: make(infrav1.Tags),
+				},
+				&applicationsecuritygroups.ApplicationSecurityGroupSpec{
+					Name:           "web",
+					ResourceGroup:  "my-rg",
+					Location:       "centralIndia",
+					ClusterName:    "my-cluster",
+					AdditionalTags: make(infrav1.Tags),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.clusterScope.ApplicationSecurityGroupSpecs(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ApplicationSecurityGroupSpecs() = %s, want %s", specArrayToString(got), specArrayToString(tt.want))
+			}
+		})
+	}
+}
+
+func TestFlowLogSpecs(t *testing.T) {
+	tests := []struct {
+		name         string
+		clusterScope ClusterScope
+		want         []azure.ResourceSpecGetter
+	}{
+		{
+			name: "returns nil if no flow logs are specified",
+			clusterScope: ClusterScope{
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						NetworkSpec: infrav1.NetworkSpec{
+							Subnets: infrav1.Subnets{
+								{
+									SecurityGroup: infrav1.SecurityGroup{
+										Name: "fake-security-group-1",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "returns flow logs with defaults and skips shared security groups",
+			clusterScope: ClusterScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-cluster",
+					},
+				},
+				AzureClients: AzureClients{
+					EnvironmentSettings: auth.EnvironmentSettings{
+						Values: map[string]string{
+							auth.SubscriptionID: "123",
+						},
+					},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+							Location: "centralIndia",
+						},
+						NetworkSpec: infrav1.NetworkSpec{
+							Vnet: infrav1.VnetSpec{
+								ResourceGroup: "my-rg",
+							},
+							Subnets: infrav1.Subnets{
+								{
+									SecurityGroup: infrav1.SecurityGroup{
+										Name: "fake-security-group-1",
+										SecurityGroupClass: infrav1.SecurityGroupClass{
+											FlowLog: &infrav1.FlowLog{
+												StorageAccountID: "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.Storage/storageAccounts/flowlogs",
+												TrafficAnalytics: &infrav1.TrafficAnalytics{
+													WorkspaceResourceID: "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.OperationalInsights/workspaces/my-workspace",
+													WorkspaceID:         "00000000-0000-0000-0000-000000000000",
+												},
+											},
+										},
+									},
+								},
+								{
+									SecurityGroup: infrav1.SecurityGroup{
+										Name: "fake-security-group-1",
+										SecurityGroupClass: infrav1.SecurityGroupClass{
+											FlowLog: &infrav1.FlowLog{
+												StorageAccountID: "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.Storage/storageAccounts/flowlogs",
+											},
+										},
+									},
+								},
+								{
+									SecurityGroup: infrav1.SecurityGroup{
+										Name: "fake-security-group-2",
+										SecurityGroupClass: infrav1.SecurityGroupClass{
+											FlowLog: &infrav1.FlowLog{
+												Name:                        "my-flowlog",
+												Enabled:                     ptr.To(false),
+												NetworkWatcherName:          "my-network-watcher",
+												NetworkWatcherResourceGroup: "my-network-watcher-rg",
+												StorageAccountID:            "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.Storage/storageAccounts/flowlogs",
+												RetentionDays:               ptr.To[int32](7),
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []azure.ResourceSpecGetter{
+				&flowlogs.FlowLogSpec{
+					Name:               "fake-security-group-1-flowlog",
+					ResourceGroup:      "NetworkWatcherRG",
+					NetworkWatcherName: "NetworkWatcher_centralIndia",
+					Location:           "centralIndia",
+					SecurityGroupID:    "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/fake-security-group-1",
+					StorageAccountID:   "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.Storage/storageAccounts/flowlogs",
+					Enabled:            true,
+					TrafficAnalytics: &infrav1.TrafficAnalytics{
+						WorkspaceResourceID: "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.OperationalInsights/workspaces/my-workspace",
+						WorkspaceID:         "00000000-0000-0000-0000-000000000000",
+						WorkspaceRegion:     "centralIndia",
+						IntervalInMinutes:   ptr.To[int32](60),
+					},
+					ClusterName:    "my-cluster",
+					AdditionalTags: make(infrav1.Tags),
+				},
+				&flowlogs.FlowLogSpec{
+					Name:               "my-flowlog",
+					ResourceGroup:      "my-network-watcher-rg",
+					NetworkWatcherName: "my-network-watcher",
+					Location:           "centralIndia",
+					SecurityGroupID:    "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/fake-security-group-2",
+					StorageAccountID:   "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.Storage/storageAccounts/flowlogs",
+					Enabled:            false,
+					RetentionDays:      ptr.To[int32](7),
+					ClusterName:        "my-cluster",
+					AdditionalTags:     make(infrav1.Tags),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := tt.clusterScope.FlowLogSpecs(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FlowLogSpecs() = %s, want %s", specArrayToString(got), specArrayToString(tt.want))
+			}
+		})
+	}
+}
+
 func TestSubnetSpecs(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -3092,6 +3652,7 @@ func TestClusterScope_LBSpecs(t *testing.T) {
 					Role:                 infrav1.APIServerRole,
 					BackendPoolName:      "api-server-lb-backend-pool",
 					IdleTimeoutInMinutes: ptr.To[int32](30),
+					LastAppliedRules:     map[string]interface{}{},
 					AdditionalTags: infrav1.Tags{
 						"foo": "bar",
 					},
@@ -3212,10 +3773,107 @@ func TestClusterScope_LBSpecs(t *testing.T) {
 					Role:                 infrav1.APIServerRole,
 					BackendPoolName:      "api-server-lb-backend-pool",
 					IdleTimeoutInMinutes: ptr.To[int32](30),
+					LastAppliedRules:     map[string]interface{}{},
 					AdditionalTags:       infrav1.Tags{},
 				},
 			},
 		},
+		{
+			name: "API Server LB with health probe and additional rules",
+			azureCluster: &infrav1.AzureCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-cluster",
+					Annotations: map[string]string{
+						azure.LoadBalancingRuleLastAppliedAnnotation: `{"api-server-lb":{"ingress":"ingress-probe"}}`,
+					},
+				},
+				Spec: infrav1.AzureClusterSpec{
+					AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+						SubscriptionID: "123",
+						Location:       "westus2",
+						IdentityRef: &corev1.ObjectReference{
+							Kind: infrav1.AzureClusterIdentityKind,
+						},
+					},
+					ResourceGroup: "my-rg",
+					NetworkSpec: infrav1.NetworkSpec{
+						Vnet: infrav1.VnetSpec{
+							Name:          "my-vnet",
+							ResourceGroup: "my-rg",
+						},
+						Subnets: []infrav1.SubnetSpec{
+							{
+								SubnetClassSpec: infrav1.SubnetClassSpec{
+									Name: "cp-subnet",
+									Role: infrav1.SubnetControlPlane,
+								},
+							},
+							{
+								SubnetClassSpec: infrav1.SubnetClassSpec{
+									Name: "node-subnet",
+									Role: infrav1.SubnetNode,
+								},
+							},
+						},
+						APIServerLB: infrav1.LoadBalancerSpec{
+							Name: "api-server-lb",
+							BackendPool: infrav1.BackendPool{
+								Name: "api-server-lb-backend-pool",
+							},
+							LoadBalancerClassSpec: infrav1.LoadBalancerClassSpec{
+								Type:                 infrav1.Internal,
+								IdleTimeoutInMinutes: ptr.To[int32](30),
+								SKU:                  infrav1.SKUStandard,
+								HealthProbe: &infrav1.LoadBalancerProbe{
+									Protocol:          infrav1.LoadBalancerProbeProtocolTCP,
+									IntervalInSeconds: ptr.To[int32](5),
+								},
+								AdditionalRules: []infrav1.LoadBalancingRule{
+									{
+										Name:         "konnectivity",
+										FrontendPort: 8132,
+										BackendPort:  8132,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			want: []azure.ResourceSpecGetter{
+				&loadbalancers.LBSpec{
+					Name:                 "api-server-lb",
+					ResourceGroup:        "my-rg",
+					SubscriptionID:       "123",
+					ClusterName:          "my-cluster",
+					Location:             "westus2",
+					VNetName:             "my-vnet",
+					VNetResourceGroup:    "my-rg",
+					SubnetName:           "cp-subnet",
+					APIServerPort:        6443,
+					Type:                 infrav1.Internal,
+					SKU:                  infrav1.SKUStandard,
+					Role:                 infrav1.APIServerRole,
+					BackendPoolName:      "api-server-lb-backend-pool",
+					IdleTimeoutInMinutes: ptr.To[int32](30),
+					HealthProbe: &infrav1.LoadBalancerProbe{
+						Protocol:          infrav1.LoadBalancerProbeProtocolTCP,
+						IntervalInSeconds: ptr.To[int32](5),
+					},
+					AdditionalRules: []infrav1.LoadBalancingRule{
+						{
+							Name:         "konnectivity",
+							FrontendPort: 8132,
+							BackendPort:  8132,
+						},
+					},
+					LastAppliedRules: map[string]interface{}{
+						"ingress": "ingress-probe",
+					},
+					AdditionalTags: infrav1.Tags{},
+				},
+			},
+		},
 	}
 	for _, tc := range tests {
 		tc := tc
@@ -3475,12 +4133,13 @@ func TestVNetPeerings(t *testing.T) {
 					SubscriptionID:      fakeSubscriptionID,
 				},
 				&vnetpeerings.VnetPeeringSpec{
-					PeeringName:         "vnet2-To-vnet1",
-					SourceResourceGroup: "rg2",
-					SourceVnetName:      "vnet2",
-					RemoteResourceGroup: "rg1",
-					RemoteVnetName:      "vnet1",
-					SubscriptionID:      fakeSubscriptionID,
+					PeeringName:          "vnet2-To-vnet1",
+					SourceResourceGroup:  "rg2",
+					SourceVnetName:       "vnet2",
+					RemoteResourceGroup:  "rg1",
+					RemoteVnetName:       "vnet1",
+					SubscriptionID:       fakeSubscriptionID,
+					SourceSubscriptionID: fakeSubscriptionID,
 				},
 			},
 		},
@@ -3528,6 +4187,7 @@ func TestVNetPeerings(t *testing.T) {
 					RemoteResourceGroup:   "rg1",
 					RemoteVnetName:        "vnet1",
 					SubscriptionID:        fakeSubscriptionID,
+					SourceSubscriptionID:  fakeSubscriptionID,
 					AllowForwardedTraffic: ptr.To(true),
 					AllowGatewayTransit:   ptr.To(true),
 					UseRemoteGateways:     ptr.To(false),
@@ -3584,6 +4244,7 @@ func TestVNetPeerings(t *testing.T) {
 					RemoteResourceGroup:   "rg1",
 					RemoteVnetName:        "vnet1",
 					SubscriptionID:        fakeSubscriptionID,
+					SourceSubscriptionID:  fakeSubscriptionID,
 					AllowForwardedTraffic: ptr.To(true),
 					AllowGatewayTransit:   ptr.To(true),
 					UseRemoteGateways:     ptr.To(false),
@@ -3597,12 +4258,65 @@ func TestVNetPeerings(t *testing.T) {
 					SubscriptionID:      fakeSubscriptionID,
 				},
 				&vnetpeerings.VnetPeeringSpec{
-					PeeringName:         "vnet3-To-vnet1",
-					SourceResourceGroup: "rg3",
-					SourceVnetName:      "vnet3",
-					RemoteResourceGroup: "rg1",
-					RemoteVnetName:      "vnet1",
-					SubscriptionID:      fakeSubscriptionID,
+					PeeringName:          "vnet3-To-vnet1",
+					SourceResourceGroup:  "rg3",
+					SourceVnetName:       "vnet3",
+					RemoteResourceGroup:  "rg1",
+					RemoteVnetName:       "vnet1",
+					SubscriptionID:       fakeSubscriptionID,
+					SourceSubscriptionID: fakeSubscriptionID,
+				},
+			},
+		},
+		{
+			name:           "VNet peering with a remote VNet in another subscription is specified",
+			subscriptionID: fakeSubscriptionID,
+			azureClusterVNetSpec: infrav1.VnetSpec{
+				ResourceGroup: "rg1",
+				Name:          "vnet1",
+				Peerings: infrav1.VnetPeerings{
+					{
+						VnetPeeringClassSpec: infrav1.VnetPeeringClassSpec{
+							ResourceGroup:  "hub-rg",
+							RemoteVnetName: "hub-vnet",
+							RemoteVnetID:   "/subscriptions/hub-subscription/resourceGroups/hub-rg/providers/Microsoft.Network/virtualNetworks/hub-vnet",
+							ReversePeeringIdentityRef: &corev1.ObjectReference{
+								Kind: infrav1.AzureClusterIdentityKind,
+								Name: "hub-identity",
+							},
+							ForwardPeeringProperties: infrav1.VnetPeeringProperties{
+								UseRemoteGateways: ptr.To(true),
+							},
+							ReversePeeringProperties: infrav1.VnetPeeringProperties{
+								AllowGatewayTransit: ptr.To(true),
+							},
+						},
+					},
+				},
+			},
+			want: []azure.ResourceSpecGetter{
+				&vnetpeerings.VnetPeeringSpec{
+					PeeringName:         "vnet1-To-hub-vnet",
+					SourceResourceGroup: "rg1",
+					SourceVnetName:      "vnet1",
+					RemoteResourceGroup: "hub-rg",
+					RemoteVnetName:      "hub-vnet",
+					SubscriptionID:      "hub-subscription",
+					UseRemoteGateways:   ptr.To(true),
+				},
+				&vnetpeerings.VnetPeeringSpec{
+					PeeringName:          "hub-vnet-To-vnet1",
+					SourceResourceGroup:  "hub-rg",
+					SourceVnetName:       "hub-vnet",
+					RemoteResourceGroup:  "rg1",
+					RemoteVnetName:       "vnet1",
+					SubscriptionID:       fakeSubscriptionID,
+					SourceSubscriptionID: "hub-subscription",
+					IdentityRef: &corev1.ObjectReference{
+						Kind: infrav1.AzureClusterIdentityKind,
+						Name: "hub-identity",
+					},
+					AllowGatewayTransit: ptr.To(true),
 				},
 			},
 		},
@@ -26,14 +26,18 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/availabilitysets"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bootstrapdata"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bootstrapdiagnostics"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/disks"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/inboundnatrules"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/networkinterfaces"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/proximityplacementgroups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/publicips"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/roleassignments"
@@ -49,6 +53,7 @@ import (
 	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/patch"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 )
 
 // MachineScopeParams defines the input parameters used to create a new MachineScope.
@@ -110,6 +115,7 @@ type SKUCacher interface {
 // MachineCache stores common machine information so we don't have to hit the API multiple times within the same reconcile loop.
 type MachineCache struct {
 	BootstrapData      string
+	UserData           string
 	VMImage            *infrav1.Image
 	VMSKU              resourceskus.SKU
 	availabilitySetSKU resourceskus.SKU
@@ -129,6 +135,11 @@ func (m *MachineScope) InitMachineCache(ctx context.Context) error {
 			return err
 		}
 
+		m.cache.UserData, err = m.GetUserData(ctx)
+		if err != nil {
+			return err
+		}
+
 		m.cache.VMImage, err = m.GetVMImage(ctx)
 		if err != nil {
 			return err
@@ -157,6 +168,89 @@ func (m *MachineScope) InitMachineCache(ctx context.Context) error {
 	return nil
 }
 
+// ValidateVMSize checks that the VM size supports the features and the availability zone the AzureMachine
+// requires. It returns a terminal error listing the incompatibilities, if any, and must be called after InitMachineCache.
+func (m *MachineScope) ValidateVMSize() error {
+	if m.cache == nil {
+		return errors.New("machine cache is not initialized")
+	}
+
+	var zones []string
+	if zone := m.AvailabilityZone(); zone != "" {
+		zones = []string{zone}
+	}
+	spec := m.AzureMachine.Spec
+	req := vmRequirements(m.Location(), zones, spec.OSDisk, spec.DataDisks, spec.NetworkInterfaces, spec.SecurityProfile)
+	if spec.AdditionalCapabilities != nil && ptr.Deref(spec.AdditionalCapabilities.UltraSSDEnabled, false) {
+		req.UltraSSD = true
+	}
+
+	if err := m.cache.VMSKU.ValidateVMRequirements(req); err != nil {
+		return azure.WithTerminalError(errors.Wrapf(err, "vm size %s is not compatible with the AzureMachine", spec.VMSize))
+	}
+	return nil
+}
+
+// vmRequirements returns the features VMs with the given configuration require from their VM size.
+func vmRequirements(location string, zones []string, osDisk infrav1.OSDisk, dataDisks []infrav1.DataDisk, nics []infrav1.NetworkInterface, securityProfile *infrav1.SecurityProfile) resourceskus.VMRequirements {
+	req := resourceskus.VMRequirements{
+		Location:     location,
+		Zones:        zones,
+		OSDiskSizeGB: osDisk.DiskSizeGB,
+	}
+
+	for _, nic := range nics {
+		if ptr.Deref(nic.AcceleratedNetworking, false) {
+			req.AcceleratedNetworking = true
+		}
+	}
+
+	if osDisk.ManagedDisk != nil {
+		req.PremiumStorage = isPremiumStorage(osDisk.ManagedDisk.StorageAccountType)
+		if osDisk.ManagedDisk.SecurityProfile != nil {
+			req.ConfidentialComputing = true
+		}
+	}
+	for _, disk := range dataDisks {
+		if disk.ManagedDisk == nil {
+			continue
+		}
+		if isPremiumStorage(disk.ManagedDisk.StorageAccountType) {
+			req.PremiumStorage = true
+		}
+		if disk.ManagedDisk.StorageAccountType == string(armcompute.StorageAccountTypesUltraSSDLRS) {
+			req.UltraSSD = true
+		}
+	}
+
+	if osDisk.DiffDiskSettings != nil {
+		req.EphemeralOSDisk = true
+		req.EphemeralOSDiskPlacement = armcompute.DiffDiskPlacement(ptr.Deref(osDisk.DiffDiskSettings.Placement, ""))
+	}
+
+	if securityProfile != nil {
+		req.EncryptionAtHost = ptr.Deref(securityProfile.EncryptionAtHost, false)
+		switch securityProfile.SecurityType {
+		case infrav1.SecurityTypesTrustedLaunch:
+			req.TrustedLaunch = true
+		case infrav1.SecurityTypesConfidentialVM:
+			req.ConfidentialComputing = true
+		}
+	}
+
+	return req
+}
+
+// isPremiumStorage returns true if the storage account type requires a VM size that supports premium storage.
+func isPremiumStorage(storageAccountType string) bool {
+	switch armcompute.StorageAccountTypes(storageAccountType) {
+	case armcompute.StorageAccountTypesPremiumLRS, armcompute.StorageAccountTypesPremiumZRS, armcompute.StorageAccountTypesPremiumV2LRS:
+		return true
+	default:
+		return false
+	}
+}
+
 // VMSpec returns the VM spec.
 func (m *MachineScope) VMSpec() azure.ResourceSpecGetter {
 	spec := &virtualmachines.VMSpec{
@@ -181,12 +275,16 @@ func (m *MachineScope) VMSpec() azure.ResourceSpecGetter {
 		AdditionalTags:             m.AdditionalTags(),
 		AdditionalCapabilities:     m.AzureMachine.Spec.AdditionalCapabilities,
 		CapacityReservationGroupID: m.GetCapacityReservationGroupID(),
+		ProximityPlacementGroupID:  m.ProximityPlacementGroupID(),
+		HostGroupID:                ptr.Deref(m.AzureMachine.Spec.HostGroupID, ""),
+		HostID:                     ptr.Deref(m.AzureMachine.Spec.HostID, ""),
 		ProviderID:                 m.ProviderID(),
 	}
 	if m.cache != nil {
 		spec.SKU = m.cache.VMSKU
 		spec.Image = m.cache.VMImage
 		spec.BootstrapData = m.cache.BootstrapData
+		spec.UserData = m.cache.UserData
 	}
 	return spec
 }
@@ -221,6 +319,17 @@ func (m *MachineScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 	return specs
 }
 
+// PublicIPPrefixSpecs returns nil as machines don't use public IP prefixes.
+func (m *MachineScope) PublicIPPrefixSpecs() []azure.ResourceSpecGetter {
+	return nil
+}
+
+// SetPublicIPAddress is a no-op for machines, the public IP of a machine is reported in its addresses.
+func (m *MachineScope) SetPublicIPAddress(name, address string) {}
+
+// SetPublicIPPrefix is a no-op for machines.
+func (m *MachineScope) SetPublicIPPrefix(name, prefix string) {}
+
 // InboundNatSpecs returns the inbound NAT specs.
 func (m *MachineScope) InboundNatSpecs() []azure.ResourceSpecGetter {
 	// The existing inbound NAT rules are needed in order to find an available SSH port for each new inbound NAT rule.
@@ -261,21 +370,22 @@ func (m *MachineScope) NICSpecs() []azure.ResourceSpecGetter {
 // BuildNICSpec takes a NetworkInterface from the AzureMachineSpec and returns a NICSpec for use by the networkinterfaces service.
 func (m *MachineScope) BuildNICSpec(nicName string, infrav1NetworkInterface infrav1.NetworkInterface, primaryNetworkInterface bool) *networkinterfaces.NICSpec {
 	spec := &networkinterfaces.NICSpec{
-		Name:                  nicName,
-		ResourceGroup:         m.NodeResourceGroup(),
-		Location:              m.Location(),
-		ExtendedLocation:      m.ExtendedLocation(),
-		SubscriptionID:        m.SubscriptionID(),
-		MachineName:           m.Name(),
-		VNetName:              m.Vnet().Name,
-		VNetResourceGroup:     m.Vnet().ResourceGroup,
-		AcceleratedNetworking: infrav1NetworkInterface.AcceleratedNetworking,
-		IPv6Enabled:           m.IsIPv6Enabled(),
-		EnableIPForwarding:    m.AzureMachine.Spec.EnableIPForwarding,
-		SubnetName:            infrav1NetworkInterface.SubnetName,
-		AdditionalTags:        m.AdditionalTags(),
-		ClusterName:           m.ClusterName(),
-		IPConfigs:             []networkinterfaces.IPConfig{},
+		Name:                        nicName,
+		ResourceGroup:               m.NodeResourceGroup(),
+		Location:                    m.Location(),
+		ExtendedLocation:            m.ExtendedLocation(),
+		SubscriptionID:              m.SubscriptionID(),
+		MachineName:                 m.Name(),
+		VNetName:                    m.Vnet().Name,
+		VNetResourceGroup:           m.Vnet().ResourceGroup,
+		AcceleratedNetworking:       infrav1NetworkInterface.AcceleratedNetworking,
+		IPv6Enabled:                 m.IsIPv6Enabled(),
+		EnableIPForwarding:          m.AzureMachine.Spec.EnableIPForwarding,
+		SubnetName:                  infrav1NetworkInterface.SubnetName,
+		AdditionalTags:              m.AdditionalTags(),
+		ClusterName:                 m.ClusterName(),
+		IPConfigs:                   []networkinterfaces.IPConfig{},
+		ApplicationSecurityGroupIDs: m.applicationSecurityGroupIDs(infrav1NetworkInterface.SubnetName),
 	}
 
 	if m.cache != nil {
@@ -314,6 +424,31 @@ func (m *MachineScope) BuildNICSpec(nicName string, infrav1NetworkInterface infr
 	return spec
 }
 
+// applicationSecurityGroupIDs returns the resource IDs of the application security groups a network interface of the machine
+// joins: the cluster's application security groups of the machine's role, or of the bastion role if the network interface
+// is in a bastion subnet, and the ones listed in the AzureMachine spec.
+func (m *MachineScope) applicationSecurityGroupIDs(subnetName string) []string {
+	var refs []string
+	seen := make(map[string]struct{})
+	for _, asg := range m.ApplicationSecurityGroups() {
+		member := asg.Role != "" && asg.Role == m.Role()
+		if asg.Role == infrav1.Bastion {
+			member = m.ClusterScoper.Subnet(subnetName).Role == infrav1.SubnetBastion
+		}
+		if member {
+			refs = append(refs, asg.Name)
+			seen[strings.ToLower(asg.Name)] = struct{}{}
+		}
+	}
+	for _, ref := range m.AzureMachine.Spec.ApplicationSecurityGroups {
+		if _, ok := seen[strings.ToLower(ref)]; !ok {
+			refs = append(refs, ref)
+			seen[strings.ToLower(ref)] = struct{}{}
+		}
+	}
+	return applicationSecurityGroupIDs(m.SubscriptionID(), m.ResourceGroup(), refs)
+}
+
 // NICIDs returns the NIC resource IDs.
 func (m *MachineScope) NICIDs() []string {
 	nicspecs := m.NICSpecs()
@@ -325,19 +460,65 @@ func (m *MachineScope) NICIDs() []string {
 	return nicIDs
 }
 
-// DiskSpecs returns the disk specs.
+// DiskSpecs returns the specs of the disks created by CAPZ for the machine.
 func (m *MachineScope) DiskSpecs() []azure.ResourceSpecGetter {
-	diskSpecs := make([]azure.ResourceSpecGetter, 1+len(m.AzureMachine.Spec.DataDisks))
-	diskSpecs[0] = &disks.DiskSpec{
-		Name:          azure.GenerateOSDiskName(m.Name()),
-		ResourceGroup: m.NodeResourceGroup(),
+	diskSpecs := []azure.ResourceSpecGetter{
+		&disks.DiskSpec{
+			Name:          azure.GenerateOSDiskName(m.Name()),
+			ResourceGroup: m.NodeResourceGroup(),
+		},
 	}
 
-	for i, dd := range m.AzureMachine.Spec.DataDisks {
-		diskSpecs[i+1] = &disks.DiskSpec{
-			Name:          azure.GenerateDataDiskName(m.Name(), dd.NameSuffix),
-			ResourceGroup: m.NodeResourceGroup(),
+	lastAppliedDiskSizes := m.getLastAppliedDiskSizes()
+	for _, dd := range m.AzureMachine.Spec.DataDisks {
+		if dd.ManagedDiskID != nil {
+			// existing disks are not owned by CAPZ and must not be deleted with the machine.
+			continue
+		}
+		diskSpecs = append(diskSpecs, &disks.DiskSpec{
+			Name:                  azure.GenerateDataDiskName(m.Name(), dd.NameSuffix),
+			ResourceGroup:         m.NodeResourceGroup(),
+			DiskSizeGB:            dd.DiskSizeGB,
+			DeletionPolicy:        dd.DeletionPolicy,
+			LastAppliedDiskSizeGB: lastAppliedDiskSizes[azure.GenerateDataDiskName(m.Name(), dd.NameSuffix)],
+		})
+	}
+	return diskSpecs
+}
+
+// getLastAppliedDiskSizes returns the sizes CAPZ last applied to the data disks it created, by disk name.
+func (m *MachineScope) getLastAppliedDiskSizes() map[string]int32 {
+	sizes := map[string]int32{}
+	lastAppliedSizes, err := m.AnnotationJSON(azure.DiskSizeLastAppliedAnnotation)
+	if err != nil {
+		return sizes
+	}
+	for name, size := range lastAppliedSizes {
+		if sizeGB, ok := size.(float64); ok {
+			sizes[name] = int32(sizeGB)
+		}
+	}
+	return sizes
+}
+
+// ExistingDiskSpecs returns the specs of the existing disks attached to the machine.
+func (m *MachineScope) ExistingDiskSpecs() []azure.ResourceSpecGetter {
+	var diskSpecs []azure.ResourceSpecGetter
+	for _, dd := range m.AzureMachine.Spec.DataDisks {
+		if dd.ManagedDiskID == nil {
+			continue
+		}
+		resourceID, err := azureutil.ParseResourceID(*dd.ManagedDiskID)
+		if err != nil {
+			// the webhook validates the disk ID, and the VM service surfaces the error if it is invalid.
+			continue
 		}
+		diskSpecs = append(diskSpecs, &disks.ExistingDiskSpec{
+			Name:          resourceID.Name,
+			ResourceGroup: resourceID.ResourceGroupName,
+			VMID:          azure.VMID(m.SubscriptionID(), m.NodeResourceGroup(), m.Name()),
+			MaxShares:     dd.MaxShares,
+		})
 	}
 	return diskSpecs
 }
@@ -391,7 +572,7 @@ func (m *MachineScope) VMExtensionSpecs() []azure.ResourceSpecGetter {
 	}
 
 	cpuArchitectureType, _ := m.cache.VMSKU.GetCapability(resourceskus.CPUArchitectureType)
-	bootstrapExtensionSpec := azure.GetBootstrappingVMExtension(m.AzureMachine.Spec.OSDisk.OSType, m.CloudEnvironment(), m.Name(), cpuArchitectureType)
+	bootstrapExtensionSpec := azure.GetBootstrappingVMExtension(m.AzureMachine.Spec.OSDisk.OSType, m.CloudEnvironment(), m.Name(), cpuArchitectureType, m.AzureMachine.Spec.BootstrapCheck)
 
 	if bootstrapExtensionSpec != nil {
 		extensionSpecs = append(extensionSpecs, &vmextensions.VMExtensionSpec{
@@ -490,6 +671,8 @@ func (m *MachineScope) AvailabilitySetSpec() azure.ResourceSpecGetter {
 		Location:       m.Location(),
 		SKU:            nil,
 		AdditionalTags: m.AdditionalTags(),
+		// Every VM in an availability set must be in the availability set's proximity placement group.
+		ProximityPlacementGroupID: m.ProximityPlacementGroupID(),
 	}
 
 	if m.cache != nil {
@@ -499,11 +682,53 @@ func (m *MachineScope) AvailabilitySetSpec() azure.ResourceSpecGetter {
 	return spec
 }
 
+// ProximityPlacementGroupSpec returns the spec of the proximity placement group created and owned by CAPZ for the
+// machine, or nil if the machine does not use one.
+func (m *MachineScope) ProximityPlacementGroupSpec() azure.ResourceSpecGetter {
+	ppg := m.AzureMachine.Spec.ProximityPlacementGroup
+	if ppg == nil {
+		return nil
+	}
+	spec := &proximityplacementgroups.ProximityPlacementGroupSpec{
+		Name:           azure.GenerateProximityPlacementGroupName(ppg.Name, m.AvailabilityZone()),
+		ResourceGroup:  m.proximityPlacementGroupResourceGroup(ppg),
+		ClusterName:    m.ClusterName(),
+		Location:       m.Location(),
+		VMSizes:        ppg.VMSizes,
+		AdditionalTags: m.AdditionalTags(),
+	}
+	if zone := m.AvailabilityZone(); zone != "" {
+		spec.Zones = []string{zone}
+	}
+	return spec
+}
+
+// ProximityPlacementGroupID returns the ID of the proximity placement group the machine is placed in, which is either
+// the one referenced by ID or the one created and owned by CAPZ, or "" if the machine is not in one.
+func (m *MachineScope) ProximityPlacementGroupID() string {
+	if ppg := m.AzureMachine.Spec.ProximityPlacementGroup; ppg != nil {
+		return azure.ProximityPlacementGroupID(
+			m.SubscriptionID(),
+			m.proximityPlacementGroupResourceGroup(ppg),
+			azure.GenerateProximityPlacementGroupName(ppg.Name, m.AvailabilityZone()),
+		)
+	}
+	return ptr.Deref(m.AzureMachine.Spec.ProximityPlacementGroupID, "")
+}
+
+func (m *MachineScope) proximityPlacementGroupResourceGroup(ppg *infrav1.ProximityPlacementGroup) string {
+	if ppg.ResourceGroup != "" {
+		return ppg.ResourceGroup
+	}
+	return m.NodeResourceGroup()
+}
+
 // AvailabilitySet returns the availability set for this machine if available.
 func (m *MachineScope) AvailabilitySet() (string, bool) {
 	// AvailabilitySet service is not supported on EdgeZone currently.
-	// AvailabilitySet cannot be used with Spot instances.
-	if !m.AvailabilitySetEnabled() || m.AzureMachine.Spec.SpotVMOptions != nil || m.ExtendedLocation() != nil {
+	// AvailabilitySet cannot be used with Spot instances or dedicated hosts.
+	if !m.AvailabilitySetEnabled() || m.AzureMachine.Spec.SpotVMOptions != nil || m.ExtendedLocation() != nil ||
+		m.AzureMachine.Spec.HostGroupID != nil || m.AzureMachine.Spec.HostID != nil {
 		return "", false
 	}
 
@@ -650,6 +875,8 @@ func (m *MachineScope) PatchObject(ctx context.Context) error {
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 			clusterv1.ReadyCondition,
 			infrav1.VMRunningCondition,
+			infrav1.VMSizeCompatibleCondition,
+			infrav1.ProximityPlacementGroupReadyCondition,
 			infrav1.AvailabilitySetReadyCondition,
 			infrav1.NetworkInterfaceReadyCondition,
 		}})
@@ -674,25 +901,236 @@ func (m *MachineScope) AdditionalTags() infrav1.Tags {
 	return tags
 }
 
-// GetBootstrapData returns the bootstrap data from the secret in the Machine's bootstrap.dataSecretName.
+// GetBootstrapData returns the custom data of the VM. This is the bootstrap data from the secret in the Machine's
+// bootstrap.dataSecretName, or a loader for it if it is delivered another way.
 func (m *MachineScope) GetBootstrapData(ctx context.Context) (string, error) {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "scope.MachineScope.GetBootstrapData")
 	defer done()
 
+	if m.hasUserDataBootstrapTransport() {
+		loader, err := bootstrapdata.UserDataLoader()
+		if err != nil {
+			return "", err
+		}
+		return base64.StdEncoding.EncodeToString(loader), nil
+	}
+
+	if m.BootstrapDataSpec() == nil {
+		value, err := m.getBootstrapDataSecretValue(ctx)
+		if err != nil {
+			return "", err
+		}
+		return base64.StdEncoding.EncodeToString(value), nil
+	}
+
+	// The bootstrap data is stored outside of the VM's custom data by the bootstrap data service, and the custom
+	// data only contains a loader for it.
+	loader, err := bootstrapdata.New(m).Loader()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(loader), nil
+}
+
+// GetUserData returns the user data of the VM, which is the bootstrap data when it is delivered as user data.
+func (m *MachineScope) GetUserData(ctx context.Context) (string, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "scope.MachineScope.GetUserData")
+	defer done()
+
+	if !m.hasUserDataBootstrapTransport() {
+		return "", nil
+	}
+
+	value, err := m.getBootstrapDataSecretValue(ctx)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(value), nil
+}
+
+func (m *MachineScope) hasUserDataBootstrapTransport() bool {
+	transport := m.AzureMachine.Spec.BootstrapTransport
+	return transport != nil && transport.Type == infrav1.BootstrapTransportUserData
+}
+
+// getBootstrapDataSecretValue returns the bootstrap data from the secret in the Machine's bootstrap.dataSecretName.
+func (m *MachineScope) getBootstrapDataSecretValue(ctx context.Context) ([]byte, error) {
 	if m.Machine.Spec.Bootstrap.DataSecretName == nil {
-		return "", errors.New("error retrieving bootstrap data: linked Machine's bootstrap.dataSecretName is nil")
+		return nil, errors.New("error retrieving bootstrap data: linked Machine's bootstrap.dataSecretName is nil")
 	}
 	secret := &corev1.Secret{}
 	key := types.NamespacedName{Namespace: m.Namespace(), Name: *m.Machine.Spec.Bootstrap.DataSecretName}
 	if err := m.client.Get(ctx, key, secret); err != nil {
-		return "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for AzureMachine %s/%s", m.Namespace(), m.Name())
+		return nil, errors.Wrapf(err, "failed to retrieve bootstrap data secret for AzureMachine %s/%s", m.Namespace(), m.Name())
 	}
 
 	value, ok := secret.Data["value"]
 	if !ok {
-		return "", errors.New("error retrieving bootstrap data: secret value key is missing")
+		return nil, errors.New("error retrieving bootstrap data: secret value key is missing")
 	}
-	return base64.StdEncoding.EncodeToString(value), nil
+	return value, nil
+}
+
+// GetBootstrapDataValue returns the bootstrap data of the machine.
+func (m *MachineScope) GetBootstrapDataValue(ctx context.Context) ([]byte, error) {
+	return m.getBootstrapDataSecretValue(ctx)
+}
+
+// StoredBootstrapDataHash returns the hash of the bootstrap data last stored outside of the VM's custom data.
+func (m *MachineScope) StoredBootstrapDataHash() string {
+	return m.AzureMachine.GetAnnotations()[azure.BootstrapDataHashAnnotation]
+}
+
+// SetStoredBootstrapDataHash records the hash of the bootstrap data stored outside of the VM's custom data.
+func (m *MachineScope) SetStoredBootstrapDataHash(hash string) {
+	m.SetAnnotation(azure.BootstrapDataHashAnnotation, hash)
+}
+
+// BootstrapDataSpec returns where the bootstrap data of the machine is stored, or nil if it is passed to the VM as
+// custom data.
+func (m *MachineScope) BootstrapDataSpec() *bootstrapdata.BootstrapDataSpec {
+	spec := m.AzureMachine.Spec
+	return newBootstrapDataSpec(m, spec.BootstrapTransport, m.Name(), string(m.AzureMachine.UID), spec.Identity, spec.UserAssignedIdentities)
+}
+
+// newBootstrapDataSpec returns where the bootstrap data delivered with the given transport is stored, or nil if it is
+// passed to the VM as custom data or user data.
+func newBootstrapDataSpec(cluster azure.ClusterDescriber, transport *infrav1.BootstrapTransport, name, uid string, identity infrav1.VMIdentity, userAssignedIdentities []infrav1.UserAssignedIdentity) *bootstrapdata.BootstrapDataSpec {
+	if transport == nil {
+		return nil
+	}
+
+	spec := &bootstrapdata.BootstrapDataSpec{
+		Transport: transport.Type,
+		// The owner's UID keeps the name unique if an owner with the same name is created again,
+		// as deleted Key Vault secrets can't be reused until they are purged.
+		Name: strings.ReplaceAll(name, ".", "-") + "-" + uid,
+	}
+	switch transport.Type {
+	case infrav1.BootstrapTransportStorageBlob:
+		if transport.StorageBlob == nil {
+			return nil
+		}
+		spec.StorageAccountName = transport.StorageBlob.StorageAccountName
+		spec.ContainerName = transport.StorageBlob.ContainerName
+		if spec.ContainerName == "" {
+			spec.ContainerName = bootstrapDataContainerName(cluster.ClusterName())
+		}
+	case infrav1.BootstrapTransportKeyVaultSecret:
+		if transport.KeyVaultSecret == nil {
+			return nil
+		}
+		spec.VaultName = transport.KeyVaultSecret.VaultName
+	default:
+		return nil
+	}
+
+	if identity == infrav1.VMIdentityUserAssigned && len(userAssignedIdentities) > 0 {
+		spec.IdentityResourceID = strings.TrimPrefix(userAssignedIdentities[0].ProviderID, azureutil.ProviderIDPrefix)
+	}
+	return spec
+}
+
+// bootstrapDataContainerName returns a valid blob container name for the bootstrap data of a cluster.
+func bootstrapDataContainerName(clusterName string) string {
+	name := strings.ReplaceAll(strings.ToLower(clusterName), ".", "-")
+	if len(name) > 63 {
+		name = name[:63]
+	}
+	return strings.TrimRight(name, "-")
+}
+
+// IsBootstrapSucceeded returns true if the machine has bootstrapped successfully.
+func (m *MachineScope) IsBootstrapSucceeded() bool {
+	return conditions.IsTrue(m.AzureMachine, infrav1.BootstrapSucceededCondition)
+}
+
+// IsBootstrapCheckDisabled returns true if the bootstrap check VM extension is disabled for the machine.
+func (m *MachineScope) IsBootstrapCheckDisabled() bool {
+	return m.AzureMachine.Spec.BootstrapCheck != nil && m.AzureMachine.Spec.BootstrapCheck.Disabled
+}
+
+// UpdateBootstrapStatusFromNode sets the BootstrapSucceeded condition from the machine's Node when the
+// bootstrap check VM extension is disabled.
+func (m *MachineScope) UpdateBootstrapStatusFromNode() {
+	if !m.IsBootstrapCheckDisabled() {
+		return
+	}
+	if m.Machine.Status.NodeRef == nil {
+		conditions.MarkFalse(m.AzureMachine, infrav1.BootstrapSucceededCondition, infrav1.BootstrapInProgressReason, clusterv1.ConditionSeverityInfo, "waiting for the Node to register")
+		return
+	}
+	conditions.MarkTrue(m.AzureMachine, infrav1.BootstrapSucceededCondition)
+}
+
+// BootstrapDiagnosticsSpec returns the VM to collect bootstrap diagnostics from, or nil if it hasn't been created.
+func (m *MachineScope) BootstrapDiagnosticsSpec() *bootstrapdiagnostics.BootstrapDiagnosticsSpec {
+	if m.ProviderID() == "" {
+		return nil
+	}
+	diagnostics := m.AzureMachine.Spec.Diagnostics
+	return &bootstrapdiagnostics.BootstrapDiagnosticsSpec{
+		Name:          m.Name(),
+		ResourceGroup: m.NodeResourceGroup(),
+		BootDiagnosticsEnabled: diagnostics != nil && diagnostics.Boot != nil &&
+			diagnostics.Boot.StorageAccountType != infrav1.DisabledDiagnosticsStorage,
+	}
+}
+
+// NeedsBootstrapDiagnostics returns true if bootstrapping failed and no diagnostics have been collected for this failure.
+// A new failure is detected by its message, as the condition doesn't transition while bootstrapping keeps failing.
+func (m *MachineScope) NeedsBootstrapDiagnostics() bool {
+	cond := conditions.Get(m.AzureMachine, infrav1.BootstrapSucceededCondition)
+	if cond == nil || cond.Status != corev1.ConditionFalse || cond.Reason != infrav1.FailedReason {
+		return false
+	}
+	collected := m.AzureMachine.Status.BootstrapDiagnostics
+	return collected == nil || collected.FailureMessage != cond.Message || collected.CollectionTime.Before(&cond.LastTransitionTime)
+}
+
+// BootstrapDiagnosticsSecretName returns the name of the Secret the bootstrap diagnostics are stored in.
+func (m *MachineScope) BootstrapDiagnosticsSecretName() string {
+	return m.Name() + "-bootstrap-diagnostics"
+}
+
+// StoreBootstrapDiagnostics stores the bootstrap diagnostics in a Secret owned by the AzureMachine, as the serial
+// console log may contain sensitive data, and references it from the AzureMachine status.
+func (m *MachineScope) StoreBootstrapDiagnostics(ctx context.Context, diagnostics *bootstrapdiagnostics.Diagnostics) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "scope.MachineScope.StoreBootstrapDiagnostics")
+	defer done()
+
+	diagnosticsSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      m.BootstrapDiagnosticsSecretName(),
+			Namespace: m.Namespace(),
+		},
+	}
+	if _, err := controllerutil.CreateOrUpdate(ctx, m.client, diagnosticsSecret, func() error {
+		if diagnosticsSecret.Labels == nil {
+			diagnosticsSecret.Labels = make(map[string]string)
+		}
+		diagnosticsSecret.Labels[clusterv1.ClusterNameLabel] = m.ClusterName()
+		diagnosticsSecret.OwnerReferences = util.EnsureOwnerRef(diagnosticsSecret.OwnerReferences, metav1.OwnerReference{
+			APIVersion: infrav1.GroupVersion.String(),
+			Kind:       infrav1.AzureMachineKind,
+			Name:       m.AzureMachine.Name,
+			UID:        m.AzureMachine.UID,
+		})
+		diagnosticsSecret.Data = map[string][]byte{
+			infrav1.BootstrapDiagnosticsSerialConsoleLogKey: []byte(diagnostics.SerialConsoleLog),
+			infrav1.BootstrapDiagnosticsExtensionStatusKey:  []byte(diagnostics.ExtensionStatus),
+		}
+		return nil
+	}); err != nil {
+		return errors.Wrapf(err, "failed to store bootstrap diagnostics for AzureMachine %s/%s", m.Namespace(), m.Name())
+	}
+
+	m.AzureMachine.Status.BootstrapDiagnostics = &infrav1.BootstrapDiagnostics{
+		SecretName:     diagnosticsSecret.Name,
+		CollectionTime: metav1.Now(),
+		FailureMessage: conditions.GetMessage(m.AzureMachine, infrav1.BootstrapSucceededCondition),
+	}
+	return nil
 }
 
 // GetVMImage returns the image from the machine configuration, or a default one.
@@ -755,6 +1193,24 @@ func (m *MachineScope) SetSubnetName() error {
 	return nil
 }
 
+// ValidateSubnetRoles returns an error when a network interface of the AzureMachine is placed in a subnet that
+// machines cannot use, such as a subnet delegated to an Azure service.
+func (m *MachineScope) ValidateSubnetRoles() error {
+	return validateSubnetRoles(m.Subnets(), m.AzureMachine.Spec.NetworkInterfaces)
+}
+
+// validateSubnetRoles returns an error when one of the network interfaces is placed in a delegated subnet.
+func validateSubnetRoles(subnets infrav1.Subnets, nics []infrav1.NetworkInterface) error {
+	for _, nic := range nics {
+		for _, subnet := range subnets {
+			if subnet.Name == nic.SubnetName && subnet.Role == infrav1.SubnetDelegated {
+				return errors.Errorf("subnet %s has role %s and cannot be used by machines", subnet.Name, subnet.Role)
+			}
+		}
+	}
+	return nil
+}
+
 // SetLongRunningOperationState will set the future on the AzureMachine status to allow the resource to continue
 // in the next reconciliation.
 func (m *MachineScope) SetLongRunningOperationState(future *infrav1.Future) {
@@ -20,23 +20,30 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/authorization/armauthorization/v2"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	azureautorest "github.com/Azure/go-autorest/autorest/azure"
 	"github.com/Azure/go-autorest/autorest/azure/auth"
 	"github.com/google/go-cmp/cmp"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/mock_azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bootstrapdata"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bootstrapdiagnostics"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/disks"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/inboundnatrules"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/networkinterfaces"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/proximityplacementgroups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/publicips"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/roleassignments"
@@ -44,6 +51,9 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/virtualmachineimages/mock_virtualmachineimages"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/vmextensions"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestMachineScope_Name(t *testing.T) {
@@ -598,6 +608,106 @@ func TestMachineScope_VMExtensionSpecs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "If the bootstrap check uses a custom extension handler, it returns ExtensionSpec outside of AzurePublicCloud",
+			machineScope: MachineScope{
+				Machine: &clusterv1.Machine{},
+				AzureMachine: &infrav1.AzureMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machine-name",
+					},
+					Spec: infrav1.AzureMachineSpec{
+						OSDisk: infrav1.OSDisk{
+							OSType: "Linux",
+						},
+						BootstrapCheck: &infrav1.BootstrapCheck{
+							Command: "test -f /var/lib/my-image/ready",
+							Extension: &infrav1.BootstrapCheckExtension{
+								Publisher: "Microsoft.Azure.Extensions",
+								Type:      "CustomScript",
+								Version:   "2.1",
+							},
+						},
+					},
+				},
+				ClusterScoper: &ClusterScope{
+					AzureClients: AzureClients{
+						EnvironmentSettings: auth.EnvironmentSettings{
+							Environment: azureautorest.Environment{
+								Name: azureautorest.USGovernmentCloud.Name,
+							},
+						},
+					},
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+							AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+								Location: "westus",
+							},
+						},
+					},
+				},
+				cache: &MachineCache{
+					VMSKU: resourceskus.SKU{},
+				},
+			},
+			want: []azure.ResourceSpecGetter{
+				&vmextensions.VMExtensionSpec{
+					ExtensionSpec: azure.ExtensionSpec{
+						Name:      "CAPZ.Linux.Bootstrapping",
+						VMName:    "machine-name",
+						Publisher: "Microsoft.Azure.Extensions",
+						Type:      "CustomScript",
+						Version:   "2.1",
+						ProtectedSettings: map[string]string{
+							"commandToExecute": "test -f /var/lib/my-image/ready",
+						},
+					},
+					ResourceGroup: "my-rg",
+					Location:      "westus",
+				},
+			},
+		},
+		{
+			name: "If the bootstrap check is disabled, it returns empty",
+			machineScope: MachineScope{
+				Machine: &clusterv1.Machine{},
+				AzureMachine: &infrav1.AzureMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machine-name",
+					},
+					Spec: infrav1.AzureMachineSpec{
+						OSDisk: infrav1.OSDisk{
+							OSType: "Linux",
+						},
+						BootstrapCheck: &infrav1.BootstrapCheck{
+							Disabled: true,
+						},
+					},
+				},
+				ClusterScoper: &ClusterScope{
+					AzureClients: AzureClients{
+						EnvironmentSettings: auth.EnvironmentSettings{
+							Environment: azureautorest.Environment{
+								Name: azureautorest.PublicCloud.Name,
+							},
+						},
+					},
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+							AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+								Location: "westus",
+							},
+						},
+					},
+				},
+				cache: &MachineCache{
+					VMSKU: resourceskus.SKU{},
+				},
+			},
+			want: []azure.ResourceSpecGetter{},
+		},
 		{
 			name: "If OS type is Linux and cloud is not AzurePublicCloud, it returns empty",
 			machineScope: MachineScope{
@@ -987,6 +1097,55 @@ func TestMachineScope_Subnet(t *testing.T) {
 	}
 }
 
+func TestMachineScope_ValidateSubnetRoles(t *testing.T) {
+	subnets := infrav1.Subnets{
+		{SubnetClassSpec: infrav1.SubnetClassSpec{Name: "node-subnet", Role: infrav1.SubnetNode}},
+		{SubnetClassSpec: infrav1.SubnetClassSpec{Name: "postgres-subnet", Role: infrav1.SubnetDelegated}},
+	}
+	tests := []struct {
+		name    string
+		nics    []infrav1.NetworkInterface
+		wantErr string
+	}{
+		{
+			name: "machine in a node subnet",
+			nics: []infrav1.NetworkInterface{{SubnetName: "node-subnet"}},
+		},
+		{
+			name:    "machine with a network interface in a delegated subnet",
+			nics:    []infrav1.NetworkInterface{{SubnetName: "node-subnet"}, {SubnetName: "postgres-subnet"}},
+			wantErr: "subnet postgres-subnet has role delegated and cannot be used by machines",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machineScope := MachineScope{
+				AzureMachine: &infrav1.AzureMachine{
+					Spec: infrav1.AzureMachineSpec{
+						NetworkInterfaces: tt.nics,
+					},
+				},
+				ClusterScoper: &ClusterScope{
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							NetworkSpec: infrav1.NetworkSpec{
+								Subnets: subnets,
+							},
+						},
+					},
+				},
+			}
+			err := machineScope.ValidateSubnetRoles()
+			if tt.wantErr != "" {
+				g.Expect(err).To(MatchError(tt.wantErr))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
 func TestMachineScope_AvailabilityZone(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1322,6 +1481,35 @@ func TestMachineScope_AvailabilitySet(t *testing.T) {
 			wantAvailabilitySetName:      "cluster_foo-machine-set-as",
 			wantAvailabilitySetExistence: true,
 		},
+		{
+			name: "returns empty and false if machine is on a dedicated host",
+			machineScope: MachineScope{
+				ClusterScoper: &ClusterScope{
+					Cluster: &clusterv1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "cluster",
+						},
+					},
+					AzureCluster: &infrav1.AzureCluster{
+						Status: infrav1.AzureClusterStatus{},
+					},
+				},
+				Machine: &clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							clusterv1.MachineDeploymentNameLabel: "foo-machine-deployment",
+						},
+					},
+				},
+				AzureMachine: &infrav1.AzureMachine{
+					Spec: infrav1.AzureMachineSpec{
+						HostGroupID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/hostGroups/my-hg"),
+					},
+				},
+			},
+			wantAvailabilitySetName:      "",
+			wantAvailabilitySetExistence: false,
+		},
 		{
 			name: "returns AvailabilitySet name and true if AvailabilitySet is enabled for worker machine and machine deployment name takes precedence over machine set name",
 			machineScope: MachineScope{
@@ -1387,6 +1575,110 @@ func TestMachineScope_AvailabilitySet(t *testing.T) {
 	}
 }
 
+func TestMachineScope_ProximityPlacementGroup(t *testing.T) {
+	newMachineScope := func(failureDomain *string, spec infrav1.AzureMachineSpec) MachineScope {
+		return MachineScope{
+			ClusterScoper: &ClusterScope{
+				AzureClients: AzureClients{
+					EnvironmentSettings: auth.EnvironmentSettings{
+						Values: map[string]string{
+							auth.SubscriptionID: "123",
+						},
+					},
+				},
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster",
+					},
+				},
+				AzureCluster: &infrav1.AzureCluster{
+					Spec: infrav1.AzureClusterSpec{
+						ResourceGroup: "my-rg",
+						AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+							Location: "westus",
+						},
+					},
+				},
+			},
+			Machine: &clusterv1.Machine{
+				Spec: clusterv1.MachineSpec{
+					FailureDomain: failureDomain,
+				},
+			},
+			AzureMachine: &infrav1.AzureMachine{
+				Spec: spec,
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		machineScope MachineScope
+		wantSpec     azure.ResourceSpecGetter
+		wantID       string
+	}{
+		{
+			name:         "no proximity placement group",
+			machineScope: newMachineScope(nil, infrav1.AzureMachineSpec{}),
+			wantSpec:     nil,
+			wantID:       "",
+		},
+		{
+			name: "existing proximity placement group",
+			machineScope: newMachineScope(ptr.To("1"), infrav1.AzureMachineSpec{
+				ProximityPlacementGroupID: ptr.To("/subscriptions/123/resourceGroups/other-rg/providers/Microsoft.Compute/proximityPlacementGroups/my-ppg"),
+			}),
+			wantSpec: nil,
+			wantID:   "/subscriptions/123/resourceGroups/other-rg/providers/Microsoft.Compute/proximityPlacementGroups/my-ppg",
+		},
+		{
+			name: "CAPZ-managed proximity placement group without failure domain",
+			machineScope: newMachineScope(nil, infrav1.AzureMachineSpec{
+				ProximityPlacementGroup: &infrav1.ProximityPlacementGroup{Name: "my-ppg", VMSizes: []string{"Standard_D2s_v3"}},
+			}),
+			wantSpec: &proximityplacementgroups.ProximityPlacementGroupSpec{
+				Name:          "my-ppg",
+				ResourceGroup: "my-rg",
+				ClusterName:   "cluster",
+				Location:      "westus",
+				VMSizes:       []string{"Standard_D2s_v3"},
+				AdditionalTags: infrav1.Tags{
+					"kubernetes.io_cluster_cluster": "owned",
+				},
+			},
+			wantID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/proximityPlacementGroups/my-ppg",
+		},
+		{
+			name: "CAPZ-managed proximity placement group per failure domain",
+			machineScope: newMachineScope(ptr.To("2"), infrav1.AzureMachineSpec{
+				ProximityPlacementGroup: &infrav1.ProximityPlacementGroup{Name: "my-ppg", ResourceGroup: "ppg-rg"},
+			}),
+			wantSpec: &proximityplacementgroups.ProximityPlacementGroupSpec{
+				Name:          "my-ppg-2",
+				ResourceGroup: "ppg-rg",
+				ClusterName:   "cluster",
+				Location:      "westus",
+				Zones:         []string{"2"},
+				AdditionalTags: infrav1.Tags{
+					"kubernetes.io_cluster_cluster": "owned",
+				},
+			},
+			wantID: "/subscriptions/123/resourceGroups/ppg-rg/providers/Microsoft.Compute/proximityPlacementGroups/my-ppg-2",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			if tt.wantSpec == nil {
+				g.Expect(tt.machineScope.ProximityPlacementGroupSpec()).To(BeNil())
+			} else {
+				g.Expect(tt.machineScope.ProximityPlacementGroupSpec()).To(Equal(tt.wantSpec))
+			}
+			g.Expect(tt.machineScope.ProximityPlacementGroupID()).To(Equal(tt.wantID))
+		})
+	}
+}
+
 func TestMachineScope_VMState(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -1835,7 +2127,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 			},
 		},
 		{
-			name: "Node Machine with no NAT gateway and no public IP address and SKU is in machine cache",
+			name: "Node Machine with application security groups",
 			machineScope: MachineScope{
 				ClusterScoper: &ClusterScope{
 					AzureClients: AzureClients{
@@ -1881,6 +2173,13 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 										},
 									},
 								},
+								NetworkClassSpec: infrav1.NetworkClassSpec{
+									ApplicationSecurityGroups: []infrav1.ApplicationSecurityGroup{
+										{Name: "node-asg", Role: "node"},
+										{Name: "control-plane-asg", Role: "control-plane"},
+										{Name: "web"},
+									},
+								},
 								NodeOutboundLB: &infrav1.LoadBalancerSpec{
 									Name: "outbound-lb",
 									BackendPool: infrav1.BackendPool{
@@ -1901,6 +2200,10 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 							SubnetName:       "subnet1",
 							PrivateIPConfigs: 1,
 						}},
+						ApplicationSecurityGroups: []string{
+							"Node-ASG",
+							"/subscriptions/456/resourceGroups/other-rg/providers/Microsoft.Network/applicationSecurityGroups/monitoring",
+						},
 					},
 				},
 				Machine: &clusterv1.Machine{
@@ -1911,11 +2214,6 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 						},
 					},
 				},
-				cache: &MachineCache{
-					VMSKU: resourceskus.SKU{
-						Name: ptr.To("Standard_D2v2"),
-					},
-				},
 			},
 			want: []azure.ResourceSpecGetter{
 				&networkinterfaces.NICSpec{
@@ -1938,18 +2236,20 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					DNSServers:                nil,
 					IPv6Enabled:               false,
 					EnableIPForwarding:        false,
-					SKU: &resourceskus.SKU{
-						Name: ptr.To("Standard_D2v2"),
-					},
-					ClusterName: "cluster",
+					SKU:                       nil,
+					ClusterName:               "cluster",
 					AdditionalTags: infrav1.Tags{
 						"kubernetes.io_cluster_cluster": "owned",
 					},
+					ApplicationSecurityGroupIDs: []string{
+						"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/applicationSecurityGroups/node-asg",
+						"/subscriptions/456/resourceGroups/other-rg/providers/Microsoft.Network/applicationSecurityGroups/monitoring",
+					},
 				},
 			},
 		},
 		{
-			name: "Node Machine with NAT gateway",
+			name: "Node Machine with no NAT gateway and no public IP address and SKU is in machine cache",
 			machineScope: MachineScope{
 				ClusterScoper: &ClusterScope{
 					AzureClients: AzureClients{
@@ -1993,15 +2293,13 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 											Role: infrav1.SubnetNode,
 											Name: "subnet1",
 										},
-										NatGateway: infrav1.NatGateway{
-											NatGatewayClassSpec: infrav1.NatGatewayClassSpec{
-												Name: "natgw",
-											},
-										},
 									},
 								},
 								NodeOutboundLB: &infrav1.LoadBalancerSpec{
 									Name: "outbound-lb",
+									BackendPool: infrav1.BackendPool{
+										Name: "outbound-lb-outboundBackendPool",
+									},
 								},
 							},
 						},
@@ -2027,6 +2325,11 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 						},
 					},
 				},
+				cache: &MachineCache{
+					VMSKU: resourceskus.SKU{
+						Name: ptr.To("Standard_D2v2"),
+					},
+				},
 			},
 			want: []azure.ResourceSpecGetter{
 				&networkinterfaces.NICSpec{
@@ -2039,8 +2342,8 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					IPConfigs:                 []networkinterfaces.IPConfig{{}},
 					VNetName:                  "vnet1",
 					VNetResourceGroup:         "rg1",
-					PublicLBName:              "",
-					PublicLBAddressPoolName:   "",
+					PublicLBName:              "outbound-lb",
+					PublicLBAddressPoolName:   "outbound-lb-outboundBackendPool",
 					PublicLBNATRuleName:       "",
 					InternalLBName:            "",
 					InternalLBAddressPoolName: "",
@@ -2049,8 +2352,10 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					DNSServers:                nil,
 					IPv6Enabled:               false,
 					EnableIPForwarding:        false,
-					SKU:                       nil,
-					ClusterName:               "cluster",
+					SKU: &resourceskus.SKU{
+						Name: ptr.To("Standard_D2v2"),
+					},
+					ClusterName: "cluster",
 					AdditionalTags: infrav1.Tags{
 						"kubernetes.io_cluster_cluster": "owned",
 					},
@@ -2058,7 +2363,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 			},
 		},
 		{
-			name: "Node Machine with public IP address",
+			name: "Node Machine with NAT gateway",
 			machineScope: MachineScope{
 				ClusterScoper: &ClusterScope{
 					AzureClients: AzureClients{
@@ -2102,6 +2407,11 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 											Role: infrav1.SubnetNode,
 											Name: "subnet1",
 										},
+										NatGateway: infrav1.NatGateway{
+											NatGatewayClassSpec: infrav1.NatGatewayClassSpec{
+												Name: "natgw",
+											},
+										},
 									},
 								},
 								NodeOutboundLB: &infrav1.LoadBalancerSpec{
@@ -2121,7 +2431,6 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 							SubnetName:       "subnet1",
 							PrivateIPConfigs: 1,
 						}},
-						AllocatePublicIP: true,
 					},
 				},
 				Machine: &clusterv1.Machine{
@@ -2149,7 +2458,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					PublicLBNATRuleName:       "",
 					InternalLBName:            "",
 					InternalLBAddressPoolName: "",
-					PublicIPName:              "pip-machine-name",
+					PublicIPName:              "",
 					AcceleratedNetworking:     nil,
 					DNSServers:                nil,
 					IPv6Enabled:               false,
@@ -2163,7 +2472,7 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 			},
 		},
 		{
-			name: "Control Plane Machine with private LB",
+			name: "Node Machine with public IP address",
 			machineScope: MachineScope{
 				ClusterScoper: &ClusterScope{
 					AzureClients: AzureClients{
@@ -2209,15 +2518,6 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 										},
 									},
 								},
-								APIServerLB: infrav1.LoadBalancerSpec{
-									Name: "api-lb",
-									LoadBalancerClassSpec: infrav1.LoadBalancerClassSpec{
-										Type: infrav1.Internal,
-									},
-									BackendPool: infrav1.BackendPool{
-										Name: "api-lb-backendPool",
-									},
-								},
 								NodeOutboundLB: &infrav1.LoadBalancerSpec{
 									Name: "outbound-lb",
 								},
@@ -2235,13 +2535,14 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 							SubnetName:       "subnet1",
 							PrivateIPConfigs: 1,
 						}},
+						AllocatePublicIP: true,
 					},
 				},
 				Machine: &clusterv1.Machine{
 					ObjectMeta: metav1.ObjectMeta{
-						Name: "machine",
+						Name:   "machine",
 						Labels: map[string]string{
-							clusterv1.MachineControlPlaneLabel: "true",
+							// clusterv1.MachineControlPlaneLabel: "true",
 						},
 					},
 				},
@@ -2260,11 +2561,124 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 					PublicLBName:              "",
 					PublicLBAddressPoolName:   "",
 					PublicLBNATRuleName:       "",
-					InternalLBName:            "api-lb",
-					InternalLBAddressPoolName: "api-lb-backendPool",
-					PublicIPName:              "",
-					AcceleratedNetworking:     nil,
-					DNSServers:                nil,
+					InternalLBName:            "",
+					InternalLBAddressPoolName: "",
+					PublicIPName:              "pip-machine-name",
+					AcceleratedNetworking:     nil,
+					DNSServers:                nil,
+					IPv6Enabled:               false,
+					EnableIPForwarding:        false,
+					SKU:                       nil,
+					ClusterName:               "cluster",
+					AdditionalTags: infrav1.Tags{
+						"kubernetes.io_cluster_cluster": "owned",
+					},
+				},
+			},
+		},
+		{
+			name: "Control Plane Machine with private LB",
+			machineScope: MachineScope{
+				ClusterScoper: &ClusterScope{
+					AzureClients: AzureClients{
+						EnvironmentSettings: auth.EnvironmentSettings{
+							Values: map[string]string{
+								auth.SubscriptionID: "123",
+							},
+						},
+					},
+					Cluster: &clusterv1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "cluster",
+							Namespace: "default",
+						},
+					},
+					AzureCluster: &infrav1.AzureCluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "cluster",
+							Namespace: "default",
+							OwnerReferences: []metav1.OwnerReference{
+								{
+									APIVersion: "cluster.x-k8s.io/v1beta1",
+									Kind:       "Cluster",
+									Name:       "cluster",
+								},
+							},
+						},
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+							AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+								Location: "westus",
+							},
+							NetworkSpec: infrav1.NetworkSpec{
+								Vnet: infrav1.VnetSpec{
+									Name:          "vnet1",
+									ResourceGroup: "rg1",
+								},
+								Subnets: []infrav1.SubnetSpec{
+									{
+										SubnetClassSpec: infrav1.SubnetClassSpec{
+											Role: infrav1.SubnetNode,
+											Name: "subnet1",
+										},
+									},
+								},
+								APIServerLB: infrav1.LoadBalancerSpec{
+									Name: "api-lb",
+									LoadBalancerClassSpec: infrav1.LoadBalancerClassSpec{
+										Type: infrav1.Internal,
+									},
+									BackendPool: infrav1.BackendPool{
+										Name: "api-lb-backendPool",
+									},
+								},
+								NodeOutboundLB: &infrav1.LoadBalancerSpec{
+									Name: "outbound-lb",
+								},
+							},
+						},
+					},
+				},
+				AzureMachine: &infrav1.AzureMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machine",
+					},
+					Spec: infrav1.AzureMachineSpec{
+						ProviderID: ptr.To("azure:///subscriptions/1234-5678/resourceGroups/my-cluster/providers/Microsoft.Compute/virtualMachines/machine-name"),
+						NetworkInterfaces: []infrav1.NetworkInterface{{
+							SubnetName:       "subnet1",
+							PrivateIPConfigs: 1,
+						}},
+					},
+				},
+				Machine: &clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machine",
+						Labels: map[string]string{
+							clusterv1.MachineControlPlaneLabel: "true",
+						},
+					},
+				},
+			},
+			want: []azure.ResourceSpecGetter{
+				&networkinterfaces.NICSpec{
+					Name:                      "machine-name-nic",
+					ResourceGroup:             "my-rg",
+					Location:                  "westus",
+					SubscriptionID:            "123",
+					MachineName:               "machine-name",
+					SubnetName:                "subnet1",
+					IPConfigs:                 []networkinterfaces.IPConfig{{}},
+					VNetName:                  "vnet1",
+					VNetResourceGroup:         "rg1",
+					PublicLBName:              "",
+					PublicLBAddressPoolName:   "",
+					PublicLBNATRuleName:       "",
+					InternalLBName:            "api-lb",
+					InternalLBAddressPoolName: "api-lb-backendPool",
+					PublicIPName:              "",
+					AcceleratedNetworking:     nil,
+					DNSServers:                nil,
 					IPv6Enabled:               false,
 					EnableIPForwarding:        false,
 					SKU:                       nil,
@@ -2897,6 +3311,49 @@ func TestMachineScope_NICSpecs(t *testing.T) {
 	}
 }
 
+func TestMachineScope_ApplicationSecurityGroupIDs(t *testing.T) {
+	g := NewWithT(t)
+	machineScope := MachineScope{
+		ClusterScoper: &ClusterScope{
+			AzureClients: AzureClients{
+				EnvironmentSettings: auth.EnvironmentSettings{
+					Values: map[string]string{
+						auth.SubscriptionID: "123",
+					},
+				},
+			},
+			AzureCluster: &infrav1.AzureCluster{
+				Spec: infrav1.AzureClusterSpec{
+					ResourceGroup: "my-rg",
+					NetworkSpec: infrav1.NetworkSpec{
+						Subnets: []infrav1.SubnetSpec{
+							{SubnetClassSpec: infrav1.SubnetClassSpec{Role: infrav1.SubnetNode, Name: "node-subnet"}},
+							{SubnetClassSpec: infrav1.SubnetClassSpec{Role: infrav1.SubnetBastion, Name: "bastion-subnet"}},
+						},
+						NetworkClassSpec: infrav1.NetworkClassSpec{
+							ApplicationSecurityGroups: []infrav1.ApplicationSecurityGroup{
+								{Name: "node-asg", Role: "node"},
+								{Name: "control-plane-asg", Role: "control-plane"},
+								{Name: "bastion-asg", Role: "bastion"},
+							},
+						},
+					},
+				},
+			},
+		},
+		AzureMachine: &infrav1.AzureMachine{},
+		Machine:      &clusterv1.Machine{},
+	}
+
+	g.Expect(machineScope.applicationSecurityGroupIDs("node-subnet")).To(Equal([]string{
+		"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/applicationSecurityGroups/node-asg",
+	}))
+	g.Expect(machineScope.applicationSecurityGroupIDs("bastion-subnet")).To(Equal([]string{
+		"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/applicationSecurityGroups/node-asg",
+		"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/applicationSecurityGroups/bastion-asg",
+	}))
+}
+
 func TestDiskSpecs(t *testing.T) {
 	testcases := []struct {
 		name         string
@@ -3025,9 +3482,12 @@ func TestDiskSpecs(t *testing.T) {
 						DataDisks: []infrav1.DataDisk{
 							{
 								NameSuffix: "etcddisk",
+								DiskSizeGB: 256,
 							},
 							{
-								NameSuffix: "otherdisk",
+								NameSuffix:     "otherdisk",
+								DiskSizeGB:     128,
+								DeletionPolicy: infrav1.DiskDeletionPolicyRetain,
 							},
 						},
 					},
@@ -3046,11 +3506,71 @@ func TestDiskSpecs(t *testing.T) {
 				&disks.DiskSpec{
 					Name:          "my-azure-machine_etcddisk",
 					ResourceGroup: "my-rg",
+					DiskSizeGB:    256,
+				},
+				&disks.DiskSpec{
+					Name:           "my-azure-machine_otherdisk",
+					ResourceGroup:  "my-rg",
+					DiskSizeGB:     128,
+					DeletionPolicy: infrav1.DiskDeletionPolicyRetain,
+				},
+			},
+		},
+		{
+			name: "data disk with a last applied size",
+			machineScope: MachineScope{
+				ClusterScoper: &ClusterScope{
+					Cluster: &clusterv1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "cluster",
+						},
+					},
+					AzureCluster: &infrav1.AzureCluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "cluster",
+						},
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+						},
+					},
+				},
+				AzureMachine: &infrav1.AzureMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-azure-machine",
+						Annotations: map[string]string{
+							azure.DiskSizeLastAppliedAnnotation: `{"my-azure-machine_etcddisk":128}`,
+						},
+					},
+					Spec: infrav1.AzureMachineSpec{
+						OSDisk: infrav1.OSDisk{
+							DiskSizeGB: ptr.To[int32](30),
+							OSType:     "Linux",
+						},
+						DataDisks: []infrav1.DataDisk{
+							{
+								NameSuffix: "etcddisk",
+								DiskSizeGB: 256,
+							},
+						},
+					},
 				},
+				Machine: &clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machine",
+					},
+				},
+			},
+			want: []azure.ResourceSpecGetter{
 				&disks.DiskSpec{
-					Name:          "my-azure-machine_otherdisk",
+					Name:          "my-azure-machine_OSDisk",
 					ResourceGroup: "my-rg",
 				},
+				&disks.DiskSpec{
+					Name:                  "my-azure-machine_etcddisk",
+					ResourceGroup:         "my-rg",
+					DiskSizeGB:            256,
+					LastAppliedDiskSizeGB: 128,
+				},
 			},
 		},
 	}
@@ -3067,6 +3587,123 @@ func TestDiskSpecs(t *testing.T) {
 	}
 }
 
+func TestExistingDiskSpecs(t *testing.T) {
+	testcases := []struct {
+		name      string
+		dataDisks []infrav1.DataDisk
+		want      []azure.ResourceSpecGetter
+		wantOwned []azure.ResourceSpecGetter
+	}{
+		{
+			name: "no existing disks",
+			dataDisks: []infrav1.DataDisk{
+				{
+					NameSuffix: "etcddisk",
+				},
+			},
+			want: nil,
+			wantOwned: []azure.ResourceSpecGetter{
+				&disks.DiskSpec{
+					Name:          "my-azure-machine_OSDisk",
+					ResourceGroup: "my-rg",
+				},
+				&disks.DiskSpec{
+					Name:          "my-azure-machine_etcddisk",
+					ResourceGroup: "my-rg",
+				},
+			},
+		},
+		{
+			name: "existing and shared disks",
+			dataDisks: []infrav1.DataDisk{
+				{
+					NameSuffix: "etcddisk",
+				},
+				{
+					NameSuffix:    "existing",
+					ManagedDiskID: ptr.To("/subscriptions/123/resourceGroups/disk-rg/providers/Microsoft.Compute/disks/existing-disk"),
+				},
+				{
+					NameSuffix:    "shared",
+					ManagedDiskID: ptr.To("/subscriptions/123/resourceGroups/disk-rg/providers/Microsoft.Compute/disks/shared-disk"),
+					MaxShares:     ptr.To[int32](3),
+				},
+			},
+			want: []azure.ResourceSpecGetter{
+				&disks.ExistingDiskSpec{
+					Name:          "existing-disk",
+					ResourceGroup: "disk-rg",
+					VMID:          "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachines/my-azure-machine",
+				},
+				&disks.ExistingDiskSpec{
+					Name:          "shared-disk",
+					ResourceGroup: "disk-rg",
+					VMID:          "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/virtualMachines/my-azure-machine",
+					MaxShares:     ptr.To[int32](3),
+				},
+			},
+			wantOwned: []azure.ResourceSpecGetter{
+				&disks.DiskSpec{
+					Name:          "my-azure-machine_OSDisk",
+					ResourceGroup: "my-rg",
+				},
+				&disks.DiskSpec{
+					Name:          "my-azure-machine_etcddisk",
+					ResourceGroup: "my-rg",
+				},
+			},
+		},
+	}
+
+	for _, tt := range testcases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			machineScope := MachineScope{
+				ClusterScoper: &ClusterScope{
+					AzureClients: AzureClients{
+						EnvironmentSettings: auth.EnvironmentSettings{
+							Values: map[string]string{
+								auth.SubscriptionID: "123",
+							},
+						},
+					},
+					Cluster: &clusterv1.Cluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "cluster",
+						},
+					},
+					AzureCluster: &infrav1.AzureCluster{
+						ObjectMeta: metav1.ObjectMeta{
+							Name: "cluster",
+						},
+						Spec: infrav1.AzureClusterSpec{
+							ResourceGroup: "my-rg",
+						},
+					},
+				},
+				AzureMachine: &infrav1.AzureMachine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "my-azure-machine",
+					},
+					Spec: infrav1.AzureMachineSpec{
+						DataDisks: tt.dataDisks,
+					},
+				},
+				Machine: &clusterv1.Machine{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "machine",
+					},
+				},
+			}
+			g.Expect(machineScope.ExistingDiskSpecs()).To(BeEquivalentTo(tt.want))
+			g.Expect(machineScope.DiskSpecs()).To(BeEquivalentTo(tt.wantOwned))
+		})
+	}
+}
+
 func TestMachineScope_GetCapacityReservationGroupID(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -3111,3 +3748,358 @@ func TestMachineScope_GetCapacityReservationGroupID(t *testing.T) {
 		})
 	}
 }
+
+func TestMachineScope_ValidateVMSize(t *testing.T) {
+	sku := resourceskus.SKU{
+		Name: ptr.To("Standard_D2_v3"),
+		Capabilities: []*armcompute.ResourceSKUCapabilities{
+			{
+				Name:  ptr.To(resourceskus.AcceleratedNetworking),
+				Value: ptr.To("False"),
+			},
+			{
+				Name:  ptr.To(resourceskus.PremiumIO),
+				Value: ptr.To("False"),
+			},
+		},
+		LocationInfo: []*armcompute.ResourceSKULocationInfo{
+			{
+				Location: ptr.To("westus"),
+				Zones:    []*string{ptr.To("1"), ptr.To("2")},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		spec          infrav1.AzureMachineSpec
+		failureDomain *string
+		expectedError string
+	}{
+		{
+			name: "compatible vm size",
+			spec: infrav1.AzureMachineSpec{
+				VMSize: "Standard_D2_v3",
+				OSDisk: infrav1.OSDisk{
+					ManagedDisk: &infrav1.ManagedDiskParameters{
+						StorageAccountType: string(armcompute.StorageAccountTypesStandardLRS),
+					},
+				},
+			},
+			failureDomain: ptr.To("1"),
+		},
+		{
+			name: "incompatible vm size",
+			spec: infrav1.AzureMachineSpec{
+				VMSize: "Standard_D2_v3",
+				OSDisk: infrav1.OSDisk{
+					ManagedDisk: &infrav1.ManagedDiskParameters{
+						StorageAccountType: string(armcompute.StorageAccountTypesPremiumLRS),
+					},
+				},
+				NetworkInterfaces: []infrav1.NetworkInterface{
+					{
+						AcceleratedNetworking: ptr.To(true),
+					},
+				},
+			},
+			failureDomain: ptr.To("3"),
+			expectedError: "reconcile error that cannot be recovered occurred: vm size Standard_D2_v3 is not compatible with the AzureMachine: " +
+				"[vm size Standard_D2_v3 does not support accelerated networking, " +
+				"vm size Standard_D2_v3 does not support premium storage, " +
+				"vm size Standard_D2_v3 is not available in zone 3 of location westus]. Object will not be requeued",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machineScope := MachineScope{
+				Machine: &clusterv1.Machine{
+					Spec: clusterv1.MachineSpec{
+						FailureDomain: tt.failureDomain,
+					},
+				},
+				AzureMachine: &infrav1.AzureMachine{
+					Spec: tt.spec,
+				},
+				ClusterScoper: &ClusterScope{
+					AzureCluster: &infrav1.AzureCluster{
+						Spec: infrav1.AzureClusterSpec{
+							AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+								Location: "westus",
+							},
+						},
+					},
+				},
+				cache: &MachineCache{
+					VMSKU: sku,
+				},
+			}
+			err := machineScope.ValidateVMSize()
+			if tt.expectedError != "" {
+				g.Expect(err).To(MatchError(tt.expectedError))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
+
+func TestMachineScope_BootstrapDataSpec(t *testing.T) {
+	clusterScope := &ClusterScope{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "My.Cluster",
+			},
+		},
+	}
+	tests := []struct {
+		name         string
+		azureMachine *infrav1.AzureMachine
+		want         *bootstrapdata.BootstrapDataSpec
+	}{
+		{
+			name: "returns nil if no transport is set",
+			azureMachine: &infrav1.AzureMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine-name", UID: "1234"},
+			},
+			want: nil,
+		},
+		{
+			name: "returns nil if the bootstrap data is passed as custom data",
+			azureMachine: &infrav1.AzureMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine-name", UID: "1234"},
+				Spec: infrav1.AzureMachineSpec{
+					BootstrapTransport: &infrav1.BootstrapTransport{Type: infrav1.BootstrapTransportCustomData},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "defaults the container name to the cluster name",
+			azureMachine: &infrav1.AzureMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine.name", UID: "1234"},
+				Spec: infrav1.AzureMachineSpec{
+					Identity: infrav1.VMIdentitySystemAssigned,
+					BootstrapTransport: &infrav1.BootstrapTransport{
+						Type:        infrav1.BootstrapTransportStorageBlob,
+						StorageBlob: &infrav1.StorageBlobBootstrapTransport{StorageAccountName: "mystorageaccount"},
+					},
+				},
+			},
+			want: &bootstrapdata.BootstrapDataSpec{
+				Transport:          infrav1.BootstrapTransportStorageBlob,
+				StorageAccountName: "mystorageaccount",
+				ContainerName:      "my-cluster",
+				Name:               "machine-name-1234",
+			},
+		},
+		{
+			name: "uses the first user-assigned identity to fetch a key vault secret",
+			azureMachine: &infrav1.AzureMachine{
+				ObjectMeta: metav1.ObjectMeta{Name: "machine-name", UID: "1234"},
+				Spec: infrav1.AzureMachineSpec{
+					Identity: infrav1.VMIdentityUserAssigned,
+					UserAssignedIdentities: []infrav1.UserAssignedIdentity{
+						{ProviderID: "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/id1"},
+						{ProviderID: "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/id2"},
+					},
+					BootstrapTransport: &infrav1.BootstrapTransport{
+						Type:           infrav1.BootstrapTransportKeyVaultSecret,
+						KeyVaultSecret: &infrav1.KeyVaultSecretBootstrapTransport{VaultName: "my-vault"},
+					},
+				},
+			},
+			want: &bootstrapdata.BootstrapDataSpec{
+				Transport:          infrav1.BootstrapTransportKeyVaultSecret,
+				VaultName:          "my-vault",
+				Name:               "machine-name-1234",
+				IdentityResourceID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/id1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machineScope := MachineScope{
+				ClusterScoper: clusterScope,
+				AzureMachine:  tt.azureMachine,
+			}
+			g.Expect(machineScope.BootstrapDataSpec()).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestMachineScope_NeedsBootstrapDiagnostics(t *testing.T) {
+	failedAt := metav1.NewTime(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	tests := []struct {
+		name       string
+		conditions clusterv1.Conditions
+		collected  *infrav1.BootstrapDiagnostics
+		want       bool
+	}{
+		{
+			name: "bootstrapping is in progress",
+			conditions: clusterv1.Conditions{
+				{Type: infrav1.BootstrapSucceededCondition, Status: corev1.ConditionFalse, Reason: infrav1.CreatingReason, LastTransitionTime: failedAt},
+			},
+			want: false,
+		},
+		{
+			name: "bootstrapping succeeded",
+			conditions: clusterv1.Conditions{
+				{Type: infrav1.BootstrapSucceededCondition, Status: corev1.ConditionTrue, LastTransitionTime: failedAt},
+			},
+			want: false,
+		},
+		{
+			name: "bootstrapping failed",
+			conditions: clusterv1.Conditions{
+				{Type: infrav1.BootstrapSucceededCondition, Status: corev1.ConditionFalse, Reason: infrav1.FailedReason, LastTransitionTime: failedAt},
+			},
+			want: true,
+		},
+		{
+			name: "bootstrapping failed and diagnostics were already collected",
+			conditions: clusterv1.Conditions{
+				{Type: infrav1.BootstrapSucceededCondition, Status: corev1.ConditionFalse, Reason: infrav1.FailedReason, LastTransitionTime: failedAt, Message: "extension failed"},
+			},
+			collected: &infrav1.BootstrapDiagnostics{SecretName: "my-vm-bootstrap-diagnostics", CollectionTime: metav1.NewTime(failedAt.Add(time.Second)), FailureMessage: "extension failed"},
+			want:      false,
+		},
+		{
+			name: "bootstrapping failed with a new error after diagnostics were collected",
+			conditions: clusterv1.Conditions{
+				{Type: infrav1.BootstrapSucceededCondition, Status: corev1.ConditionFalse, Reason: infrav1.FailedReason, LastTransitionTime: failedAt, Message: "extension failed again"},
+			},
+			collected: &infrav1.BootstrapDiagnostics{SecretName: "my-vm-bootstrap-diagnostics", CollectionTime: metav1.NewTime(failedAt.Add(time.Second)), FailureMessage: "extension failed"},
+			want:      true,
+		},
+		{
+			name: "bootstrapping failed again after diagnostics were collected",
+			conditions: clusterv1.Conditions{
+				{Type: infrav1.BootstrapSucceededCondition, Status: corev1.ConditionFalse, Reason: infrav1.FailedReason, LastTransitionTime: failedAt},
+			},
+			collected: &infrav1.BootstrapDiagnostics{SecretName: "my-vm-bootstrap-diagnostics", CollectionTime: metav1.NewTime(failedAt.Add(-time.Hour)), FailureMessage: "extension failed"},
+			want:      true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machineScope := MachineScope{
+				AzureMachine: &infrav1.AzureMachine{
+					Status: infrav1.AzureMachineStatus{
+						Conditions:           tt.conditions,
+						BootstrapDiagnostics: tt.collected,
+					},
+				},
+			}
+			g.Expect(machineScope.NeedsBootstrapDiagnostics()).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestMachineScope_StoreBootstrapDiagnostics(t *testing.T) {
+	g := NewWithT(t)
+	scheme := runtime.NewScheme()
+	_ = corev1.AddToScheme(scheme)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+	machineScope := MachineScope{
+		client: fakeClient,
+		ClusterScoper: &ClusterScope{
+			Cluster: &clusterv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "my-cluster",
+				},
+			},
+		},
+		AzureMachine: &infrav1.AzureMachine{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "my-vm",
+				Namespace: "default",
+				UID:       "1234",
+			},
+		},
+	}
+	conditions.MarkFalse(machineScope.AzureMachine, infrav1.BootstrapSucceededCondition, infrav1.FailedReason, clusterv1.ConditionSeverityError, "extension failed")
+
+	// Storing diagnostics again overwrites the previous ones.
+	for _, serialConsoleLog := range []string{"first failure", "second failure"} {
+		g.Expect(machineScope.StoreBootstrapDiagnostics(context.TODO(), &bootstrapdiagnostics.Diagnostics{
+			SerialConsoleLog: serialConsoleLog,
+			ExtensionStatus:  "CAPZ.Linux.Bootstrapping failed",
+		})).To(Succeed())
+	}
+
+	secret := &corev1.Secret{}
+	g.Expect(fakeClient.Get(context.TODO(), client.ObjectKey{Namespace: "default", Name: "my-vm-bootstrap-diagnostics"}, secret)).To(Succeed())
+	g.Expect(secret.Data).To(Equal(map[string][]byte{
+		infrav1.BootstrapDiagnosticsSerialConsoleLogKey: []byte("second failure"),
+		infrav1.BootstrapDiagnosticsExtensionStatusKey:  []byte("CAPZ.Linux.Bootstrapping failed"),
+	}))
+	g.Expect(secret.Labels).To(HaveKeyWithValue(clusterv1.ClusterNameLabel, "my-cluster"))
+	g.Expect(secret.OwnerReferences).To(HaveLen(1))
+	g.Expect(secret.OwnerReferences[0].Kind).To(Equal(infrav1.AzureMachineKind))
+	g.Expect(secret.OwnerReferences[0].UID).To(BeEquivalentTo("1234"))
+
+	g.Expect(machineScope.AzureMachine.Status.BootstrapDiagnostics).NotTo(BeNil())
+	g.Expect(machineScope.AzureMachine.Status.BootstrapDiagnostics.SecretName).To(Equal("my-vm-bootstrap-diagnostics"))
+	g.Expect(machineScope.AzureMachine.Status.BootstrapDiagnostics.FailureMessage).To(Equal("extension failed"))
+	g.Expect(machineScope.NeedsBootstrapDiagnostics()).To(BeFalse())
+}
+
+func TestMachineScope_UpdateBootstrapStatusFromNode(t *testing.T) {
+	tests := []struct {
+		name           string
+		bootstrapCheck *infrav1.BootstrapCheck
+		nodeRef        *corev1.ObjectReference
+		want           *clusterv1.Condition
+	}{
+		{
+			name:           "bootstrap check is enabled",
+			bootstrapCheck: nil,
+			nodeRef:        &corev1.ObjectReference{Name: "node1"},
+			want:           nil,
+		},
+		{
+			name:           "bootstrap check is disabled and the node has not registered",
+			bootstrapCheck: &infrav1.BootstrapCheck{Disabled: true},
+			want:           conditions.FalseCondition(infrav1.BootstrapSucceededCondition, infrav1.BootstrapInProgressReason, clusterv1.ConditionSeverityInfo, "waiting for the Node to register"),
+		},
+		{
+			name:           "bootstrap check is disabled and the node has registered",
+			bootstrapCheck: &infrav1.BootstrapCheck{Disabled: true},
+			nodeRef:        &corev1.ObjectReference{Name: "node1"},
+			want:           conditions.TrueCondition(infrav1.BootstrapSucceededCondition),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machineScope := MachineScope{
+				Machine: &clusterv1.Machine{
+					Status: clusterv1.MachineStatus{
+						NodeRef: tt.nodeRef,
+					},
+				},
+				AzureMachine: &infrav1.AzureMachine{
+					Spec: infrav1.AzureMachineSpec{
+						BootstrapCheck: tt.bootstrapCheck,
+					},
+				},
+			}
+			machineScope.UpdateBootstrapStatusFromNode()
+			got := conditions.Get(machineScope.AzureMachine, infrav1.BootstrapSucceededCondition)
+			if tt.want == nil {
+				g.Expect(got).To(BeNil())
+				return
+			}
+			g.Expect(got).NotTo(BeNil())
+			g.Expect(got.Status).To(Equal(tt.want.Status))
+			g.Expect(got.Reason).To(Equal(tt.want.Reason))
+			g.Expect(got.Message).To(Equal(tt.want.Message))
+		})
+	}
+}
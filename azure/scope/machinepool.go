@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
 	"strings"
@@ -34,6 +35,8 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	machinepool "sigs.k8s.io/cluster-api-provider-azure/azure/scope/strategies/machinepool_deployments"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bootstrapdata"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/capacityreservations"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/roleassignments"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/scalesets"
@@ -90,6 +93,7 @@ type (
 	// MachinePoolCache stores common machine pool information so we don't have to hit the API multiple times within the same reconcile loop.
 	MachinePoolCache struct {
 		BootstrapData           string
+		UserData                string
 		HasBootstrapDataChanges bool
 		VMImage                 *infrav1.Image
 		VMSKU                   resourceskus.SKU
@@ -146,6 +150,11 @@ func (m *MachinePoolScope) InitMachinePoolCache(ctx context.Context) error {
 			return err
 		}
 
+		m.cache.UserData, err = m.GetUserData(ctx)
+		if err != nil {
+			return err
+		}
+
 		m.cache.HasBootstrapDataChanges, err = m.HasBootstrapDataChanges(ctx)
 		if err != nil {
 			return err
@@ -176,6 +185,68 @@ func (m *MachinePoolScope) InitMachinePoolCache(ctx context.Context) error {
 	return nil
 }
 
+// ValidateVMSize checks that the VM size supports the features and the availability zones the AzureMachinePool
+// requires. It returns a terminal error listing the incompatibilities, if any, and must be called after InitMachinePoolCache.
+func (m *MachinePoolScope) ValidateVMSize() error {
+	if m.cache == nil {
+		return errors.New("machine pool cache is not initialized")
+	}
+
+	if err := m.cache.VMSKU.ValidateVMRequirements(m.vmRequirements()); err != nil {
+		return azure.WithTerminalError(errors.Wrapf(err, "vm size %s is not compatible with the AzureMachinePool", m.AzureMachinePool.Spec.Template.VMSize))
+	}
+	return nil
+}
+
+// NeedsVMSizeValidation returns true if the VM size of the AzureMachinePool's template, or any of the requirements
+// it is checked against, changed since it was last found compatible, or was never checked.
+func (m *MachinePoolScope) NeedsVMSizeValidation() bool {
+	if !conditions.IsTrue(m.AzureMachinePool, infrav1.VMSizeCompatibleCondition) {
+		return true
+	}
+	hash, err := m.vmSizeRequirementsHash()
+	if err != nil {
+		return true
+	}
+	return m.AzureMachinePool.GetAnnotations()[azure.VMSizeValidatedAnnotation] != hash
+}
+
+// SetVMSizeValidated records that the VM size of the AzureMachinePool's template is compatible.
+func (m *MachinePoolScope) SetVMSizeValidated() {
+	if hash, err := m.vmSizeRequirementsHash(); err == nil {
+		m.SetAnnotation(azure.VMSizeValidatedAnnotation, hash)
+	}
+	conditions.MarkTrue(m.AzureMachinePool, infrav1.VMSizeCompatibleCondition)
+}
+
+// IsVMSizeIncompatible returns true if the VM size of the AzureMachinePool's template was found incompatible.
+// The scale set keeps its current model until the VM size is compatible again.
+func (m *MachinePoolScope) IsVMSizeIncompatible() bool {
+	return conditions.IsFalse(m.AzureMachinePool, infrav1.VMSizeCompatibleCondition)
+}
+
+// vmRequirements returns the requirements the VM size of the AzureMachinePool's template is checked against.
+func (m *MachinePoolScope) vmRequirements() resourceskus.VMRequirements {
+	template := m.AzureMachinePool.Spec.Template
+	return vmRequirements(m.Location(), m.MachinePool.Spec.FailureDomains, template.OSDisk, template.DataDisks, template.NetworkInterfaces, template.SecurityProfile)
+}
+
+// vmSizeRequirementsHash calculates the sha256 hash of the VM size and the requirements it is checked against.
+func (m *MachinePoolScope) vmSizeRequirementsHash() (string, error) {
+	req, err := json.Marshal(m.vmRequirements())
+	if err != nil {
+		return "", errors.Wrap(err, "failed to marshal VM requirements")
+	}
+	h := sha256.New()
+	if _, err := io.WriteString(h, m.AzureMachinePool.Spec.Template.VMSize); err != nil {
+		return "", errors.Wrap(err, "failed to write VM size")
+	}
+	if _, err := h.Write(req); err != nil {
+		return "", errors.Wrap(err, "failed to write VM requirements")
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
+
 // ScaleSetSpec returns the scale set spec.
 func (m *MachinePoolScope) ScaleSetSpec(ctx context.Context) azure.ResourceSpecGetter {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "scope.MachinePoolScope.ScaleSetSpec")
@@ -212,6 +283,10 @@ func (m *MachinePoolScope) ScaleSetSpec(ctx context.Context) azure.ResourceSpecG
 		AdditionalTags:               m.AzureMachinePool.Spec.AdditionalTags,
 		PlatformFaultDomainCount:     m.AzureMachinePool.Spec.PlatformFaultDomainCount,
 		ZoneBalance:                  m.AzureMachinePool.Spec.ZoneBalance,
+		CapacityReservationGroupID:   m.CapacityReservationGroupID(),
+		ProximityPlacementGroupID:    ptr.Deref(m.AzureMachinePool.Spec.Template.ProximityPlacementGroupID, ""),
+		HostGroupID:                  ptr.Deref(m.AzureMachinePool.Spec.Template.HostGroupID, ""),
+		SkipModelUpdate:              m.IsVMSizeIncompatible(),
 	}
 
 	if m.AzureMachinePool.Spec.ZoneBalance != nil && len(m.MachinePool.Spec.FailureDomains) <= 1 {
@@ -228,6 +303,7 @@ func (m *MachinePoolScope) ScaleSetSpec(ctx context.Context) azure.ResourceSpecG
 		spec.SKU = m.cache.VMSKU
 		spec.VMImage = m.cache.VMImage
 		spec.BootstrapData = m.cache.BootstrapData
+		spec.UserData = m.cache.UserData
 		spec.MaxSurge = m.cache.MaxSurge
 	} else {
 		log.V(4).Info("machinepool cache is nil, this is only expected when deleting a machinepool")
@@ -245,6 +321,48 @@ func (m *MachinePoolScope) Name() string {
 	return m.AzureMachinePool.Name
 }
 
+// CapacityReservationGroupID returns the ID of the capacity reservation group the scale set consumes, if any.
+func (m *MachinePoolScope) CapacityReservationGroupID() string {
+	return ptr.Deref(m.AzureMachinePool.Spec.Template.CapacityReservationGroupID, "")
+}
+
+// CapacityReservationSpec returns the capacity reservation spec, or nil if the scale set doesn't consume a capacity
+// reservation group.
+func (m *MachinePoolScope) CapacityReservationSpec() *capacityreservations.CapacityReservationSpec {
+	resourceID, err := azureutil.ParseResourceID(m.CapacityReservationGroupID())
+	if err != nil {
+		return nil
+	}
+	return &capacityreservations.CapacityReservationSpec{
+		SubscriptionID: resourceID.SubscriptionID,
+		ResourceGroup:  resourceID.ResourceGroupName,
+		Name:           resourceID.Name,
+		VMSize:         m.AzureMachinePool.Spec.Template.VMSize,
+	}
+}
+
+// SetCapacityReservationStatus records the utilization of the capacity reserved for the scale set's VM size, and
+// marks the reservation as exhausted if it can't fit the desired replicas.
+func (m *MachinePoolScope) SetCapacityReservationStatus(reserved, allocated int32) {
+	m.AzureMachinePool.Status.CapacityReservation = &infrav1exp.CapacityReservationStatus{
+		ReservedCapacity:  reserved,
+		AllocatedCapacity: allocated,
+	}
+
+	desired := ptr.Deref(m.MachinePool.Spec.Replicas, 0)
+	if allocated >= reserved && desired > m.AzureMachinePool.Status.Replicas {
+		conditions.MarkFalse(m.AzureMachinePool, infrav1.CapacityReservationAvailableCondition, infrav1.CapacityReservationExhaustedReason, clusterv1.ConditionSeverityWarning,
+			"all %d VMs reserved for size %s are allocated, %d more replicas are desired", reserved, m.AzureMachinePool.Spec.Template.VMSize, desired-m.AzureMachinePool.Status.Replicas)
+		return
+	}
+	conditions.MarkTrue(m.AzureMachinePool, infrav1.CapacityReservationAvailableCondition)
+}
+
+// SetCapacityReservationAllocationFailed marks the capacity reservation as unable to allocate the scale set's VMs.
+func (m *MachinePoolScope) SetCapacityReservationAllocationFailed(err error) {
+	conditions.MarkFalse(m.AzureMachinePool, infrav1.CapacityReservationAvailableCondition, infrav1.CapacityReservationAllocationFailedReason, clusterv1.ConditionSeverityError, "%s", err.Error())
+}
+
 // SetInfrastructureMachineKind sets the infrastructure machine kind in the status if it is not set already, returning
 // `true` if the status was updated. This supports MachinePool Machines.
 func (m *MachinePoolScope) SetInfrastructureMachineKind() bool {
@@ -656,6 +774,8 @@ func (m *MachinePoolScope) PatchObject(ctx context.Context) error {
 		patch.WithOwnedConditions{Conditions: []clusterv1.ConditionType{
 			clusterv1.ReadyCondition,
 			infrav1.BootstrapSucceededCondition,
+			infrav1.VMSizeCompatibleCondition,
+			infrav1.CapacityReservationAvailableCondition,
 			infrav1.ScaleSetDesiredReplicasCondition,
 			infrav1.ScaleSetModelUpdatedCondition,
 			infrav1.ScaleSetRunningCondition,
@@ -694,26 +814,103 @@ func (m *MachinePoolScope) Close(ctx context.Context) error {
 	return nil
 }
 
-// GetBootstrapData returns the bootstrap data from the secret in the MachinePool's bootstrap.dataSecretName.
+// GetBootstrapData returns the custom data of the scale set. This is the bootstrap data from the secret in the
+// MachinePool's bootstrap.dataSecretName, or a loader for it if it is delivered as user data or stored in Azure.
 func (m *MachinePoolScope) GetBootstrapData(ctx context.Context) (string, error) {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "scope.MachinePoolScope.GetBootstrapData")
 	defer done()
 
+	if m.hasUserDataBootstrapTransport() {
+		loader, err := bootstrapdata.UserDataLoader()
+		if err != nil {
+			return "", err
+		}
+		return base64.StdEncoding.EncodeToString(loader), nil
+	}
+
+	if m.BootstrapDataSpec() == nil {
+		value, err := m.getBootstrapDataSecretValue(ctx)
+		if err != nil {
+			return "", err
+		}
+		return base64.StdEncoding.EncodeToString(value), nil
+	}
+
+	// The bootstrap data is stored outside of the scale set's custom data by the bootstrap data service, and the
+	// custom data only contains a loader for it.
+	loader, err := bootstrapdata.New(m).Loader()
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(loader), nil
+}
+
+// BootstrapDataSpec returns where the bootstrap data of the scale set is stored, or nil if it is passed to the
+// instances as custom data or user data.
+func (m *MachinePoolScope) BootstrapDataSpec() *bootstrapdata.BootstrapDataSpec {
+	spec := m.AzureMachinePool.Spec
+	return newBootstrapDataSpec(m, spec.Template.BootstrapTransport, m.Name(), string(m.AzureMachinePool.UID), spec.Identity, spec.UserAssignedIdentities)
+}
+
+// GetBootstrapDataValue returns the bootstrap data of the scale set.
+func (m *MachinePoolScope) GetBootstrapDataValue(ctx context.Context) ([]byte, error) {
+	return m.getBootstrapDataSecretValue(ctx)
+}
+
+// StoredBootstrapDataHash returns the hash of the bootstrap data last stored outside of the scale set's custom data.
+func (m *MachinePoolScope) StoredBootstrapDataHash() string {
+	return m.AzureMachinePool.GetAnnotations()[azure.BootstrapDataHashAnnotation]
+}
+
+// SetStoredBootstrapDataHash records the hash of the bootstrap data stored outside of the scale set's custom data.
+func (m *MachinePoolScope) SetStoredBootstrapDataHash(hash string) {
+	m.SetAnnotation(azure.BootstrapDataHashAnnotation, hash)
+}
+
+// IsBootstrapSucceeded always returns false, as the scale set can create instances that need the stored bootstrap
+// data at any time. The stored bootstrap data is deleted along with the AzureMachinePool.
+func (m *MachinePoolScope) IsBootstrapSucceeded() bool {
+	return false
+}
+
+// GetUserData returns the user data of the scale set, which is the bootstrap data when it is delivered as user data.
+func (m *MachinePoolScope) GetUserData(ctx context.Context) (string, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "scope.MachinePoolScope.GetUserData")
+	defer done()
+
+	if !m.hasUserDataBootstrapTransport() {
+		return "", nil
+	}
+
+	value, err := m.getBootstrapDataSecretValue(ctx)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(value), nil
+}
+
+func (m *MachinePoolScope) hasUserDataBootstrapTransport() bool {
+	transport := m.AzureMachinePool.Spec.Template.BootstrapTransport
+	return transport != nil && transport.Type == infrav1.BootstrapTransportUserData
+}
+
+// getBootstrapDataSecretValue returns the bootstrap data from the secret in the MachinePool's bootstrap.dataSecretName.
+func (m *MachinePoolScope) getBootstrapDataSecretValue(ctx context.Context) ([]byte, error) {
 	dataSecretName := m.MachinePool.Spec.Template.Spec.Bootstrap.DataSecretName
 	if dataSecretName == nil {
-		return "", errors.New("error retrieving bootstrap data: linked MachinePool Spec's bootstrap.dataSecretName is nil")
+		return nil, errors.New("error retrieving bootstrap data: linked MachinePool Spec's bootstrap.dataSecretName is nil")
 	}
 	secret := &corev1.Secret{}
 	key := types.NamespacedName{Namespace: m.AzureMachinePool.Namespace, Name: *dataSecretName}
 	if err := m.client.Get(ctx, key, secret); err != nil {
-		return "", errors.Wrapf(err, "failed to retrieve bootstrap data secret for AzureMachinePool %s/%s", m.AzureMachinePool.Namespace, m.Name())
+		return nil, errors.Wrapf(err, "failed to retrieve bootstrap data secret for AzureMachinePool %s/%s", m.AzureMachinePool.Namespace, m.Name())
 	}
 
 	value, ok := secret.Data["value"]
 	if !ok {
-		return "", errors.New("error retrieving bootstrap data: secret value key is missing")
+		return nil, errors.New("error retrieving bootstrap data: secret value key is missing")
 	}
-	return base64.StdEncoding.EncodeToString(value), nil
+	return value, nil
 }
 
 // calculateBootstrapDataHash calculates the sha256 hash of the bootstrap data.
@@ -724,6 +921,13 @@ func (m *MachinePoolScope) calculateBootstrapDataHash(_ context.Context) (string
 	if err != nil || n == 0 {
 		return "", fmt.Errorf("unable to write custom data (bytes written: %q): %w", n, err)
 	}
+	// The custom data is only a loader when the bootstrap data is delivered as user data, so the user data
+	// needs to be part of the hash for its changes to be patched on the scale set.
+	if m.cache.UserData != "" {
+		if _, err := io.WriteString(h, m.cache.UserData); err != nil {
+			return "", fmt.Errorf("unable to write user data: %w", err)
+		}
+	}
 	return fmt.Sprintf("%x", h.Sum(nil)), nil
 }
 
@@ -836,7 +1040,7 @@ func (m *MachinePoolScope) VMSSExtensionSpecs() []azure.ResourceSpecGetter {
 	}
 
 	cpuArchitectureType, _ := m.cache.VMSKU.GetCapability(resourceskus.CPUArchitectureType)
-	bootstrapExtensionSpec := azure.GetBootstrappingVMExtension(m.AzureMachinePool.Spec.Template.OSDisk.OSType, m.CloudEnvironment(), m.Name(), cpuArchitectureType)
+	bootstrapExtensionSpec := azure.GetBootstrappingVMExtension(m.AzureMachinePool.Spec.Template.OSDisk.OSType, m.CloudEnvironment(), m.Name(), cpuArchitectureType, m.AzureMachinePool.Spec.Template.BootstrapCheck)
 
 	if bootstrapExtensionSpec != nil {
 		extensionSpecs = append(extensionSpecs, &scalesets.VMSSExtensionSpec{
@@ -875,6 +1079,12 @@ func (m *MachinePoolScope) SetSubnetName() error {
 	return nil
 }
 
+// ValidateSubnetRoles returns an error when a network interface of the AzureMachinePool is placed in a subnet that
+// machines cannot use, such as a subnet delegated to an Azure service.
+func (m *MachinePoolScope) ValidateSubnetRoles() error {
+	return validateSubnetRoles(m.Subnets(), m.AzureMachinePool.Spec.Template.NetworkInterfaces)
+}
+
 // UpdateDeleteStatus updates a condition on the AzureMachinePool status after a DELETE operation.
 func (m *MachinePoolScope) UpdateDeleteStatus(condition clusterv1.ConditionType, service string, err error) {
 	switch {
@@ -18,6 +18,7 @@ package scope
 
 import (
 	"context"
+	"encoding/base64"
 	"fmt"
 	"reflect"
 	"testing"
@@ -36,12 +37,14 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/mock_azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bootstrapdata"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/roleassignments"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/scalesets"
 	infrav1exp "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1beta1"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
@@ -1344,6 +1347,70 @@ func TestMachinePoolScope_SetInfrastructureMachineKind(t *testing.T) {
 	}
 }
 
+func TestMachinePoolScope_SetCapacityReservationStatus(t *testing.T) {
+	testcases := []struct {
+		name           string
+		replicas       int32
+		statusReplicas int32
+		reserved       int32
+		allocated      int32
+		expectedStatus corev1.ConditionStatus
+		expectedReason string
+	}{
+		{
+			name:           "reservation has capacity left",
+			replicas:       3,
+			statusReplicas: 2,
+			reserved:       5,
+			allocated:      2,
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name:           "reservation is fully allocated to the desired replicas",
+			replicas:       5,
+			statusReplicas: 5,
+			reserved:       5,
+			allocated:      5,
+			expectedStatus: corev1.ConditionTrue,
+		},
+		{
+			name:           "reservation is exhausted",
+			replicas:       6,
+			statusReplicas: 5,
+			reserved:       5,
+			allocated:      5,
+			expectedStatus: corev1.ConditionFalse,
+			expectedReason: infrav1.CapacityReservationExhaustedReason,
+		},
+	}
+
+	for _, tt := range testcases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			machinePoolScope := &MachinePoolScope{
+				MachinePool: &expv1.MachinePool{
+					Spec: expv1.MachinePoolSpec{Replicas: ptr.To(tt.replicas)},
+				},
+				AzureMachinePool: &infrav1exp.AzureMachinePool{
+					Status: infrav1exp.AzureMachinePoolStatus{Replicas: tt.statusReplicas},
+				},
+			}
+
+			machinePoolScope.SetCapacityReservationStatus(tt.reserved, tt.allocated)
+			g.Expect(machinePoolScope.AzureMachinePool.Status.CapacityReservation).To(Equal(&infrav1exp.CapacityReservationStatus{
+				ReservedCapacity:  tt.reserved,
+				AllocatedCapacity: tt.allocated,
+			}))
+			condition := conditions.Get(machinePoolScope.AzureMachinePool, infrav1.CapacityReservationAvailableCondition)
+			g.Expect(condition).NotTo(BeNil())
+			g.Expect(condition.Status).To(Equal(tt.expectedStatus))
+			g.Expect(condition.Reason).To(Equal(tt.expectedReason))
+		})
+	}
+}
+
 func TestMachinePoolScope_applyAzureMachinePoolMachines(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -1515,3 +1582,211 @@ func TestMachinePoolScope_applyAzureMachinePoolMachines(t *testing.T) {
 		})
 	}
 }
+
+func TestMachinePoolScope_GetUserData(t *testing.T) {
+	loader, err := bootstrapdata.UserDataLoader()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name              string
+		transport         *infrav1.BootstrapTransport
+		wantBootstrapData string
+		wantUserData      string
+	}{
+		{
+			name:              "bootstrap data is passed as custom data by default",
+			transport:         nil,
+			wantBootstrapData: base64.StdEncoding.EncodeToString([]byte("#cloud-config")),
+			wantUserData:      "",
+		},
+		{
+			name:              "bootstrap data is passed as user data with a loader as custom data",
+			transport:         &infrav1.BootstrapTransport{Type: infrav1.BootstrapTransportUserData},
+			wantBootstrapData: base64.StdEncoding.EncodeToString(loader),
+			wantUserData:      base64.StdEncoding.EncodeToString([]byte("#cloud-config")),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			scheme := runtime.NewScheme()
+			_ = corev1.AddToScheme(scheme)
+			secret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "bootstrap-data",
+					Namespace: "default",
+				},
+				Data: map[string][]byte{
+					"value": []byte("#cloud-config"),
+				},
+			}
+			s := &MachinePoolScope{
+				client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(secret).Build(),
+				MachinePool: &expv1.MachinePool{
+					Spec: expv1.MachinePoolSpec{
+						Template: clusterv1.MachineTemplateSpec{
+							Spec: clusterv1.MachineSpec{
+								Bootstrap: clusterv1.Bootstrap{
+									DataSecretName: ptr.To("bootstrap-data"),
+								},
+							},
+						},
+					},
+				},
+				AzureMachinePool: &infrav1exp.AzureMachinePool{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "amp",
+						Namespace: "default",
+					},
+					Spec: infrav1exp.AzureMachinePoolSpec{
+						Template: infrav1exp.AzureMachinePoolMachineTemplate{
+							BootstrapTransport: tt.transport,
+						},
+					},
+				},
+			}
+
+			bootstrapData, err := s.GetBootstrapData(context.TODO())
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(bootstrapData).To(Equal(tt.wantBootstrapData))
+
+			userData, err := s.GetUserData(context.TODO())
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(userData).To(Equal(tt.wantUserData))
+		})
+	}
+}
+
+func TestMachinePoolScope_HasBootstrapDataChanges(t *testing.T) {
+	g := NewWithT(t)
+	s := &MachinePoolScope{
+		AzureMachinePool: &infrav1exp.AzureMachinePool{},
+		cache: &MachinePoolCache{
+			BootstrapData: "loader",
+			UserData:      "user-data-1",
+		},
+	}
+	g.Expect(s.updateCustomDataHash(context.TODO())).To(Succeed())
+
+	changed, err := s.HasBootstrapDataChanges(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(changed).To(BeFalse())
+
+	// Rotating the user data changes the hash even though the custom data stays the same.
+	s.cache.UserData = "user-data-2"
+	changed, err = s.HasBootstrapDataChanges(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(changed).To(BeTrue())
+}
+
+func TestMachinePoolScope_BootstrapDataSpec(t *testing.T) {
+	clusterScope := &ClusterScope{
+		Cluster: &clusterv1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "my-cluster",
+			},
+		},
+	}
+	tests := []struct {
+		name             string
+		azureMachinePool *infrav1exp.AzureMachinePool
+		want             *bootstrapdata.BootstrapDataSpec
+	}{
+		{
+			name: "returns nil if the bootstrap data is passed as user data",
+			azureMachinePool: &infrav1exp.AzureMachinePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool-name", UID: "1234"},
+				Spec: infrav1exp.AzureMachinePoolSpec{
+					Template: infrav1exp.AzureMachinePoolMachineTemplate{
+						BootstrapTransport: &infrav1.BootstrapTransport{Type: infrav1.BootstrapTransportUserData},
+					},
+				},
+			},
+			want: nil,
+		},
+		{
+			name: "uses the first user-assigned identity of the scale set to fetch a blob",
+			azureMachinePool: &infrav1exp.AzureMachinePool{
+				ObjectMeta: metav1.ObjectMeta{Name: "pool-name", UID: "1234"},
+				Spec: infrav1exp.AzureMachinePoolSpec{
+					Identity: infrav1.VMIdentityUserAssigned,
+					UserAssignedIdentities: []infrav1.UserAssignedIdentity{
+						{ProviderID: "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/id1"},
+					},
+					Template: infrav1exp.AzureMachinePoolMachineTemplate{
+						BootstrapTransport: &infrav1.BootstrapTransport{
+							Type:        infrav1.BootstrapTransportStorageBlob,
+							StorageBlob: &infrav1.StorageBlobBootstrapTransport{StorageAccountName: "mystorageaccount"},
+						},
+					},
+				},
+			},
+			want: &bootstrapdata.BootstrapDataSpec{
+				Transport:          infrav1.BootstrapTransportStorageBlob,
+				StorageAccountName: "mystorageaccount",
+				ContainerName:      "my-cluster",
+				Name:               "pool-name-1234",
+				IdentityResourceID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/id1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewWithT(t)
+			machinePoolScope := MachinePoolScope{
+				ClusterScoper:    clusterScope,
+				AzureMachinePool: tt.azureMachinePool,
+			}
+			g.Expect(machinePoolScope.BootstrapDataSpec()).To(Equal(tt.want))
+		})
+	}
+}
+
+func TestMachinePoolScope_NeedsVMSizeValidation(t *testing.T) {
+	g := NewWithT(t)
+	s := &MachinePoolScope{
+		ClusterScoper: &ClusterScope{
+			AzureCluster: &infrav1.AzureCluster{
+				Spec: infrav1.AzureClusterSpec{
+					AzureClusterClassSpec: infrav1.AzureClusterClassSpec{
+						Location: "westus2",
+					},
+				},
+			},
+		},
+		MachinePool: &expv1.MachinePool{},
+		AzureMachinePool: &infrav1exp.AzureMachinePool{
+			Spec: infrav1exp.AzureMachinePoolSpec{
+				Template: infrav1exp.AzureMachinePoolMachineTemplate{
+					VMSize: "Standard_D2s_v3",
+				},
+			},
+		},
+	}
+	g.Expect(s.NeedsVMSizeValidation()).To(BeTrue())
+
+	s.SetVMSizeValidated()
+	g.Expect(s.NeedsVMSizeValidation()).To(BeFalse())
+	g.Expect(conditions.IsTrue(s.AzureMachinePool, infrav1.VMSizeCompatibleCondition)).To(BeTrue())
+	g.Expect(s.IsVMSizeIncompatible()).To(BeFalse())
+
+	// Changing the VM size of an existing scale set requires checking it again.
+	s.AzureMachinePool.Spec.Template.VMSize = "Standard_D4s_v3"
+	g.Expect(s.NeedsVMSizeValidation()).To(BeTrue())
+
+	// So does changing a feature the VM size is checked against.
+	s.SetVMSizeValidated()
+	s.AzureMachinePool.Spec.Template.OSDisk.DiffDiskSettings = &infrav1.DiffDiskSettings{Option: "Local"}
+	g.Expect(s.NeedsVMSizeValidation()).To(BeTrue())
+
+	s.SetVMSizeValidated()
+	s.MachinePool.Spec.FailureDomains = []string{"1"}
+	g.Expect(s.NeedsVMSizeValidation()).To(BeTrue())
+
+	s.SetVMSizeValidated()
+	conditions.MarkFalse(s.AzureMachinePool, infrav1.VMSizeCompatibleCondition, infrav1.VMSizeIncompatibleReason, clusterv1.ConditionSeverityError, "")
+	g.Expect(s.NeedsVMSizeValidation()).To(BeTrue())
+	g.Expect(s.IsVMSizeIncompatible()).To(BeTrue())
+}
@@ -386,9 +386,26 @@ func (s *MachinePoolMachineScope) UpdateNodeStatus(ctx context.Context) error {
 		s.AzureMachinePoolMachine.Status.Version = node.Status.NodeInfo.KubeletVersion
 	}
 
+	// Without the bootstrap check VM extension, the instance has bootstrapped once its Node has registered.
+	if s.isBootstrapCheckDisabled() {
+		if found {
+			conditions.MarkTrue(s.AzureMachinePoolMachine, infrav1.BootstrapSucceededCondition)
+		} else {
+			conditions.MarkFalse(s.AzureMachinePoolMachine, infrav1.BootstrapSucceededCondition, infrav1.BootstrapInProgressReason, clusterv1.ConditionSeverityInfo, "waiting for the Node to register")
+		}
+	}
+
 	return nil
 }
 
+func (s *MachinePoolMachineScope) isBootstrapCheckDisabled() bool {
+	if s.AzureMachinePool == nil {
+		return false
+	}
+	check := s.AzureMachinePool.Spec.Template.BootstrapCheck
+	return check != nil && check.Disabled
+}
+
 // UpdateInstanceStatus updates the provisioning state of the AzureMachinePoolMachine and if it has the latest model applied
 // using the VMSS VM instance.
 // Note: This func should be called at the end of a reconcile request and after updating the scope with the most recent Azure data.
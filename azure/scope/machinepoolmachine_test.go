@@ -342,6 +342,8 @@ func TestMachineScope_UpdateNodeStatus(t *testing.T) {
 		Setup  func(mockNodeGetter *mock_scope.MocknodeGetter, ampm *infrav1exp.AzureMachinePoolMachine) (*azure.VMSSVM, *infrav1exp.AzureMachinePoolMachine)
 		Verify func(g *WithT, scope *MachinePoolMachineScope)
 		Err    string
+		// AzureMachinePool defaults to an empty AzureMachinePool.
+		AzureMachinePool *infrav1exp.AzureMachinePool
 	}{
 		{
 			Name: "should set kubernetes version, ready, and node reference upon finding the node",
@@ -410,6 +412,28 @@ func TestMachineScope_UpdateNodeStatus(t *testing.T) {
 				assertCondition(t, scope.AzureMachinePoolMachine, conditions.TrueCondition(clusterv1.MachineNodeHealthyCondition))
 			},
 		},
+		{
+			Name: "should mark bootstrap succeeded upon finding the node when the bootstrap check is disabled",
+			Setup: func(mockNodeGetter *mock_scope.MocknodeGetter, ampm *infrav1exp.AzureMachinePoolMachine) (*azure.VMSSVM, *infrav1exp.AzureMachinePoolMachine) {
+				mockNodeGetter.EXPECT().GetNodeByProviderID(gomock2.AContext(), FakeProviderID).Return(getReadyNode(), nil)
+				return &azure.VMSSVM{State: infrav1.Succeeded}, ampm
+			},
+			AzureMachinePool: getAzureMachinePoolWithBootstrapCheckDisabled(),
+			Verify: func(g *WithT, scope *MachinePoolMachineScope) {
+				g.Expect(conditions.IsTrue(scope.AzureMachinePoolMachine, infrav1.BootstrapSucceededCondition)).To(BeTrue())
+			},
+		},
+		{
+			Name: "should wait for the node to register when the bootstrap check is disabled",
+			Setup: func(mockNodeGetter *mock_scope.MocknodeGetter, ampm *infrav1exp.AzureMachinePoolMachine) (*azure.VMSSVM, *infrav1exp.AzureMachinePoolMachine) {
+				mockNodeGetter.EXPECT().GetNodeByProviderID(gomock2.AContext(), FakeProviderID).Return(nil, nil)
+				return &azure.VMSSVM{State: infrav1.Succeeded}, ampm
+			},
+			AzureMachinePool: getAzureMachinePoolWithBootstrapCheckDisabled(),
+			Verify: func(g *WithT, scope *MachinePoolMachineScope) {
+				assertCondition(t, scope.AzureMachinePoolMachine, conditions.FalseCondition(infrav1.BootstrapSucceededCondition, infrav1.BootstrapInProgressReason, clusterv1.ConditionSeverityInfo, ""))
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -437,6 +461,9 @@ func TestMachineScope_UpdateNodeStatus(t *testing.T) {
 
 			defer controller.Finish()
 
+			if c.AzureMachinePool != nil {
+				params.AzureMachinePool = c.AzureMachinePool
+			}
 			instance, ampm := c.Setup(mockClient, &infrav1exp.AzureMachinePoolMachine{
 				Spec: infrav1exp.AzureMachinePoolMachineSpec{
 					ProviderID: FakeProviderID,
@@ -463,6 +490,12 @@ func TestMachineScope_UpdateNodeStatus(t *testing.T) {
 	}
 }
 
+func getAzureMachinePoolWithBootstrapCheckDisabled() *infrav1exp.AzureMachinePool {
+	amp := new(infrav1exp.AzureMachinePool)
+	amp.Spec.Template.BootstrapCheck = &infrav1.BootstrapCheck{Disabled: true}
+	return amp
+}
+
 func getReadyNode() *corev1.Node {
 	return &corev1.Node{
 		ObjectMeta: metav1.ObjectMeta{
@@ -319,6 +319,11 @@ func (s *ManagedControlPlaneScope) AzureFleetsMemberSpec() []azure.ASOResourceSp
 	}}
 }
 
+// ApplicationSecurityGroups returns nil as managed clusters don't have application security groups.
+func (s *ManagedControlPlaneScope) ApplicationSecurityGroups() []infrav1.ApplicationSecurityGroup {
+	return nil
+}
+
 // ControlPlaneRouteTable returns the cluster controlplane routetable.
 func (s *ManagedControlPlaneScope) ControlPlaneRouteTable() infrav1.RouteTable {
 	return infrav1.RouteTable{}
@@ -682,9 +687,99 @@ func (s *ManagedControlPlaneScope) ManagedClusterSpec() azure.ASOResourceSpecGet
 		managedClusterSpec.SecurityProfile = s.getManagedClusterSecurityProfile()
 	}
 
+	if s.ControlPlane.Spec.ServiceMeshProfile != nil {
+		managedClusterSpec.ServiceMeshProfile = s.getManagedClusterServiceMeshProfile()
+	}
+
+	if s.ControlPlane.Spec.WorkloadAutoScalerProfile != nil {
+		managedClusterSpec.WorkloadAutoScalerProfile = &managedclusters.ManagedClusterWorkloadAutoScalerProfile{}
+		if s.ControlPlane.Spec.WorkloadAutoScalerProfile.Keda != nil {
+			managedClusterSpec.WorkloadAutoScalerProfile.KedaEnabled = ptr.To(s.ControlPlane.Spec.WorkloadAutoScalerProfile.Keda.Enabled)
+		}
+		if s.ControlPlane.Spec.WorkloadAutoScalerProfile.VerticalPodAutoscaler != nil {
+			managedClusterSpec.WorkloadAutoScalerProfile.VerticalPodAutoscalerEnabled = ptr.To(s.ControlPlane.Spec.WorkloadAutoScalerProfile.VerticalPodAutoscaler.Enabled)
+		}
+	}
+
+	if s.ControlPlane.Spec.AzureMonitorProfile != nil {
+		managedClusterSpec.AzureMonitorProfile = &managedclusters.ManagedClusterAzureMonitorProfile{}
+		if metrics := s.ControlPlane.Spec.AzureMonitorProfile.Metrics; metrics != nil {
+			managedClusterSpec.AzureMonitorProfile.Metrics = &managedclusters.ManagedClusterAzureMonitorProfileMetrics{
+				Enabled: metrics.Enabled,
+			}
+			if metrics.KubeStateMetrics != nil {
+				managedClusterSpec.AzureMonitorProfile.Metrics.MetricAnnotationsAllowList = metrics.KubeStateMetrics.MetricAnnotationsAllowList
+				managedClusterSpec.AzureMonitorProfile.Metrics.MetricLabelsAllowlist = metrics.KubeStateMetrics.MetricLabelsAllowlist
+			}
+		}
+	}
+
+	if s.ControlPlane.Spec.StorageProfile != nil {
+		managedClusterSpec.StorageProfile = s.getManagedClusterStorageProfile()
+	}
+
 	return &managedClusterSpec
 }
 
+// getManagedClusterStorageProfile gets the storage profile for managed cluster.
+func (s *ManagedControlPlaneScope) getManagedClusterStorageProfile() *managedclusters.ManagedClusterStorageProfile {
+	storageProfile := &managedclusters.ManagedClusterStorageProfile{}
+	if s.ControlPlane.Spec.StorageProfile.BlobCSIDriver != nil {
+		storageProfile.BlobCSIDriverEnabled = ptr.To(s.ControlPlane.Spec.StorageProfile.BlobCSIDriver.Enabled)
+	}
+	if s.ControlPlane.Spec.StorageProfile.DiskCSIDriver != nil {
+		storageProfile.DiskCSIDriverEnabled = ptr.To(s.ControlPlane.Spec.StorageProfile.DiskCSIDriver.Enabled)
+	}
+	if s.ControlPlane.Spec.StorageProfile.FileCSIDriver != nil {
+		storageProfile.FileCSIDriverEnabled = ptr.To(s.ControlPlane.Spec.StorageProfile.FileCSIDriver.Enabled)
+	}
+	if s.ControlPlane.Spec.StorageProfile.SnapshotController != nil {
+		storageProfile.SnapshotControllerEnabled = ptr.To(s.ControlPlane.Spec.StorageProfile.SnapshotController.Enabled)
+	}
+	return storageProfile
+}
+
+// getManagedClusterServiceMeshProfile gets the service mesh profile for managed cluster.
+func (s *ManagedControlPlaneScope) getManagedClusterServiceMeshProfile() *managedclusters.ServiceMeshProfile {
+	serviceMeshProfile := &managedclusters.ServiceMeshProfile{
+		Mode: string(s.ControlPlane.Spec.ServiceMeshProfile.Mode),
+	}
+	istio := s.ControlPlane.Spec.ServiceMeshProfile.Istio
+	if istio == nil {
+		return serviceMeshProfile
+	}
+
+	serviceMeshProfile.Istio = &managedclusters.IstioServiceMesh{
+		Revisions: istio.Revisions,
+	}
+	if istio.Components != nil {
+		for _, gateway := range istio.Components.IngressGateways {
+			serviceMeshProfile.Istio.IngressGateways = append(serviceMeshProfile.Istio.IngressGateways, managedclusters.IstioIngressGateway{
+				Mode:    string(gateway.Mode),
+				Enabled: gateway.Enabled,
+			})
+		}
+		for _, gateway := range istio.Components.EgressGateways {
+			serviceMeshProfile.Istio.EgressGateways = append(serviceMeshProfile.Istio.EgressGateways, managedclusters.IstioEgressGateway{
+				Enabled:      gateway.Enabled,
+				NodeSelector: gateway.NodeSelector,
+			})
+		}
+	}
+	if istio.CertificateAuthority != nil && istio.CertificateAuthority.Plugin != nil {
+		plugin := istio.CertificateAuthority.Plugin
+		serviceMeshProfile.Istio.PluginCertificateAuthority = &managedclusters.IstioPluginCertificateAuthority{
+			KeyVaultResourceID:  plugin.KeyVaultResourceID,
+			CertObjectName:      plugin.CertObjectName,
+			KeyObjectName:       plugin.KeyObjectName,
+			RootCertObjectName:  plugin.RootCertObjectName,
+			CertChainObjectName: plugin.CertChainObjectName,
+		}
+	}
+
+	return serviceMeshProfile
+}
+
 // GetManagedClusterSecurityProfile gets the security profile for managed cluster.
 func (s *ManagedControlPlaneScope) getManagedClusterSecurityProfile() *managedclusters.ManagedClusterSecurityProfile {
 	securityProfile := &managedclusters.ManagedClusterSecurityProfile{}
@@ -1004,6 +1099,11 @@ func (s *ManagedControlPlaneScope) SetOIDCIssuerProfileStatus(oidc *infrav1.OIDC
 	s.ControlPlane.Status.OIDCIssuerProfile = oidc
 }
 
+// SetStorageProfileStatus sets the status for the storage profile.
+func (s *ManagedControlPlaneScope) SetStorageProfileStatus(storageProfile *infrav1.ManagedClusterStorageProfileStatus) {
+	s.ControlPlane.Status.StorageProfile = storageProfile
+}
+
 // AKSExtension returns the cluster AKS extensions.
 func (s *ManagedControlPlaneScope) AKSExtension() []infrav1.AKSExtension {
 	return s.ControlPlane.Spec.Extensions
@@ -27,6 +27,8 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/agentpools"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/agentpoolsnapshots"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/proximityplacementgroups"
 	"sigs.k8s.io/cluster-api-provider-azure/util/futures"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	"sigs.k8s.io/cluster-api-provider-azure/util/versions"
@@ -182,23 +184,27 @@ func buildAgentPoolSpec(managedControlPlane *infrav1.AzureManagedControlPlane,
 			managedControlPlane.Spec.VirtualNetwork.Name,
 			ptr.Deref(getAgentPoolSubnet(managedControlPlane, managedMachinePool), ""),
 		),
-		Mode:                   managedMachinePool.Spec.Mode,
-		MaxPods:                managedMachinePool.Spec.MaxPods,
-		AvailabilityZones:      managedMachinePool.Spec.AvailabilityZones,
-		OsDiskType:             managedMachinePool.Spec.OsDiskType,
-		EnableUltraSSD:         managedMachinePool.Spec.EnableUltraSSD,
-		EnableNodePublicIP:     managedMachinePool.Spec.EnableNodePublicIP,
-		NodePublicIPPrefixID:   ptr.Deref(managedMachinePool.Spec.NodePublicIPPrefixID, ""),
-		ScaleSetPriority:       managedMachinePool.Spec.ScaleSetPriority,
-		ScaleDownMode:          managedMachinePool.Spec.ScaleDownMode,
-		SpotMaxPrice:           managedMachinePool.Spec.SpotMaxPrice,
-		AdditionalTags:         managedMachinePool.Spec.AdditionalTags,
-		KubeletDiskType:        managedMachinePool.Spec.KubeletDiskType,
-		LinuxOSConfig:          managedMachinePool.Spec.LinuxOSConfig,
-		EnableFIPS:             managedMachinePool.Spec.EnableFIPS,
-		EnableEncryptionAtHost: managedMachinePool.Spec.EnableEncryptionAtHost,
-		Patches:                managedMachinePool.Spec.ASOManagedClustersAgentPoolPatches,
-		Preview:                ptr.Deref(managedControlPlane.Spec.EnablePreviewFeatures, false),
+		Mode:                       managedMachinePool.Spec.Mode,
+		MaxPods:                    managedMachinePool.Spec.MaxPods,
+		AvailabilityZones:          managedMachinePool.Spec.AvailabilityZones,
+		OsDiskType:                 managedMachinePool.Spec.OsDiskType,
+		EnableUltraSSD:             managedMachinePool.Spec.EnableUltraSSD,
+		EnableNodePublicIP:         managedMachinePool.Spec.EnableNodePublicIP,
+		NodePublicIPPrefixID:       ptr.Deref(managedMachinePool.Spec.NodePublicIPPrefixID, ""),
+		SnapshotID:                 ptr.Deref(managedMachinePool.Spec.SnapshotID, ""),
+		ProximityPlacementGroupID:  getAgentPoolProximityPlacementGroupID(managedControlPlane, managedMachinePool),
+		CapacityReservationGroupID: ptr.Deref(managedMachinePool.Spec.CapacityReservationGroupID, ""),
+		HostGroupID:                ptr.Deref(managedMachinePool.Spec.HostGroupID, ""),
+		ScaleSetPriority:           managedMachinePool.Spec.ScaleSetPriority,
+		ScaleDownMode:              managedMachinePool.Spec.ScaleDownMode,
+		SpotMaxPrice:               managedMachinePool.Spec.SpotMaxPrice,
+		AdditionalTags:             managedMachinePool.Spec.AdditionalTags,
+		KubeletDiskType:            managedMachinePool.Spec.KubeletDiskType,
+		LinuxOSConfig:              managedMachinePool.Spec.LinuxOSConfig,
+		EnableFIPS:                 managedMachinePool.Spec.EnableFIPS,
+		EnableEncryptionAtHost:     managedMachinePool.Spec.EnableEncryptionAtHost,
+		Patches:                    managedMachinePool.Spec.ASOManagedClustersAgentPoolPatches,
+		Preview:                    ptr.Deref(managedControlPlane.Spec.EnablePreviewFeatures, false),
 	}
 
 	if managedMachinePool.Spec.OSDiskSizeGB != nil {
@@ -244,6 +250,74 @@ func buildAgentPoolSpec(managedControlPlane *infrav1.AzureManagedControlPlane,
 	return agentPoolSpec
 }
 
+// getAgentPoolProximityPlacementGroupID returns the resource ID of the proximity placement group the agent pool is placed in,
+// which is either the one referenced by ID or the one created and owned by CAPZ.
+func getAgentPoolProximityPlacementGroupID(controlPlane *infrav1.AzureManagedControlPlane, infraMachinePool *infrav1.AzureManagedMachinePool) string {
+	if ppg := infraMachinePool.Spec.ProximityPlacementGroup; ppg != nil {
+		return azure.ProximityPlacementGroupID(
+			controlPlane.Spec.SubscriptionID,
+			getProximityPlacementGroupResourceGroup(controlPlane, ppg),
+			ppg.Name,
+		)
+	}
+	return ptr.Deref(infraMachinePool.Spec.ProximityPlacementGroupID, "")
+}
+
+func getProximityPlacementGroupResourceGroup(controlPlane *infrav1.AzureManagedControlPlane, ppg *infrav1.ProximityPlacementGroup) string {
+	if ppg.ResourceGroup != "" {
+		return ppg.ResourceGroup
+	}
+	return controlPlane.Spec.ResourceGroupName
+}
+
+// ProximityPlacementGroupSpec returns the spec of the proximity placement group created and owned by CAPZ for the
+// AzureManagedMachinePool, or nil if the node pool does not use one.
+func (s *ManagedMachinePoolScope) ProximityPlacementGroupSpec() azure.ResourceSpecGetter {
+	ppg := s.InfraMachinePool.Spec.ProximityPlacementGroup
+	if ppg == nil {
+		return nil
+	}
+	return &proximityplacementgroups.ProximityPlacementGroupSpec{
+		Name:           ppg.Name,
+		ResourceGroup:  getProximityPlacementGroupResourceGroup(s.ControlPlane, ppg),
+		ClusterName:    s.Cluster.Name,
+		Location:       s.ControlPlane.Spec.Location,
+		Zones:          s.InfraMachinePool.Spec.AvailabilityZones,
+		VMSizes:        ppg.VMSizes,
+		AdditionalTags: s.InfraMachinePool.Spec.AdditionalTags,
+	}
+}
+
+// AgentPoolSnapshotSpec returns the AKS node pool snapshot spec for the AzureManagedMachinePool, or nil if
+// no snapshot is configured.
+func (s *ManagedMachinePoolScope) AgentPoolSnapshotSpec() azure.ResourceSpecGetter {
+	snapshot := s.InfraMachinePool.Spec.Snapshot
+	if snapshot == nil {
+		return nil
+	}
+	resourceGroup := snapshot.ResourceGroup
+	if resourceGroup == "" {
+		resourceGroup = s.ControlPlane.Spec.ResourceGroupName
+	}
+	return &agentpoolsnapshots.AgentPoolSnapshotSpec{
+		Name:          snapshot.Name,
+		ResourceGroup: resourceGroup,
+		Location:      s.ControlPlane.Spec.Location,
+		AgentPoolID: azure.AgentPoolID(
+			s.ControlPlane.Spec.SubscriptionID,
+			s.ControlPlane.Spec.ResourceGroupName,
+			s.ControlPlane.Name,
+			ptr.Deref(s.InfraMachinePool.Spec.Name, s.InfraMachinePool.Name),
+		),
+		AdditionalTags: s.InfraMachinePool.Spec.AdditionalTags,
+	}
+}
+
+// SetAgentPoolSnapshotStatus sets the status of the most recent AKS node pool snapshot of the agent pool.
+func (s *ManagedMachinePoolScope) SetAgentPoolSnapshotStatus(status *infrav1.AgentPoolSnapshotStatus) {
+	s.InfraMachinePool.Status.Snapshot = status
+}
+
 // IsPreviewEnabled returns the value of the EnablePreviewFeatures field from the AzureManagedControlPlane.
 func (s *ManagedMachinePoolScope) IsPreviewEnabled() bool {
 	return ptr.Deref(s.ControlPlane.Spec.EnablePreviewFeatures, false)
@@ -31,6 +31,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/agentpools"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/proximityplacementgroups"
 	clusterv1 "sigs.k8s.io/cluster-api/api/v1beta1"
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -945,6 +946,81 @@ func TestManagedMachinePoolScope_EnablePreviewFeatures(t *testing.T) {
 	}
 }
 
+func TestManagedMachinePoolScope_ProximityPlacementGroup(t *testing.T) {
+	cases := []struct {
+		Name         string
+		ppgID        *string
+		ppg          *infrav1.ProximityPlacementGroup
+		ExpectedID   string
+		ExpectedSpec azure.ResourceSpecGetter
+	}{
+		{
+			Name:         "Without proximity placement group",
+			ExpectedID:   "",
+			ExpectedSpec: nil,
+		},
+		{
+			Name:         "With proximity placement group ID",
+			ppgID:        ptr.To("/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/other-rg/providers/Microsoft.Compute/proximityPlacementGroups/ppg"),
+			ExpectedID:   "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/other-rg/providers/Microsoft.Compute/proximityPlacementGroups/ppg",
+			ExpectedSpec: nil,
+		},
+		{
+			Name:       "With CAPZ-owned proximity placement group",
+			ppg:        &infrav1.ProximityPlacementGroup{Name: "ppg", VMSizes: []string{"Standard_D2s_v3"}},
+			ExpectedID: "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/cluster-rg/providers/Microsoft.Compute/proximityPlacementGroups/ppg",
+			ExpectedSpec: &proximityplacementgroups.ProximityPlacementGroupSpec{
+				Name:          "ppg",
+				ResourceGroup: "cluster-rg",
+				ClusterName:   "cluster1",
+				Location:      "eastus",
+				Zones:         []string{"1"},
+				VMSizes:       []string{"Standard_D2s_v3"},
+			},
+		},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(c.Name, func(t *testing.T) {
+			g := NewWithT(t)
+			s := &ManagedMachinePoolScope{
+				Cluster: &clusterv1.Cluster{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster1",
+					},
+				},
+				ControlPlane: &infrav1.AzureManagedControlPlane{
+					Spec: infrav1.AzureManagedControlPlaneSpec{
+						AzureManagedControlPlaneClassSpec: infrav1.AzureManagedControlPlaneClassSpec{
+							SubscriptionID:    "00000000-0000-0000-0000-000000000000",
+							Location:          "eastus",
+							ResourceGroupName: "cluster-rg",
+						},
+					},
+				},
+				MachinePool: &expv1.MachinePool{},
+				InfraMachinePool: &infrav1.AzureManagedMachinePool{
+					Spec: infrav1.AzureManagedMachinePoolSpec{
+						AzureManagedMachinePoolClassSpec: infrav1.AzureManagedMachinePoolClassSpec{
+							AvailabilityZones:         []string{"1"},
+							ProximityPlacementGroupID: c.ppgID,
+						},
+						ProximityPlacementGroup: c.ppg,
+					},
+				},
+			}
+			agentPool, ok := s.AgentPoolSpec().(*agentpools.AgentPoolSpec)
+			g.Expect(ok).To(BeTrue())
+			g.Expect(agentPool.ProximityPlacementGroupID).To(Equal(c.ExpectedID))
+			if c.ExpectedSpec == nil {
+				g.Expect(s.ProximityPlacementGroupSpec()).To(BeNil())
+			} else {
+				g.Expect(s.ProximityPlacementGroupSpec()).To(Equal(c.ExpectedSpec))
+			}
+		})
+	}
+}
+
 func Test_getManagedMachinePoolVersion(t *testing.T) {
 	cases := []struct {
 		name                string
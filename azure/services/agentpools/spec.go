@@ -155,6 +155,18 @@ type AgentPoolSpec struct {
 	// EnableEncryptionAtHost indicates whether host encryption is enabled on the node pool
 	EnableEncryptionAtHost *bool
 
+	// SnapshotID is the resource ID of the AKS node pool snapshot the agent pool is created from.
+	SnapshotID string
+
+	// ProximityPlacementGroupID is the resource ID of the proximity placement group the agent pool is placed in.
+	ProximityPlacementGroupID string
+
+	// CapacityReservationGroupID is the resource ID of the capacity reservation group the agent pool is associated with.
+	CapacityReservationGroupID string
+
+	// HostGroupID is the resource ID of the dedicated host group the agent pool's VMs are provisioned on.
+	HostGroupID string
+
 	// Patches are extra patches to be applied to the ASO resource.
 	Patches []string
 
@@ -278,6 +290,32 @@ func (s *AgentPoolSpec) Parameters(ctx context.Context, existingObj genruntime.M
 		}
 	}
 
+	if s.SnapshotID != "" {
+		agentPool.Spec.CreationData = &asocontainerservicev1hub.CreationData{
+			SourceResourceReference: &genruntime.ResourceReference{
+				ARMID: s.SnapshotID,
+			},
+		}
+	}
+
+	if s.ProximityPlacementGroupID != "" {
+		agentPool.Spec.ProximityPlacementGroupReference = &genruntime.ResourceReference{
+			ARMID: s.ProximityPlacementGroupID,
+		}
+	}
+
+	if s.CapacityReservationGroupID != "" {
+		agentPool.Spec.CapacityReservationGroupReference = &genruntime.ResourceReference{
+			ARMID: s.CapacityReservationGroupID,
+		}
+	}
+
+	if s.HostGroupID != "" {
+		agentPool.Spec.HostGroupReference = &genruntime.ResourceReference{
+			ARMID: s.HostGroupID,
+		}
+	}
+
 	if s.LinuxOSConfig != nil {
 		agentPool.Spec.LinuxOSConfig = &asocontainerservicev1hub.LinuxOSConfig{
 			SwapFileSizeMB:             s.LinuxOSConfig.SwapFileSizeMB,
@@ -70,8 +70,12 @@ func TestParameters(t *testing.T) {
 					FsNrOpen: ptr.To(6),
 				},
 			},
-			EnableFIPS:             ptr.To(true),
-			EnableEncryptionAtHost: ptr.To(false),
+			EnableFIPS:                 ptr.To(true),
+			EnableEncryptionAtHost:     ptr.To(false),
+			SnapshotID:                 "snapshot ID",
+			ProximityPlacementGroupID:  "ppg ID",
+			CapacityReservationGroupID: "crg ID",
+			HostGroupID:                "host group ID",
 		}
 		expected := &asocontainerservicev1.ManagedClustersAgentPool{
 			Spec: asocontainerservicev1.ManagedClusters_AgentPool_Spec{
@@ -112,6 +116,20 @@ func TestParameters(t *testing.T) {
 				NodePublicIPPrefixReference: &genruntime.ResourceReference{
 					ARMID: "public IP prefix ID",
 				},
+				CreationData: &asocontainerservicev1.CreationData{
+					SourceResourceReference: &genruntime.ResourceReference{
+						ARMID: "snapshot ID",
+					},
+				},
+				ProximityPlacementGroupReference: &genruntime.ResourceReference{
+					ARMID: "ppg ID",
+				},
+				CapacityReservationGroupReference: &genruntime.ResourceReference{
+					ARMID: "crg ID",
+				},
+				HostGroupReference: &genruntime.ResourceReference{
+					ARMID: "host group ID",
+				},
 				LinuxOSConfig: &asocontainerservicev1.LinuxOSConfig{
 					Sysctls: &asocontainerservicev1.SysctlConfig{
 						FsNrOpen: ptr.To(6),
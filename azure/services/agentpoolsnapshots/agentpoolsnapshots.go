@@ -0,0 +1,112 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentpoolsnapshots
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+const serviceName = "agentpoolsnapshots"
+
+// AgentPoolSnapshotScope defines the scope interface for an AKS node pool snapshots service.
+type AgentPoolSnapshotScope interface {
+	azure.Authorizer
+	azure.AsyncStatusUpdater
+	AgentPoolSnapshotSpec() azure.ResourceSpecGetter
+	SetAgentPoolSnapshotStatus(*infrav1.AgentPoolSnapshotStatus)
+}
+
+// Service provides operations on AKS node pool snapshots.
+type Service struct {
+	Scope AgentPoolSnapshotScope
+	async.Reconciler
+}
+
+// New creates a new AKS node pool snapshots service.
+func New(scope AgentPoolSnapshotScope) (*Service, error) {
+	client, err := newClient(scope)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		Scope: scope,
+		Reconciler: async.New[armcontainerservice.SnapshotsClientCreateOrUpdateResponse,
+			armcontainerservice.SnapshotsClientDeleteResponse](scope, client, client),
+	}, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return serviceName
+}
+
+// Reconcile idempotently creates an AKS node pool snapshot of the agent pool.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "agentpoolsnapshots.Service.Reconcile")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	defer cancel()
+
+	snapshotSpec := s.Scope.AgentPoolSnapshotSpec()
+	if snapshotSpec == nil {
+		log.V(2).Info("skip creation when no agent pool snapshot spec is found")
+		return nil
+	}
+
+	result, err := s.CreateOrUpdateResource(ctx, snapshotSpec, serviceName)
+	if err == nil && result != nil {
+		snapshot, ok := result.(armcontainerservice.Snapshot)
+		if !ok {
+			err = errors.Errorf("%T is not an armcontainerservice.Snapshot", result)
+		} else {
+			s.Scope.SetAgentPoolSnapshotStatus(snapshotStatus(snapshot))
+		}
+	}
+
+	s.Scope.UpdatePutStatus(infrav1.AgentPoolSnapshotReadyCondition, serviceName, err)
+	return err
+}
+
+// Delete is a no-op as snapshots are retained after the agent pool they were taken from is deleted,
+// so that new agent pools can still be created from them.
+func (s *Service) Delete(ctx context.Context) error {
+	_, log, done := tele.StartSpanWithLogger(ctx, "agentpoolsnapshots.Service.Delete")
+	defer done()
+
+	log.V(2).Info("skip deletion of agent pool snapshots, snapshots are retained")
+	return nil
+}
+
+func snapshotStatus(snapshot armcontainerservice.Snapshot) *infrav1.AgentPoolSnapshotStatus {
+	status := &infrav1.AgentPoolSnapshotStatus{
+		ID: ptr.Deref(snapshot.ID, ""),
+	}
+	if snapshot.Properties != nil {
+		status.KubernetesVersion = ptr.Deref(snapshot.Properties.KubernetesVersion, "")
+		status.NodeImageVersion = ptr.Deref(snapshot.Properties.NodeImageVersion, "")
+	}
+	return status
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentpoolsnapshots
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/agentpoolsnapshots/mock_agentpoolsnapshots"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+var fakeSnapshotSpec = AgentPoolSnapshotSpec{
+	Name:          "test-snapshot",
+	ResourceGroup: "test-rg",
+	Location:      "test-location",
+	AgentPoolID:   "/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.ContainerService/managedClusters/test-cluster/agentPools/pool0",
+}
+
+func TestReconcileAgentPoolSnapshots(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_agentpoolsnapshots.MockAgentPoolSnapshotScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no snapshot spec is found",
+			expectedError: "",
+			expect: func(s *mock_agentpoolsnapshots.MockAgentPoolSnapshotScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AgentPoolSnapshotSpec().Return(nil)
+			},
+		},
+		{
+			name:          "create snapshot and set status",
+			expectedError: "",
+			expect: func(s *mock_agentpoolsnapshots.MockAgentPoolSnapshotScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AgentPoolSnapshotSpec().Return(&fakeSnapshotSpec)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeSnapshotSpec, serviceName).Return(armcontainerservice.Snapshot{
+					ID: ptr.To("snapshot-id"),
+					Properties: &armcontainerservice.SnapshotProperties{
+						KubernetesVersion: ptr.To("1.29.2"),
+						NodeImageVersion:  ptr.To("AKSUbuntu-2204gen2containerd-202403.25.0"),
+					},
+				}, nil)
+				s.SetAgentPoolSnapshotStatus(&infrav1.AgentPoolSnapshotStatus{
+					ID:                "snapshot-id",
+					KubernetesVersion: "1.29.2",
+					NodeImageVersion:  "AKSUbuntu-2204gen2containerd-202403.25.0",
+				})
+				s.UpdatePutStatus(infrav1.AgentPoolSnapshotReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "error creating snapshot",
+			expectedError: "some error",
+			expect: func(s *mock_agentpoolsnapshots.MockAgentPoolSnapshotScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.AgentPoolSnapshotSpec().Return(&fakeSnapshotSpec)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeSnapshotSpec, serviceName).Return(nil, errors.New("some error"))
+				s.UpdatePutStatus(infrav1.AgentPoolSnapshotReadyCondition, serviceName, gomockinternal.ErrStrEq("some error"))
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_agentpoolsnapshots.NewMockAgentPoolSnapshotScope(mockCtrl)
+			asyncMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), asyncMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: asyncMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
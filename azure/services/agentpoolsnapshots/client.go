@@ -0,0 +1,92 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentpoolsnapshots
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// azureClient contains the Azure go-sdk Client.
+type azureClient struct {
+	snapshots *armcontainerservice.SnapshotsClient
+}
+
+// newClient creates a new AKS node pool snapshots client from an authorizer.
+func newClient(auth azure.Authorizer) (*azureClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create agentpoolsnapshots client options")
+	}
+	factory, err := armcontainerservice.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armcontainerservice client factory")
+	}
+	return &azureClient{factory.NewSnapshotsClient()}, nil
+}
+
+// Get gets an AKS node pool snapshot.
+func (ac *azureClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (result interface{}, err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "agentpoolsnapshots.azureClient.Get")
+	defer done()
+
+	resp, err := ac.snapshots.Get(ctx, spec.ResourceGroupName(), spec.ResourceName(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Snapshot, nil
+}
+
+// CreateOrUpdateAsync creates an AKS node pool snapshot.
+// Snapshots are created synchronously by the API, so this func will never return a poller.
+func (ac *azureClient) CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, _resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armcontainerservice.SnapshotsClientCreateOrUpdateResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "agentpoolsnapshots.azureClient.CreateOrUpdateAsync")
+	defer done()
+
+	snapshot, ok := parameters.(armcontainerservice.Snapshot)
+	if !ok && parameters != nil {
+		return nil, nil, errors.Errorf("%T is not an armcontainerservice.Snapshot", parameters)
+	}
+
+	resp, err := ac.snapshots.CreateOrUpdate(ctx, spec.ResourceGroupName(), spec.ResourceName(), snapshot, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// if the operation completed, return a nil poller
+	return resp.Snapshot, nil, err
+}
+
+// DeleteAsync deletes an AKS node pool snapshot.
+// Snapshots are deleted synchronously by the API, so this func will never return a poller.
+func (ac *azureClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecGetter, _resumeToken string) (poller *runtime.Poller[armcontainerservice.SnapshotsClientDeleteResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "agentpoolsnapshots.azureClient.DeleteAsync")
+	defer done()
+
+	_, err = ac.snapshots.Delete(ctx, spec.ResourceGroupName(), spec.ResourceName(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// if the operation completed, return a nil poller.
+	return nil, err
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentpoolsnapshots
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
+)
+
+// AgentPoolSnapshotSpec defines the specification for an AKS node pool snapshot.
+type AgentPoolSnapshotSpec struct {
+	Name           string
+	ResourceGroup  string
+	Location       string
+	AgentPoolID    string
+	AdditionalTags infrav1.Tags
+}
+
+// ResourceName returns the name of the snapshot.
+func (s *AgentPoolSnapshotSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group.
+func (s *AgentPoolSnapshotSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName is a no-op for snapshots.
+func (s *AgentPoolSnapshotSpec) OwnerResourceName() string {
+	return ""
+}
+
+// Parameters returns the parameters for the snapshot.
+func (s *AgentPoolSnapshotSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	if existing != nil {
+		if _, ok := existing.(armcontainerservice.Snapshot); !ok {
+			return nil, errors.Errorf("%T is not an armcontainerservice.Snapshot", existing)
+		}
+		// snapshots are immutable once taken
+		return nil, nil
+	}
+
+	return armcontainerservice.Snapshot{
+		Location: ptr.To(s.Location),
+		Properties: &armcontainerservice.SnapshotProperties{
+			CreationData: &armcontainerservice.CreationData{
+				SourceResourceID: ptr.To(s.AgentPoolID),
+			},
+			SnapshotType: ptr.To(armcontainerservice.SnapshotTypeNodePool),
+		},
+		Tags: converters.TagsToMap(s.AdditionalTags),
+	}, nil
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package agentpoolsnapshots
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/containerservice/armcontainerservice/v4"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func TestParameters(t *testing.T) {
+	testcases := []struct {
+		name     string
+		spec     *AgentPoolSnapshotSpec
+		existing interface{}
+		expected interface{}
+	}{
+		{
+			name:     "snapshot already exists",
+			spec:     &fakeSnapshotSpec,
+			existing: armcontainerservice.Snapshot{},
+			expected: nil,
+		},
+		{
+			name: "new snapshot",
+			spec: &AgentPoolSnapshotSpec{
+				Name:           "test-snapshot",
+				ResourceGroup:  "test-rg",
+				Location:       "test-location",
+				AgentPoolID:    "agent-pool-id",
+				AdditionalTags: map[string]string{"foo": "bar"},
+			},
+			existing: nil,
+			expected: armcontainerservice.Snapshot{
+				Location: ptr.To("test-location"),
+				Properties: &armcontainerservice.SnapshotProperties{
+					CreationData: &armcontainerservice.CreationData{
+						SourceResourceID: ptr.To("agent-pool-id"),
+					},
+					SnapshotType: ptr.To(armcontainerservice.SnapshotTypeNodePool),
+				},
+				Tags: map[string]*string{"foo": ptr.To("bar")},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expected == nil {
+				g.Expect(result).To(BeNil())
+			} else {
+				g.Expect(result).To(Equal(tc.expected))
+			}
+		})
+	}
+}
@@ -0,0 +1,117 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationsecuritygroups
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+const serviceName = "applicationsecuritygroups"
+
+// ApplicationSecurityGroupScope defines the scope interface for an application security groups service.
+type ApplicationSecurityGroupScope interface {
+	azure.Authorizer
+	azure.AsyncStatusUpdater
+	ApplicationSecurityGroupSpecs() []azure.ResourceSpecGetter
+}
+
+// Service provides operations on Azure resources.
+type Service struct {
+	Scope ApplicationSecurityGroupScope
+	async.Reconciler
+}
+
+// New creates a new service.
+func New(scope ApplicationSecurityGroupScope) (*Service, error) {
+	client, err := newClient(scope, scope.DefaultedAzureCallTimeout())
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		Scope: scope,
+		Reconciler: async.New[armnetwork.ApplicationSecurityGroupsClientCreateOrUpdateResponse,
+			armnetwork.ApplicationSecurityGroupsClientDeleteResponse](scope, client, client),
+	}, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return serviceName
+}
+
+// Reconcile idempotently creates or updates a set of application security groups.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "applicationsecuritygroups.Service.Reconcile")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	defer cancel()
+
+	specs := s.Scope.ApplicationSecurityGroupSpecs()
+	if len(specs) == 0 {
+		return nil
+	}
+
+	// We go through the list of application security groups to reconcile each one, independently of the result of the previous one.
+	// If multiple errors occur, we return the most pressing one.
+	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
+	var resErr error
+	for _, asgSpec := range specs {
+		if _, err := s.CreateOrUpdateResource(ctx, asgSpec, serviceName); err != nil {
+			if !azure.IsOperationNotDoneError(err) || resErr == nil {
+				resErr = err
+			}
+		}
+	}
+
+	s.Scope.UpdatePutStatus(infrav1.ApplicationSecurityGroupsReadyCondition, serviceName, resErr)
+	return resErr
+}
+
+// Delete deletes application security groups.
+func (s *Service) Delete(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "applicationsecuritygroups.Service.Delete")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	defer cancel()
+
+	specs := s.Scope.ApplicationSecurityGroupSpecs()
+	if len(specs) == 0 {
+		return nil
+	}
+
+	// We go through the list of ApplicationSecurityGroupSpecs to delete each one, independently of the result of the previous one.
+	// If multiple errors occur, we return the most pressing one
+	// order of precedence is: error deleting -> deleting in progress -> deleted (no error)
+	var result error
+	for _, asgSpec := range specs {
+		if err := s.DeleteResource(ctx, asgSpec, serviceName); err != nil {
+			if !azure.IsOperationNotDoneError(err) || result == nil {
+				result = err
+			}
+		}
+	}
+	s.Scope.UpdateDeleteStatus(infrav1.ApplicationSecurityGroupsReadyCondition, serviceName, result)
+	return result
+}
@@ -0,0 +1,206 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationsecuritygroups
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/applicationsecuritygroups/mock_applicationsecuritygroups"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+var (
+	fakeASG = ApplicationSecurityGroupSpec{
+		Name:          "test-asg-control-plane",
+		ResourceGroup: "test-rg",
+		Location:      "fake-location",
+		ClusterName:   "test-cluster",
+		AdditionalTags: map[string]string{
+			"foo": "bar",
+		},
+	}
+	fakeASG2 = ApplicationSecurityGroupSpec{
+		Name:          "test-asg-node",
+		ResourceGroup: "test-rg",
+		Location:      "fake-location",
+		ClusterName:   "test-cluster",
+	}
+	errFake      = errors.New("this is an error")
+	notDoneError = azure.NewOperationNotDoneError(&infrav1.Future{})
+)
+
+func TestReconcileApplicationSecurityGroups(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_applicationsecuritygroups.MockApplicationSecurityGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no application security group specs are found",
+			expectedError: "",
+			expect: func(s *mock_applicationsecuritygroups.MockApplicationSecurityGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ApplicationSecurityGroupSpecs().Return([]azure.ResourceSpecGetter{})
+			},
+		},
+		{
+			name:          "create multiple application security groups succeeds",
+			expectedError: "",
+			expect: func(s *mock_applicationsecuritygroups.MockApplicationSecurityGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ApplicationSecurityGroupSpecs().Return([]azure.ResourceSpecGetter{&fakeASG, &fakeASG2})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeASG, serviceName).Return(nil, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeASG2, serviceName).Return(nil, nil)
+				s.UpdatePutStatus(infrav1.ApplicationSecurityGroupsReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "first application security group create fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_applicationsecuritygroups.MockApplicationSecurityGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ApplicationSecurityGroupSpecs().Return([]azure.ResourceSpecGetter{&fakeASG, &fakeASG2})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeASG, serviceName).Return(nil, errFake)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeASG2, serviceName).Return(nil, nil)
+				s.UpdatePutStatus(infrav1.ApplicationSecurityGroupsReadyCondition, serviceName, errFake)
+			},
+		},
+		{
+			name:          "second application security group create not done",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_applicationsecuritygroups.MockApplicationSecurityGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ApplicationSecurityGroupSpecs().Return([]azure.ResourceSpecGetter{&fakeASG, &fakeASG2})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeASG, serviceName).Return(nil, errFake)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeASG2, serviceName).Return(nil, notDoneError)
+				s.UpdatePutStatus(infrav1.ApplicationSecurityGroupsReadyCondition, serviceName, errFake)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_applicationsecuritygroups.NewMockApplicationSecurityGroupScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestDeleteApplicationSecurityGroups(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_applicationsecuritygroups.MockApplicationSecurityGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no application security group specs are found",
+			expectedError: "",
+			expect: func(s *mock_applicationsecuritygroups.MockApplicationSecurityGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ApplicationSecurityGroupSpecs().Return([]azure.ResourceSpecGetter{})
+			},
+		},
+		{
+			name:          "delete multiple application security groups succeeds",
+			expectedError: "",
+			expect: func(s *mock_applicationsecuritygroups.MockApplicationSecurityGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ApplicationSecurityGroupSpecs().Return([]azure.ResourceSpecGetter{&fakeASG, &fakeASG2})
+				r.DeleteResource(gomockinternal.AContext(), &fakeASG, serviceName).Return(nil)
+				r.DeleteResource(gomockinternal.AContext(), &fakeASG2, serviceName).Return(nil)
+				s.UpdateDeleteStatus(infrav1.ApplicationSecurityGroupsReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "first application security group delete fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_applicationsecuritygroups.MockApplicationSecurityGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ApplicationSecurityGroupSpecs().Return([]azure.ResourceSpecGetter{&fakeASG, &fakeASG2})
+				r.DeleteResource(gomockinternal.AContext(), &fakeASG, serviceName).Return(errFake)
+				r.DeleteResource(gomockinternal.AContext(), &fakeASG2, serviceName).Return(nil)
+				s.UpdateDeleteStatus(infrav1.ApplicationSecurityGroupsReadyCondition, serviceName, errFake)
+			},
+		},
+		{
+			name:          "second application security group delete not done",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_applicationsecuritygroups.MockApplicationSecurityGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ApplicationSecurityGroupSpecs().Return([]azure.ResourceSpecGetter{&fakeASG, &fakeASG2})
+				r.DeleteResource(gomockinternal.AContext(), &fakeASG, serviceName).Return(errFake)
+				r.DeleteResource(gomockinternal.AContext(), &fakeASG2, serviceName).Return(notDoneError)
+				s.UpdateDeleteStatus(infrav1.ApplicationSecurityGroupsReadyCondition, serviceName, errFake)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_applicationsecuritygroups.NewMockApplicationSecurityGroupScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
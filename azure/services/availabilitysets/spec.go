@@ -36,6 +36,8 @@ type AvailabilitySetSpec struct {
 	Location       string
 	SKU            *resourceskus.SKU
 	AdditionalTags infrav1.Tags
+	// ProximityPlacementGroupID is the ID of the proximity placement group the availability set is created in, if any.
+	ProximityPlacementGroupID string
 }
 
 // ResourceName returns the name of the availability set.
@@ -94,6 +96,9 @@ func (s *AvailabilitySetSpec) Parameters(ctx context.Context, existing interface
 		})),
 		Location: ptr.To(s.Location),
 	}
+	if s.ProximityPlacementGroupID != "" {
+		asParams.Properties.ProximityPlacementGroup = &armcompute.SubResource{ID: ptr.To(s.ProximityPlacementGroupID)}
+	}
 
 	return asParams, nil
 }
@@ -73,6 +73,26 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "get parameters with a proximity placement group",
+			spec: &AvailabilitySetSpec{
+				Name:                      "test-as",
+				ResourceGroup:             "test-rg",
+				ClusterName:               "test-cluster",
+				Location:                  "test-location",
+				SKU:                       &fakeSku,
+				AdditionalTags:            map[string]string{},
+				ProximityPlacementGroupID: "/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.Compute/proximityPlacementGroups/test-ppg",
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.AvailabilitySet{}))
+				g.Expect(result.(armcompute.AvailabilitySet).Properties.ProximityPlacementGroup).To(Equal(&armcompute.SubResource{
+					ID: ptr.To("/subscriptions/123/resourceGroups/test-rg/providers/Microsoft.Compute/proximityPlacementGroups/test-ppg"),
+				}))
+			},
+			expectedError: "",
+		},
 	}
 	for _, tc := range testcases {
 		tc := tc
@@ -0,0 +1,157 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapdata
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+const serviceName = "bootstrapdata"
+
+// BootstrapDataScope defines the scope interface for a bootstrap data service.
+type BootstrapDataScope interface {
+	azure.Authorizer
+	BootstrapDataSpec() *BootstrapDataSpec
+	GetBootstrapDataValue(ctx context.Context) ([]byte, error)
+	StoredBootstrapDataHash() string
+	SetStoredBootstrapDataHash(hash string)
+	IsBootstrapSucceeded() bool
+}
+
+// Service stores the bootstrap data of machines that don't receive it as custom data, and deletes it once it is
+// no longer needed.
+type Service struct {
+	Scope     BootstrapDataScope
+	newClient func(azure.Authorizer, *BootstrapDataSpec) (client, error)
+}
+
+// New creates a new bootstrap data service.
+func New(scope BootstrapDataScope) *Service {
+	return &Service{
+		Scope:     scope,
+		newClient: newClient,
+	}
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return serviceName
+}
+
+// Reconcile stores the bootstrap data whenever it changes, and deletes it once the machine has bootstrapped
+// successfully. The stored hash is cleared after the deletion so it only runs once.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "bootstrapdata.Service.Reconcile")
+	defer done()
+
+	spec := s.Scope.BootstrapDataSpec()
+	if spec == nil {
+		return nil
+	}
+	if s.Scope.IsBootstrapSucceeded() {
+		if s.Scope.StoredBootstrapDataHash() == "" {
+			// nothing stored, or already deleted
+			return nil
+		}
+		if err := s.Delete(ctx); err != nil {
+			return err
+		}
+		s.Scope.SetStoredBootstrapDataHash("")
+		return nil
+	}
+
+	data, err := s.Scope.GetBootstrapDataValue(ctx)
+	if err != nil {
+		return err
+	}
+	hash := fmt.Sprintf("%x", sha256.Sum256(data))
+	if hash == s.Scope.StoredBootstrapDataHash() {
+		return nil
+	}
+	if err := s.store(ctx, spec, data); err != nil {
+		return errors.Wrapf(err, "failed to store bootstrap data %s", spec.Name)
+	}
+	s.Scope.SetStoredBootstrapDataHash(hash)
+	return nil
+}
+
+// Delete deletes the stored bootstrap data, if any.
+func (s *Service) Delete(ctx context.Context) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "bootstrapdata.Service.Delete")
+	defer done()
+
+	spec := s.Scope.BootstrapDataSpec()
+	if spec == nil {
+		return nil
+	}
+
+	c, err := s.newClient(s.Scope, spec)
+	if err != nil {
+		return err
+	}
+	log.V(2).Info("deleting bootstrap data", "transport", spec.Transport, "name", spec.Name)
+	return c.Delete(ctx, spec)
+}
+
+// store compresses and stores the bootstrap data so the VM can fetch it during boot.
+func (s *Service) store(ctx context.Context, spec *BootstrapDataSpec, data []byte) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "bootstrapdata.Service.store")
+	defer done()
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	if _, err := zw.Write(data); err != nil {
+		return errors.Wrap(err, "failed to compress bootstrap data")
+	}
+	if err := zw.Close(); err != nil {
+		return errors.Wrap(err, "failed to compress bootstrap data")
+	}
+
+	c, err := s.newClient(s.Scope, spec)
+	if err != nil {
+		return err
+	}
+	log.V(2).Info("storing bootstrap data", "transport", spec.Transport, "name", spec.Name, "size", compressed.Len())
+	return c.Put(ctx, spec, compressed.Bytes())
+}
+
+// Loader returns the cloud-init user data that fetches the stored bootstrap data. It is passed to the VM as custom
+// data instead of the bootstrap data itself.
+func (s *Service) Loader() ([]byte, error) {
+	spec := s.Scope.BootstrapDataSpec()
+	if spec == nil {
+		return nil, errors.New("no bootstrap data transport is configured")
+	}
+
+	env, err := environment(s.Scope.CloudEnvironment())
+	if err != nil {
+		return nil, err
+	}
+	urls, err := spec.endpoints(env)
+	if err != nil {
+		return nil, err
+	}
+	return loader(spec, urls)
+}
@@ -0,0 +1,290 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapdata
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+)
+
+var (
+	blobSpec = BootstrapDataSpec{
+		Transport:          infrav1.BootstrapTransportStorageBlob,
+		StorageAccountName: "mystorageaccount",
+		ContainerName:      "my-cluster",
+		Name:               "my-vm-1234",
+	}
+
+	secretSpec = BootstrapDataSpec{
+		Transport:          infrav1.BootstrapTransportKeyVaultSecret,
+		VaultName:          "my-vault",
+		Name:               "my-vm-1234",
+		IdentityResourceID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity",
+	}
+
+	errFake = errors.New("fake error")
+
+	// cloudConfigHash is the sha256 hash of "#cloud-config\n".
+	cloudConfigHash = fmt.Sprintf("%x", sha256.Sum256([]byte("#cloud-config\n")))
+)
+
+// fakeClient records the bootstrap data stored and deleted through it.
+type fakeClient struct {
+	data    []byte
+	deleted bool
+	err     error
+}
+
+func (f *fakeClient) Put(_ context.Context, _ *BootstrapDataSpec, data []byte) error {
+	f.data = data
+	return f.err
+}
+
+func (f *fakeClient) Delete(_ context.Context, _ *BootstrapDataSpec) error {
+	f.deleted = true
+	return f.err
+}
+
+// fakeScope implements BootstrapDataScope. The embedded Authorizer is only there to satisfy the interface.
+type fakeScope struct {
+	azure.Authorizer
+	spec             *BootstrapDataSpec
+	data             []byte
+	hash             string
+	succeeded        bool
+	cloudEnvironment string
+}
+
+func (f *fakeScope) BootstrapDataSpec() *BootstrapDataSpec                 { return f.spec }
+func (f *fakeScope) GetBootstrapDataValue(context.Context) ([]byte, error) { return f.data, nil }
+func (f *fakeScope) StoredBootstrapDataHash() string                       { return f.hash }
+func (f *fakeScope) SetStoredBootstrapDataHash(hash string)                { f.hash = hash }
+func (f *fakeScope) IsBootstrapSucceeded() bool                            { return f.succeeded }
+func (f *fakeScope) CloudEnvironment() string                              { return f.cloudEnvironment }
+
+func newFakeService(scope BootstrapDataScope, c *fakeClient) *Service {
+	return &Service{
+		Scope: scope,
+		newClient: func(azure.Authorizer, *BootstrapDataSpec) (client, error) {
+			return c, nil
+		},
+	}
+}
+
+func TestReconcileBootstrapData(t *testing.T) {
+	testcases := []struct {
+		name          string
+		scope         *fakeScope
+		clientErr     error
+		expectStored  string
+		expectHash    string
+		expectDeleted bool
+		expectedError string
+	}{
+		{
+			name:  "noop if the bootstrap data is passed as custom data",
+			scope: &fakeScope{succeeded: true, spec: nil, data: []byte("#cloud-config\n")},
+		},
+		{
+			name:         "store the bootstrap data until the machine has bootstrapped",
+			scope:        &fakeScope{spec: &blobSpec, data: []byte("#cloud-config\n")},
+			expectStored: "#cloud-config\n",
+			expectHash:   cloudConfigHash,
+		},
+		{
+			name:       "noop if the bootstrap data didn't change since it was stored",
+			scope:      &fakeScope{spec: &blobSpec, data: []byte("#cloud-config\n"), hash: cloudConfigHash},
+			expectHash: cloudConfigHash,
+		},
+		{
+			name:         "store the bootstrap data again when it changes",
+			scope:        &fakeScope{spec: &secretSpec, data: []byte("#cloud-config\n"), hash: "old-hash"},
+			expectStored: "#cloud-config\n",
+			expectHash:   cloudConfigHash,
+		},
+		{
+			name:          "error storing the bootstrap data",
+			scope:         &fakeScope{spec: &blobSpec, data: []byte("#cloud-config\n")},
+			clientErr:     errFake,
+			expectStored:  "#cloud-config\n",
+			expectedError: "failed to store bootstrap data my-vm-1234: fake error",
+		},
+		{
+			name:          "delete the bootstrap data once the machine has bootstrapped",
+			scope:         &fakeScope{succeeded: true, spec: &blobSpec, hash: cloudConfigHash},
+			expectDeleted: true,
+		},
+		{
+			name:  "noop if the bootstrap data was already deleted",
+			scope: &fakeScope{succeeded: true, spec: &blobSpec},
+		},
+		{
+			name:          "error deleting the bootstrap data",
+			scope:         &fakeScope{succeeded: true, spec: &secretSpec, hash: cloudConfigHash},
+			clientErr:     errFake,
+			expectHash:    cloudConfigHash,
+			expectDeleted: true,
+			expectedError: "fake error",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			c := &fakeClient{err: tc.clientErr}
+			err := newFakeService(tc.scope, c).Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			g.Expect(c.deleted).To(Equal(tc.expectDeleted))
+			g.Expect(tc.scope.hash).To(Equal(tc.expectHash))
+			if tc.expectStored == "" {
+				g.Expect(c.data).To(BeNil())
+				return
+			}
+			zr, err := gzip.NewReader(bytes.NewReader(c.data))
+			g.Expect(err).NotTo(HaveOccurred())
+			data, err := io.ReadAll(zr)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(string(data)).To(Equal(tc.expectStored))
+		})
+	}
+}
+
+func TestLoader(t *testing.T) {
+	testcases := []struct {
+		name             string
+		spec             *BootstrapDataSpec
+		cloudEnvironment string
+		expectedFetch    []string
+		notExpected      []string
+	}{
+		{
+			name:             "storage blob with system-assigned identity",
+			spec:             &blobSpec,
+			cloudEnvironment: azure.PublicCloudName,
+			expectedFetch: []string{
+				`"http://169.254.169.254/metadata/identity/oauth2/token?api-version=2018-02-01&resource=https%3A%2F%2Fstorage.azure.com%2F"`,
+				`-H "x-ms-version: 2020-04-08" "https://mystorageaccount.blob.core.windows.net/my-cluster/my-vm-1234" | gunzip`,
+			},
+			notExpected: []string{"msi_res_id", "base64 -d"},
+		},
+		{
+			name:             "key vault secret with user-assigned identity in another cloud",
+			spec:             &secretSpec,
+			cloudEnvironment: azure.ChinaCloudName,
+			expectedFetch: []string{
+				"msi_res_id=%2Fsubscriptions%2F123%2FresourceGroups%2Fmy-rg%2Fproviders%2FMicrosoft.ManagedIdentity%2FuserAssignedIdentities%2Fmy-identity",
+				"resource=https%3A%2F%2Fvault.azure.cn",
+				`"https://my-vault.vault.azure.cn/secrets/my-vm-1234?api-version=7.4" | sed -n 's/.*"value":"\([^"]*\)".*/\1/p' | base64 -d | gunzip`,
+			},
+			notExpected: []string{"x-ms-version"},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			scope := &fakeScope{spec: tc.spec, cloudEnvironment: tc.cloudEnvironment}
+			result, err := New(scope).Loader()
+			g.Expect(err).NotTo(HaveOccurred())
+			expectLoaderBoothook(g, result, tc.expectedFetch)
+			for _, e := range tc.notExpected {
+				g.Expect(string(result)).NotTo(ContainSubstring(e))
+			}
+		})
+	}
+}
+
+// expectLoaderBoothook checks that the user data rendered by the loader is a single boothook, which fetches the
+// bootstrap data before handing it to cloud-init.
+func expectLoaderBoothook(g Gomega, userData []byte, expectedFetch []string) {
+	msg, err := mail.ReadMessage(bytes.NewReader(userData))
+	g.Expect(err).NotTo(HaveOccurred())
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(mediaType).To(Equal("multipart/mixed"))
+
+	var contentTypes, contents []string
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		part, err := mr.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		g.Expect(err).NotTo(HaveOccurred())
+		content, err := io.ReadAll(part)
+		g.Expect(err).NotTo(HaveOccurred())
+		contentTypes = append(contentTypes, part.Header.Get("Content-Type"))
+		contents = append(contents, string(content))
+	}
+	g.Expect(contentTypes).To(Equal([]string{"text/cloud-boothook"}))
+
+	boothook := contents[0]
+	g.Expect(boothook).To(HavePrefix("#!/bin/sh\n"))
+	g.Expect(boothook).NotTo(ContainSubstring("#include"))
+	for _, e := range expectedFetch {
+		g.Expect(boothook).To(ContainSubstring(e))
+	}
+	fetch := strings.Index(boothook, expectedFetch[len(expectedFetch)-1])
+	for _, handOff := range []string{
+		`cp "$BOOTSTRAP_DATA.tmp" "/etc/cloud/cloud.cfg.d/99-capz-bootstrap-data.cfg"`,
+		`cp "$BOOTSTRAP_DATA.tmp" "/var/lib/cloud/scripts/per-instance/capz-bootstrap-data"`,
+	} {
+		g.Expect(strings.Index(boothook, handOff)).To(BeNumerically(">", fetch))
+	}
+}
+
+func TestDeleteBootstrapData(t *testing.T) {
+	g := NewWithT(t)
+	scope := &fakeScope{spec: &secretSpec}
+
+	c := &fakeClient{}
+	g.Expect(newFakeService(scope, c).Delete(context.TODO())).To(Succeed())
+	g.Expect(c.deleted).To(BeTrue())
+}
+
+func TestUserDataLoader(t *testing.T) {
+	g := NewWithT(t)
+	result, err := UserDataLoader()
+	g.Expect(err).NotTo(HaveOccurred())
+	expectLoaderBoothook(g, result, []string{
+		`curl -sSf -H Metadata:true "http://169.254.169.254/metadata/instance/compute/userData?api-version=2021-01-01&format=text" | base64 -d`,
+	})
+	g.Expect(string(result)).NotTo(ContainSubstring("access_token"))
+	g.Expect(string(result)).NotTo(ContainSubstring("gunzip"))
+}
@@ -0,0 +1,129 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapdata
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/security/keyvault/azsecrets"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/bloberror"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// client stores the bootstrap data of a machine in Azure. The data passed to it is already compressed.
+type client interface {
+	Put(ctx context.Context, spec *BootstrapDataSpec, data []byte) error
+	Delete(ctx context.Context, spec *BootstrapDataSpec) error
+}
+
+// newClient creates a client for the bootstrap transport of the spec.
+func newClient(auth azure.Authorizer, spec *BootstrapDataSpec) (client, error) {
+	env, err := environment(auth.CloudEnvironment())
+	if err != nil {
+		return nil, err
+	}
+	urls, err := spec.endpoints(env)
+	if err != nil {
+		return nil, err
+	}
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create bootstrap data client options")
+	}
+
+	switch spec.Transport {
+	case infrav1.BootstrapTransportStorageBlob:
+		blobs, err := azblob.NewClient(urls.ServiceURL, auth.Token(), &azblob.ClientOptions{ClientOptions: opts.ClientOptions})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create blob client")
+		}
+		return &blobClient{blobs}, nil
+	default:
+		secrets, err := azsecrets.NewClient(urls.ServiceURL, auth.Token(), &azsecrets.ClientOptions{ClientOptions: opts.ClientOptions})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create secrets client")
+		}
+		return &secretClient{secrets}, nil
+	}
+}
+
+// blobClient stores bootstrap data in Azure Storage blobs.
+type blobClient struct {
+	blobs *azblob.Client
+}
+
+// Put uploads the bootstrap data to a blob, creating its container if needed.
+func (bc *blobClient) Put(ctx context.Context, spec *BootstrapDataSpec, data []byte) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "bootstrapdata.blobClient.Put")
+	defer done()
+
+	if _, err := bc.blobs.CreateContainer(ctx, spec.ContainerName, nil); err != nil && !bloberror.HasCode(err, bloberror.ContainerAlreadyExists) {
+		return errors.Wrapf(err, "failed to create blob container %s", spec.ContainerName)
+	}
+	if _, err := bc.blobs.UploadBuffer(ctx, spec.ContainerName, spec.Name, data, nil); err != nil {
+		return errors.Wrapf(err, "failed to upload blob %s/%s", spec.ContainerName, spec.Name)
+	}
+	return nil
+}
+
+// Delete deletes the blob holding the bootstrap data, if it exists.
+func (bc *blobClient) Delete(ctx context.Context, spec *BootstrapDataSpec) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "bootstrapdata.blobClient.Delete")
+	defer done()
+
+	if _, err := bc.blobs.DeleteBlob(ctx, spec.ContainerName, spec.Name, nil); err != nil && !bloberror.HasCode(err, bloberror.BlobNotFound, bloberror.ContainerNotFound) {
+		return errors.Wrapf(err, "failed to delete blob %s/%s", spec.ContainerName, spec.Name)
+	}
+	return nil
+}
+
+// secretClient stores bootstrap data in Azure Key Vault secrets.
+type secretClient struct {
+	secrets *azsecrets.Client
+}
+
+// Put stores the base64-encoded bootstrap data in a secret, as secret values must be strings.
+func (sc *secretClient) Put(ctx context.Context, spec *BootstrapDataSpec, data []byte) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "bootstrapdata.secretClient.Put")
+	defer done()
+
+	params := azsecrets.SetSecretParameters{
+		Value:       ptr.To(base64.StdEncoding.EncodeToString(data)),
+		ContentType: ptr.To("application/gzip;base64"),
+	}
+	if _, err := sc.secrets.SetSecret(ctx, spec.Name, params, nil); err != nil {
+		return errors.Wrapf(err, "failed to set secret %s in key vault %s", spec.Name, spec.VaultName)
+	}
+	return nil
+}
+
+// Delete deletes the secret holding the bootstrap data, if it exists.
+func (sc *secretClient) Delete(ctx context.Context, spec *BootstrapDataSpec) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "bootstrapdata.secretClient.Delete")
+	defer done()
+
+	if _, err := sc.secrets.DeleteSecret(ctx, spec.Name, nil); err != nil && !azure.ResourceNotFound(err) {
+		return errors.Wrapf(err, "failed to delete secret %s from key vault %s", spec.Name, spec.VaultName)
+	}
+	return nil
+}
@@ -0,0 +1,135 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapdata
+
+import (
+	"bytes"
+	"net/url"
+	"text/template"
+
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+const (
+	// imdsTokenURL is the Azure Instance Metadata Service endpoint VMs get managed identity tokens from.
+	imdsTokenURL = "http://169.254.169.254/metadata/identity/oauth2/token"
+	// imdsUserDataURL is the Azure Instance Metadata Service endpoint VMs read their base64 encoded user data from.
+	imdsUserDataURL = "http://169.254.169.254/metadata/instance/compute/userData?api-version=2021-01-01&format=text"
+	// bootstrapDataPath is where the loader writes the fetched bootstrap data on the VM.
+	bootstrapDataPath = "/var/lib/capz/bootstrap-data"
+	// cloudConfigPath is where the loader installs bootstrap data that is a cloud-config. cloud-init merges it into
+	// the configuration of the modules it runs after the boothook.
+	cloudConfigPath = "/etc/cloud/cloud.cfg.d/99-capz-bootstrap-data.cfg"
+	// scriptPath is where the loader installs bootstrap data that is a script. cloud-init runs it once in its final
+	// stage.
+	scriptPath = "/var/lib/cloud/scripts/per-instance/capz-bootstrap-data"
+)
+
+// loaderTemplate is a cloud-init user data with a single boothook. cloud-init runs the boothook before any of its
+// modules, so the boothook fetches the bootstrap data and installs it where the later modules pick it up: a
+// cloud-config is added to cloud-init's configuration, and anything else is run as a per-instance script. When a
+// managed identity is needed, it and its role assignments may take a few minutes to become usable after the VM is
+// created, so fetching is retried for up to 10 minutes.
+var loaderTemplate = template.Must(template.New("loader").Parse(`Content-Type: multipart/mixed; boundary="MIMEBOUNDARY"
+MIME-Version: 1.0
+
+--MIMEBOUNDARY
+Content-Transfer-Encoding: 7bit
+Content-Type: text/cloud-boothook
+Mime-Version: 1.0
+
+#!/bin/sh
+BOOTSTRAP_DATA={{ .Path }}
+[ -s "$BOOTSTRAP_DATA" ] && exit 0
+mkdir -p "$(dirname "$BOOTSTRAP_DATA")"
+umask 077
+FETCHED=
+for i in $(seq 1 120); do
+{{- if .UserData }}
+  if curl -sSf -H Metadata:true "{{ .DataURL }}" | base64 -d > "$BOOTSTRAP_DATA.tmp" && [ -s "$BOOTSTRAP_DATA.tmp" ]; then
+{{- else }}
+  TOKEN=$(curl -sSf -H Metadata:true "{{ .TokenURL }}" | sed -n 's/.*"access_token":"\([^"]*\)".*/\1/p')
+  if [ -n "$TOKEN" ] && curl -sSf -H "Authorization: Bearer $TOKEN"{{ if .Blob }} -H "x-ms-version: 2020-04-08"{{ end }} "{{ .DataURL }}"{{ if not .Blob }} | sed -n 's/.*"value":"\([^"]*\)".*/\1/p' | base64 -d{{ end }} | gunzip > "$BOOTSTRAP_DATA.tmp"; then
+{{- end }}
+    FETCHED=true
+    break
+  fi
+  sleep 5
+done
+if [ -z "$FETCHED" ]; then
+  echo "failed to fetch bootstrap data from {{ .DataURL }}" >&2
+  exit 1
+fi
+if head -n 1 "$BOOTSTRAP_DATA.tmp" | grep -q '^#cloud-config'; then
+  cp "$BOOTSTRAP_DATA.tmp" "{{ .CloudConfigPath }}"
+else
+  mkdir -p "$(dirname "{{ .ScriptPath }}")"
+  cp "$BOOTSTRAP_DATA.tmp" "{{ .ScriptPath }}"
+  chmod 700 "{{ .ScriptPath }}"
+fi
+mv "$BOOTSTRAP_DATA.tmp" "$BOOTSTRAP_DATA"
+
+--MIMEBOUNDARY--
+`))
+
+// loader returns the cloud-init user data that fetches the bootstrap data described by the spec.
+func loader(spec *BootstrapDataSpec, urls endpoints) ([]byte, error) {
+	query := url.Values{}
+	query.Set("api-version", "2018-02-01")
+	query.Set("resource", urls.Resource)
+	if spec.IdentityResourceID != "" {
+		query.Set("msi_res_id", spec.IdentityResourceID)
+	}
+
+	return renderLoader(loaderParams{
+		TokenURL: imdsTokenURL + "?" + query.Encode(),
+		DataURL:  urls.DataURL,
+		Blob:     spec.Transport == infrav1.BootstrapTransportStorageBlob,
+	})
+}
+
+// UserDataLoader returns the cloud-init user data that reads the bootstrap data from the VM's user data. It is passed
+// to the VM as custom data, which then never changes when the bootstrap data is updated.
+func UserDataLoader() ([]byte, error) {
+	return renderLoader(loaderParams{
+		DataURL:  imdsUserDataURL,
+		UserData: true,
+	})
+}
+
+// loaderParams are the parameters of the loader template.
+type loaderParams struct {
+	Path            string
+	CloudConfigPath string
+	ScriptPath      string
+	TokenURL        string
+	DataURL         string
+	Blob            bool
+	UserData        bool
+}
+
+func renderLoader(params loaderParams) ([]byte, error) {
+	params.Path = bootstrapDataPath
+	params.CloudConfigPath = cloudConfigPath
+	params.ScriptPath = scriptPath
+	var buf bytes.Buffer
+	if err := loaderTemplate.Execute(&buf, params); err != nil {
+		return nil, errors.Wrap(err, "failed to render bootstrap data loader")
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapdata
+
+import (
+	"fmt"
+	"net/url"
+
+	azureautorest "github.com/Azure/go-autorest/autorest/azure"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+)
+
+// keyVaultAPIVersion is the Key Vault REST API version the loader reads secrets with.
+const keyVaultAPIVersion = "7.4"
+
+// BootstrapDataSpec defines where the bootstrap data of a machine is stored.
+type BootstrapDataSpec struct {
+	Transport infrav1.BootstrapTransportType
+	// StorageAccountName and ContainerName identify the blob container used by the StorageBlob transport.
+	StorageAccountName string
+	ContainerName      string
+	// VaultName identifies the key vault used by the KeyVaultSecret transport.
+	VaultName string
+	// Name is the name of the blob or secret that holds the bootstrap data.
+	Name string
+	// IdentityResourceID is the resource ID of the user-assigned identity the VM fetches its bootstrap data with.
+	// The VM's system-assigned identity is used if it is empty.
+	IdentityResourceID string
+}
+
+// endpoints are the URLs the bootstrap data is stored at and fetched from for a given Azure environment.
+type endpoints struct {
+	// ServiceURL is the URL of the storage account or key vault.
+	ServiceURL string
+	// DataURL is the URL the VM fetches the bootstrap data from.
+	DataURL string
+	// Resource is the resource the VM requests its managed identity token for.
+	Resource string
+}
+
+// environment returns the Azure environment with the given name, defaulting to the public cloud.
+func environment(cloudEnvironment string) (azureautorest.Environment, error) {
+	if cloudEnvironment == "" {
+		return azureautorest.PublicCloud, nil
+	}
+	env, err := azureautorest.EnvironmentFromName(cloudEnvironment)
+	if err != nil {
+		return azureautorest.Environment{}, errors.Wrapf(err, "failed to get Azure environment %s", cloudEnvironment)
+	}
+	return env, nil
+}
+
+// endpoints returns the URLs of the bootstrap data in the given Azure environment.
+func (s *BootstrapDataSpec) endpoints(env azureautorest.Environment) (endpoints, error) {
+	switch s.Transport {
+	case infrav1.BootstrapTransportStorageBlob:
+		serviceURL := fmt.Sprintf("https://%s.blob.%s", s.StorageAccountName, env.StorageEndpointSuffix)
+		return endpoints{
+			ServiceURL: serviceURL,
+			DataURL:    fmt.Sprintf("%s/%s/%s", serviceURL, s.ContainerName, url.PathEscape(s.Name)),
+			Resource:   env.ResourceIdentifiers.Storage,
+		}, nil
+	case infrav1.BootstrapTransportKeyVaultSecret:
+		serviceURL := fmt.Sprintf("https://%s.%s", s.VaultName, env.KeyVaultDNSSuffix)
+		return endpoints{
+			ServiceURL: serviceURL,
+			DataURL:    fmt.Sprintf("%s/secrets/%s?api-version=%s", serviceURL, url.PathEscape(s.Name), keyVaultAPIVersion),
+			Resource:   env.ResourceIdentifiers.KeyVault,
+		}, nil
+	default:
+		return endpoints{}, errors.Errorf("unsupported bootstrap transport %q", s.Transport)
+	}
+}
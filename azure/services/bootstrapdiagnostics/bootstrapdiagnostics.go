@@ -0,0 +1,148 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapdiagnostics
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+const (
+	// MaxSerialConsoleLogBytes is how much of the end of the serial console log is kept.
+	MaxSerialConsoleLogBytes = 256 * 1024
+	// MaxExtensionStatusBytes is how much of the end of the VM extension status is kept.
+	MaxExtensionStatusBytes = 32 * 1024
+
+	truncatedMarker = "[truncated]\n"
+)
+
+// BootstrapDiagnosticsScope defines the scope interface for a bootstrap diagnostics service.
+type BootstrapDiagnosticsScope interface {
+	azure.Authorizer
+	azure.AsyncReconciler
+	BootstrapDiagnosticsSpec() *BootstrapDiagnosticsSpec
+}
+
+// Diagnostics are the diagnostics collected from a VM whose bootstrapping failed.
+type Diagnostics struct {
+	// SerialConsoleLog is the end of the VM's serial console log, or the reason it couldn't be retrieved.
+	SerialConsoleLog string
+	// ExtensionStatus is the status and substatus of each of the VM's extensions.
+	ExtensionStatus string
+}
+
+// Service collects diagnostics from VMs whose bootstrapping failed.
+type Service struct {
+	Scope BootstrapDiagnosticsScope
+	client
+}
+
+// New creates a new bootstrap diagnostics service.
+func New(scope BootstrapDiagnosticsScope) (*Service, error) {
+	client, err := newClient(scope, scope.DefaultedAzureCallTimeout())
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		Scope:  scope,
+		client: client,
+	}, nil
+}
+
+// Collect retrieves the serial console log and the extension status of the VM. It returns nil if there is no VM to
+// collect diagnostics from.
+func (s *Service) Collect(ctx context.Context) (*Diagnostics, error) {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "bootstrapdiagnostics.Service.Collect")
+	defer done()
+
+	spec := s.Scope.BootstrapDiagnosticsSpec()
+	if spec == nil {
+		return nil, nil
+	}
+
+	instanceView, err := s.client.InstanceView(ctx, spec.ResourceGroup, spec.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get instance view of VM %s/%s", spec.ResourceGroup, spec.Name)
+	}
+
+	diagnostics := &Diagnostics{
+		ExtensionStatus: truncate(extensionStatus(instanceView.Extensions), MaxExtensionStatusBytes),
+	}
+
+	// A missing serial console log shouldn't prevent the extension status from being reported.
+	if !spec.BootDiagnosticsEnabled {
+		diagnostics.SerialConsoleLog = "boot diagnostics are disabled for this VM\n"
+		return diagnostics, nil
+	}
+	serialConsoleLog, err := s.client.SerialConsoleLog(ctx, spec.ResourceGroup, spec.Name)
+	if err != nil {
+		log.V(2).Info("unable to retrieve serial console log", "vm", spec.Name, "error", err.Error())
+		diagnostics.SerialConsoleLog = fmt.Sprintf("unable to retrieve serial console log: %s\n", err)
+	} else {
+		diagnostics.SerialConsoleLog = truncate(string(serialConsoleLog), MaxSerialConsoleLogBytes)
+	}
+
+	return diagnostics, nil
+}
+
+// extensionStatus formats the statuses and substatuses of the VM extensions, which include the output of the
+// bootstrapping extension's command.
+func extensionStatus(extensions []*armcompute.VirtualMachineExtensionInstanceView) string {
+	var b strings.Builder
+	for _, extension := range extensions {
+		if extension == nil {
+			continue
+		}
+		fmt.Fprintf(&b, "%s (%s %s):\n", ptr.Deref(extension.Name, ""), ptr.Deref(extension.Type, ""), ptr.Deref(extension.TypeHandlerVersion, ""))
+		for _, status := range extension.Statuses {
+			writeStatus(&b, "status", status)
+		}
+		for _, status := range extension.Substatuses {
+			writeStatus(&b, "substatus", status)
+		}
+	}
+	return b.String()
+}
+
+func writeStatus(b *strings.Builder, kind string, status *armcompute.InstanceViewStatus) {
+	if status == nil {
+		return
+	}
+	level := ""
+	if status.Level != nil {
+		level = string(*status.Level)
+	}
+	fmt.Fprintf(b, "  %s %s [%s] %s\n", kind, ptr.Deref(status.Code, ""), level, ptr.Deref(status.DisplayStatus, ""))
+	if message := ptr.Deref(status.Message, ""); message != "" {
+		fmt.Fprintf(b, "    %s\n", strings.ReplaceAll(strings.TrimSpace(message), "\n", "\n    "))
+	}
+}
+
+// truncate keeps the end of s, which is where the cause of a bootstrap failure is usually found.
+func truncate(s string, maxBytes int) string {
+	if len(s) <= maxBytes {
+		return s
+	}
+	return truncatedMarker + s[len(s)-maxBytes+len(truncatedMarker):]
+}
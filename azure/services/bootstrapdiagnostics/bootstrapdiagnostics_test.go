@@ -0,0 +1,190 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapdiagnostics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+)
+
+// fakeScope implements BootstrapDiagnosticsScope. The embedded interfaces are only there to satisfy it.
+type fakeScope struct {
+	azure.Authorizer
+	azure.AsyncReconciler
+	spec *BootstrapDiagnosticsSpec
+}
+
+func (f *fakeScope) BootstrapDiagnosticsSpec() *BootstrapDiagnosticsSpec { return f.spec }
+
+// fakeClient returns canned diagnostics.
+type fakeClient struct {
+	instanceView     armcompute.VirtualMachineInstanceView
+	instanceViewErr  error
+	serialConsoleLog []byte
+	serialConsoleErr error
+}
+
+func (f *fakeClient) InstanceView(_ context.Context, _, _ string) (armcompute.VirtualMachineInstanceView, error) {
+	return f.instanceView, f.instanceViewErr
+}
+
+func (f *fakeClient) SerialConsoleLog(_ context.Context, _, _ string) ([]byte, error) {
+	return f.serialConsoleLog, f.serialConsoleErr
+}
+
+var failedExtensionInstanceView = armcompute.VirtualMachineInstanceView{
+	Extensions: []*armcompute.VirtualMachineExtensionInstanceView{
+		{
+			Name:               ptr.To("CAPZ.Linux.Bootstrapping"),
+			Type:               ptr.To("Microsoft.Azure.ContainerUpstream.CAPZ.Linux.Bootstrapping"),
+			TypeHandlerVersion: ptr.To("1.0.0"),
+			Statuses: []*armcompute.InstanceViewStatus{
+				{
+					Code:          ptr.To("ProvisioningState/failed/1"),
+					Level:         ptr.To(armcompute.StatusLevelTypesError),
+					DisplayStatus: ptr.To("Provisioning failed"),
+					Message:       ptr.To("Enable failed: failed to execute command: command terminated with exit status=1"),
+				},
+			},
+			Substatuses: []*armcompute.InstanceViewStatus{
+				{
+					Code:          ptr.To("ComponentStatus/StdErr/failed"),
+					Level:         ptr.To(armcompute.StatusLevelTypesInfo),
+					DisplayStatus: ptr.To("Provisioning failed"),
+					Message:       ptr.To("line 1\nline 2"),
+				},
+			},
+		},
+	},
+}
+
+func TestCollect(t *testing.T) {
+	spec := &BootstrapDiagnosticsSpec{
+		Name:                   "my-vm",
+		ResourceGroup:          "my-rg",
+		BootDiagnosticsEnabled: true,
+	}
+
+	testcases := []struct {
+		name          string
+		spec          *BootstrapDiagnosticsSpec
+		client        *fakeClient
+		expect        func(g *WithT, diagnostics *Diagnostics)
+		expectedError string
+	}{
+		{
+			name:   "noop if the VM hasn't been created",
+			spec:   nil,
+			client: &fakeClient{},
+			expect: func(g *WithT, diagnostics *Diagnostics) {
+				g.Expect(diagnostics).To(BeNil())
+			},
+		},
+		{
+			name: "collects the serial console log and extension status",
+			spec: spec,
+			client: &fakeClient{
+				instanceView:     failedExtensionInstanceView,
+				serialConsoleLog: []byte("cloud-init failed\n"),
+			},
+			expect: func(g *WithT, diagnostics *Diagnostics) {
+				g.Expect(diagnostics.SerialConsoleLog).To(Equal("cloud-init failed\n"))
+				g.Expect(diagnostics.ExtensionStatus).To(Equal(`CAPZ.Linux.Bootstrapping (Microsoft.Azure.ContainerUpstream.CAPZ.Linux.Bootstrapping 1.0.0):
+  status ProvisioningState/failed/1 [Error] Provisioning failed
+    Enable failed: failed to execute command: command terminated with exit status=1
+  substatus ComponentStatus/StdErr/failed [Info] Provisioning failed
+    line 1
+    line 2
+`))
+			},
+		},
+		{
+			name: "keeps the end of a long serial console log",
+			spec: spec,
+			client: &fakeClient{
+				instanceView:     failedExtensionInstanceView,
+				serialConsoleLog: []byte(strings.Repeat("a", MaxSerialConsoleLogBytes) + "the end\n"),
+			},
+			expect: func(g *WithT, diagnostics *Diagnostics) {
+				g.Expect(diagnostics.SerialConsoleLog).To(HaveLen(MaxSerialConsoleLogBytes))
+				g.Expect(diagnostics.SerialConsoleLog).To(HavePrefix(truncatedMarker))
+				g.Expect(diagnostics.SerialConsoleLog).To(HaveSuffix("the end\n"))
+			},
+		},
+		{
+			name: "reports why the serial console log couldn't be retrieved",
+			spec: spec,
+			client: &fakeClient{
+				instanceView:     failedExtensionInstanceView,
+				serialConsoleErr: errors.New("the VM has no serial console log"),
+			},
+			expect: func(g *WithT, diagnostics *Diagnostics) {
+				g.Expect(diagnostics.SerialConsoleLog).To(Equal("unable to retrieve serial console log: the VM has no serial console log\n"))
+				g.Expect(diagnostics.ExtensionStatus).To(ContainSubstring("CAPZ.Linux.Bootstrapping"))
+			},
+		},
+		{
+			name: "doesn't retrieve the serial console log if boot diagnostics are disabled",
+			spec: &BootstrapDiagnosticsSpec{Name: "my-vm", ResourceGroup: "my-rg"},
+			client: &fakeClient{
+				instanceView:     failedExtensionInstanceView,
+				serialConsoleErr: errors.New("should not be called"),
+			},
+			expect: func(g *WithT, diagnostics *Diagnostics) {
+				g.Expect(diagnostics.SerialConsoleLog).To(Equal("boot diagnostics are disabled for this VM\n"))
+			},
+		},
+		{
+			name: "fails if the instance view can't be retrieved",
+			spec: spec,
+			client: &fakeClient{
+				instanceViewErr: errors.New("#: Internal Server Error: StatusCode=500"),
+			},
+			expect: func(g *WithT, diagnostics *Diagnostics) {
+				g.Expect(diagnostics).To(BeNil())
+			},
+			expectedError: "failed to get instance view of VM my-rg/my-vm: #: Internal Server Error: StatusCode=500",
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			s := &Service{
+				Scope:  &fakeScope{spec: tc.spec},
+				client: tc.client,
+			}
+			diagnostics, err := s.Collect(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			tc.expect(g, diagnostics)
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bootstrapdiagnostics
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+const (
+	// sasURIExpirationTimeInMinutes is how long the serial console log SAS URI is valid for.
+	sasURIExpirationTimeInMinutes = 5
+	// maxDownloadBytes caps how much of the serial console log is downloaded.
+	maxDownloadBytes = 16 * 1024 * 1024
+)
+
+// client wraps the Azure APIs the bootstrap diagnostics are collected from.
+type client interface {
+	InstanceView(ctx context.Context, resourceGroup, vmName string) (armcompute.VirtualMachineInstanceView, error)
+	SerialConsoleLog(ctx context.Context, resourceGroup, vmName string) ([]byte, error)
+}
+
+// azureClient contains the Azure go-sdk Client.
+type azureClient struct {
+	virtualmachines *armcompute.VirtualMachinesClient
+	http            *http.Client
+}
+
+var _ client = (*azureClient)(nil)
+
+// newClient creates a new bootstrap diagnostics client from an authorizer.
+func newClient(auth azure.Authorizer, apiCallTimeout time.Duration) (*azureClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create virtualmachines client options")
+	}
+	factory, err := armcompute.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armcompute client factory")
+	}
+	return &azureClient{
+		virtualmachines: factory.NewVirtualMachinesClient(),
+		http:            &http.Client{Timeout: apiCallTimeout},
+	}, nil
+}
+
+// InstanceView returns the instance view of a VM, which includes the status of its extensions.
+func (ac *azureClient) InstanceView(ctx context.Context, resourceGroup, vmName string) (armcompute.VirtualMachineInstanceView, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "bootstrapdiagnostics.azureClient.InstanceView")
+	defer done()
+
+	resp, err := ac.virtualmachines.InstanceView(ctx, resourceGroup, vmName, nil)
+	if err != nil {
+		return armcompute.VirtualMachineInstanceView{}, err
+	}
+	return resp.VirtualMachineInstanceView, nil
+}
+
+// SerialConsoleLog downloads the serial console log of a VM through a short-lived SAS URI.
+func (ac *azureClient) SerialConsoleLog(ctx context.Context, resourceGroup, vmName string) ([]byte, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "bootstrapdiagnostics.azureClient.SerialConsoleLog")
+	defer done()
+
+	resp, err := ac.virtualmachines.RetrieveBootDiagnosticsData(ctx, resourceGroup, vmName, &armcompute.VirtualMachinesClientRetrieveBootDiagnosticsDataOptions{
+		SasURIExpirationTimeInMinutes: ptr.To[int32](sasURIExpirationTimeInMinutes),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to retrieve boot diagnostics data")
+	}
+	uri := ptr.Deref(resp.SerialConsoleLogBlobURI, "")
+	if uri == "" {
+		return nil, errors.New("the VM has no serial console log")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, uri, http.NoBody)
+	if err != nil {
+		return nil, err
+	}
+	logResp, err := ac.http.Do(req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to download serial console log")
+	}
+	defer logResp.Body.Close()
+	if logResp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("failed to download serial console log: %s", logResp.Status)
+	}
+	return io.ReadAll(io.LimitReader(logResp.Body, maxDownloadBytes))
+}
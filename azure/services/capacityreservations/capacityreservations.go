@@ -0,0 +1,141 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityreservations
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+const serviceName = "capacityreservations"
+
+// allocationFailureCodes are the error codes Azure returns when a VM can't be allocated.
+var allocationFailureCodes = []string{
+	"AllocationFailed",
+	"ZonalAllocationFailed",
+	"OverconstrainedAllocationRequest",
+	"OverconstrainedZonalAllocationRequest",
+}
+
+// CapacityReservationScope defines the scope interface for a capacity reservations service.
+type CapacityReservationScope interface {
+	azure.Authorizer
+	azure.AsyncReconciler
+	CapacityReservationSpec() *CapacityReservationSpec
+	SetCapacityReservationStatus(reserved, allocated int32)
+}
+
+// Service reports the utilization of the capacity reservation group a scale set consumes.
+type Service struct {
+	Scope CapacityReservationScope
+	client
+}
+
+// New creates a new capacity reservations service.
+func New(scope CapacityReservationScope) (*Service, error) {
+	client, err := newClient(scope)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		Scope:  scope,
+		client: client,
+	}, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return serviceName
+}
+
+// Reconcile reports how much of the capacity reserved for the scale set's VM size is allocated. Failing to read the
+// utilization doesn't fail the reconciliation, since it doesn't prevent the scale set from being reconciled.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "capacityreservations.Service.Reconcile")
+	defer done()
+
+	spec := s.Scope.CapacityReservationSpec()
+	if spec == nil {
+		return nil
+	}
+	if !strings.EqualFold(spec.SubscriptionID, s.Scope.SubscriptionID()) {
+		log.V(2).Info("skipping capacity reservation utilization of a group in another subscription", "capacityReservationGroup", spec.Name, "subscription", spec.SubscriptionID)
+		return nil
+	}
+
+	reserved, allocated, err := s.utilization(ctx, spec)
+	if err != nil {
+		log.Error(err, "unable to get capacity reservation utilization", "capacityReservationGroup", spec.Name)
+		return nil
+	}
+	s.Scope.SetCapacityReservationStatus(reserved, allocated)
+	return nil
+}
+
+// Delete is a no-op, as the capacity reservation group isn't managed by CAPZ.
+func (s *Service) Delete(_ context.Context) error {
+	return nil
+}
+
+// utilization returns the capacity reserved for the VM size of the spec, and how much of it is allocated to VMs.
+func (s *Service) utilization(ctx context.Context, spec *CapacityReservationSpec) (reserved, allocated int32, err error) {
+	reservations, err := s.client.ListReservations(ctx, spec.ResourceGroup, spec.Name)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to list capacity reservations in group %s/%s", spec.ResourceGroup, spec.Name)
+	}
+	names := make(map[string]bool)
+	for _, reservation := range reservations {
+		if reservation.SKU == nil || !strings.EqualFold(ptr.Deref(reservation.SKU.Name, ""), spec.VMSize) {
+			continue
+		}
+		names[strings.ToLower(ptr.Deref(reservation.Name, ""))] = true
+		reserved += int32(ptr.Deref(reservation.SKU.Capacity, 0))
+	}
+
+	instanceView, err := s.client.GroupInstanceView(ctx, spec.ResourceGroup, spec.Name)
+	if err != nil {
+		return 0, 0, errors.Wrapf(err, "failed to get instance view of capacity reservation group %s/%s", spec.ResourceGroup, spec.Name)
+	}
+	for _, reservation := range instanceView.CapacityReservations {
+		if reservation == nil || !names[strings.ToLower(ptr.Deref(reservation.Name, ""))] || reservation.UtilizationInfo == nil {
+			continue
+		}
+		allocated += int32(len(reservation.UtilizationInfo.VirtualMachinesAllocated))
+	}
+	return reserved, allocated, nil
+}
+
+// IsAllocationFailure returns true if err is an Azure error caused by VMs failing to be allocated, for instance
+// because their capacity reservation is exhausted.
+func IsAllocationFailure(err error) bool {
+	var respErr *azcore.ResponseError
+	if !errors.As(err, &respErr) {
+		return false
+	}
+	for _, code := range allocationFailureCodes {
+		if strings.EqualFold(respErr.ErrorCode, code) {
+			return true
+		}
+	}
+	return strings.Contains(respErr.ErrorCode, "CapacityReservation")
+}
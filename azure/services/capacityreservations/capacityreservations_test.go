@@ -0,0 +1,183 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityreservations
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+)
+
+// fakeScope implements CapacityReservationScope. The embedded interfaces are only there to satisfy it.
+type fakeScope struct {
+	azure.Authorizer
+	azure.AsyncReconciler
+	spec      *CapacityReservationSpec
+	reserved  int32
+	allocated int32
+	reported  bool
+}
+
+func (f *fakeScope) SubscriptionID() string                            { return "123" }
+func (f *fakeScope) CapacityReservationSpec() *CapacityReservationSpec { return f.spec }
+func (f *fakeScope) SetCapacityReservationStatus(reserved, allocated int32) {
+	f.reserved, f.allocated, f.reported = reserved, allocated, true
+}
+
+// fakeClient returns canned capacity reservations.
+type fakeClient struct {
+	reservations    []armcompute.CapacityReservation
+	reservationsErr error
+	instanceView    armcompute.CapacityReservationGroupInstanceView
+}
+
+func (f *fakeClient) ListReservations(_ context.Context, _, _ string) ([]armcompute.CapacityReservation, error) {
+	return f.reservations, f.reservationsErr
+}
+
+func (f *fakeClient) GroupInstanceView(_ context.Context, _, _ string) (armcompute.CapacityReservationGroupInstanceView, error) {
+	return f.instanceView, nil
+}
+
+func allocatedVMs(n int) []*armcompute.SubResourceReadOnly {
+	vms := make([]*armcompute.SubResourceReadOnly, n)
+	for i := range vms {
+		vms[i] = &armcompute.SubResourceReadOnly{}
+	}
+	return vms
+}
+
+var testClient = &fakeClient{
+	reservations: []armcompute.CapacityReservation{
+		{Name: ptr.To("d2-zone1"), SKU: &armcompute.SKU{Name: ptr.To("Standard_D2s_v3"), Capacity: ptr.To[int64](3)}},
+		{Name: ptr.To("d2-zone2"), SKU: &armcompute.SKU{Name: ptr.To("standard_d2s_v3"), Capacity: ptr.To[int64](2)}},
+		{Name: ptr.To("d4-zone1"), SKU: &armcompute.SKU{Name: ptr.To("Standard_D4s_v3"), Capacity: ptr.To[int64](10)}},
+	},
+	instanceView: armcompute.CapacityReservationGroupInstanceView{
+		CapacityReservations: []*armcompute.CapacityReservationInstanceViewWithName{
+			{Name: ptr.To("d2-zone1"), UtilizationInfo: &armcompute.CapacityReservationUtilization{VirtualMachinesAllocated: allocatedVMs(3)}},
+			{Name: ptr.To("D2-Zone2"), UtilizationInfo: &armcompute.CapacityReservationUtilization{VirtualMachinesAllocated: allocatedVMs(1)}},
+			{Name: ptr.To("d4-zone1"), UtilizationInfo: &armcompute.CapacityReservationUtilization{VirtualMachinesAllocated: allocatedVMs(7)}},
+		},
+	},
+}
+
+func TestReconcile(t *testing.T) {
+	tests := []struct {
+		name              string
+		spec              *CapacityReservationSpec
+		client            *fakeClient
+		expectReported    bool
+		expectedReserved  int32
+		expectedAllocated int32
+	}{
+		{
+			name:   "no capacity reservation group",
+			client: testClient,
+		},
+		{
+			name:   "capacity reservation group in another subscription",
+			spec:   &CapacityReservationSpec{SubscriptionID: "456", ResourceGroup: "rg", Name: "crg", VMSize: "Standard_D2s_v3"},
+			client: testClient,
+		},
+		{
+			name:              "only reservations for the VM size are counted",
+			spec:              &CapacityReservationSpec{SubscriptionID: "123", ResourceGroup: "rg", Name: "crg", VMSize: "Standard_D2s_v3"},
+			client:            testClient,
+			expectReported:    true,
+			expectedReserved:  5,
+			expectedAllocated: 4,
+		},
+		{
+			name:           "no reservations for the VM size",
+			spec:           &CapacityReservationSpec{SubscriptionID: "123", ResourceGroup: "rg", Name: "crg", VMSize: "Standard_E2s_v3"},
+			client:         testClient,
+			expectReported: true,
+		},
+		{
+			name:   "failing to list reservations doesn't fail the reconciliation",
+			spec:   &CapacityReservationSpec{SubscriptionID: "123", ResourceGroup: "rg", Name: "crg", VMSize: "Standard_D2s_v3"},
+			client: &fakeClient{reservationsErr: errors.New("boom")},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			scope := &fakeScope{spec: tc.spec}
+			s := &Service{Scope: scope, client: tc.client}
+
+			g.Expect(s.Reconcile(context.TODO())).To(Succeed())
+			g.Expect(scope.reported).To(Equal(tc.expectReported))
+			g.Expect(scope.reserved).To(Equal(tc.expectedReserved))
+			g.Expect(scope.allocated).To(Equal(tc.expectedAllocated))
+		})
+	}
+}
+
+func TestIsAllocationFailure(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name: "not an Azure error",
+			err:  errors.New("AllocationFailed"),
+		},
+		{
+			name:     "allocation failed",
+			err:      &azcore.ResponseError{StatusCode: http.StatusConflict, ErrorCode: "AllocationFailed"},
+			expected: true,
+		},
+		{
+			name:     "zonal allocation failed",
+			err:      &azcore.ResponseError{StatusCode: http.StatusConflict, ErrorCode: "ZonalAllocationFailed"},
+			expected: true,
+		},
+		{
+			name:     "capacity reservation error",
+			err:      &azcore.ResponseError{StatusCode: http.StatusConflict, ErrorCode: "CapacityReservationCapacityExceeded"},
+			expected: true,
+		},
+		{
+			name:     "wrapped allocation failure",
+			err:      errors.Wrap(&azcore.ResponseError{ErrorCode: "OverconstrainedAllocationRequest"}, "failed to create or update resource"),
+			expected: true,
+		},
+		{
+			name: "other Azure error",
+			err:  &azcore.ResponseError{StatusCode: http.StatusBadRequest, ErrorCode: "InvalidParameter"},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(IsAllocationFailure(tc.err)).To(Equal(tc.expected))
+		})
+	}
+}
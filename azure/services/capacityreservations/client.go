@@ -0,0 +1,96 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package capacityreservations
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// client wraps the Azure APIs the capacity reservation utilization is read from.
+type client interface {
+	ListReservations(ctx context.Context, resourceGroup, groupName string) ([]armcompute.CapacityReservation, error)
+	GroupInstanceView(ctx context.Context, resourceGroup, groupName string) (armcompute.CapacityReservationGroupInstanceView, error)
+}
+
+// azureClient contains the Azure go-sdk Client.
+type azureClient struct {
+	reservations *armcompute.CapacityReservationsClient
+	groups       *armcompute.CapacityReservationGroupsClient
+}
+
+var _ client = (*azureClient)(nil)
+
+// newClient creates a new capacity reservations client from an authorizer.
+func newClient(auth azure.Authorizer) (*azureClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create capacity reservations client options")
+	}
+	factory, err := armcompute.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armcompute client factory")
+	}
+	return &azureClient{
+		reservations: factory.NewCapacityReservationsClient(),
+		groups:       factory.NewCapacityReservationGroupsClient(),
+	}, nil
+}
+
+// ListReservations returns the capacity reservations in a capacity reservation group.
+func (ac *azureClient) ListReservations(ctx context.Context, resourceGroup, groupName string) ([]armcompute.CapacityReservation, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "capacityreservations.azureClient.ListReservations")
+	defer done()
+
+	var reservations []armcompute.CapacityReservation
+	pager := ac.reservations.NewListByCapacityReservationGroupPager(resourceGroup, groupName, nil)
+	for pager.More() {
+		page, err := pager.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, reservation := range page.Value {
+			if reservation != nil {
+				reservations = append(reservations, *reservation)
+			}
+		}
+	}
+	return reservations, nil
+}
+
+// GroupInstanceView returns the instance view of a capacity reservation group, which includes the utilization of
+// each of its capacity reservations.
+func (ac *azureClient) GroupInstanceView(ctx context.Context, resourceGroup, groupName string) (armcompute.CapacityReservationGroupInstanceView, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "capacityreservations.azureClient.GroupInstanceView")
+	defer done()
+
+	resp, err := ac.groups.Get(ctx, resourceGroup, groupName, &armcompute.CapacityReservationGroupsClientGetOptions{
+		Expand: ptr.To(armcompute.CapacityReservationGroupInstanceViewTypesInstanceView),
+	})
+	if err != nil {
+		return armcompute.CapacityReservationGroupInstanceView{}, err
+	}
+	if resp.Properties == nil || resp.Properties.InstanceView == nil {
+		return armcompute.CapacityReservationGroupInstanceView{}, nil
+	}
+	return *resp.Properties.InstanceView, nil
+}
@@ -47,6 +47,70 @@ func newClient(auth azure.Authorizer, apiCallTimeout time.Duration) (*azureClien
 	return &azureClient{factory.NewDisksClient(), apiCallTimeout}, nil
 }
 
+// snapshotClient contains the Azure go-sdk Client for disk snapshots.
+type snapshotClient struct {
+	snapshots      *armcompute.SnapshotsClient
+	apiCallTimeout time.Duration
+}
+
+// newSnapshotClient creates a new snapshots client from an authorizer.
+func newSnapshotClient(auth azure.Authorizer, apiCallTimeout time.Duration) (*snapshotClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create snapshots client options")
+	}
+	factory, err := armcompute.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armcompute client factory")
+	}
+	return &snapshotClient{factory.NewSnapshotsClient(), apiCallTimeout}, nil
+}
+
+// Get gets a disk.
+func (ac *azureClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (result interface{}, err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "disks.azureClient.Get")
+	defer done()
+
+	resp, err := ac.disks.Get(ctx, spec.ResourceGroupName(), spec.ResourceName(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Disk, nil
+}
+
+// CreateOrUpdateAsync updates an existing disk asynchronously. Disks are never created by this client, as they are
+// created along with the VM they belong to. It sends a PATCH request to Azure and if accepted without error, the func
+// will return a Poller which can be used to track the ongoing progress of the operation.
+func (ac *azureClient) CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armcompute.DisksClientUpdateResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "disks.azureClient.CreateOrUpdateAsync")
+	defer done()
+
+	diskUpdate, ok := parameters.(armcompute.DiskUpdate)
+	if !ok && parameters != nil {
+		return nil, nil, errors.Errorf("%T is not an armcompute.DiskUpdate", parameters)
+	}
+
+	opts := &armcompute.DisksClientBeginUpdateOptions{ResumeToken: resumeToken}
+	poller, err = ac.disks.BeginUpdate(ctx, spec.ResourceGroupName(), spec.ResourceName(), diskUpdate, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, ac.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	resp, err := poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return nil, poller, err
+	}
+
+	// if the operation completed, return a nil poller
+	return resp.Disk, nil, err
+}
+
 // DeleteAsync deletes a disk asynchronously. DeleteAsync sends a DELETE
 // request to Azure and if accepted without error, the func will return a Poller which can be used to track the ongoing
 // progress of the operation.
@@ -74,3 +138,47 @@ func (ac *azureClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecG
 	// if the operation completed, return a nil poller.
 	return nil, err
 }
+
+// Get gets a snapshot.
+func (sc *snapshotClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (result interface{}, err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "disks.snapshotClient.Get")
+	defer done()
+
+	resp, err := sc.snapshots.Get(ctx, spec.ResourceGroupName(), spec.ResourceName(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Snapshot, nil
+}
+
+// CreateOrUpdateAsync creates or updates a snapshot asynchronously. It sends a PUT request to Azure and if accepted
+// without error, the func will return a Poller which can be used to track the ongoing progress of the operation.
+func (sc *snapshotClient) CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armcompute.SnapshotsClientCreateOrUpdateResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "disks.snapshotClient.CreateOrUpdateAsync")
+	defer done()
+
+	snapshot, ok := parameters.(armcompute.Snapshot)
+	if !ok && parameters != nil {
+		return nil, nil, errors.Errorf("%T is not an armcompute.Snapshot", parameters)
+	}
+
+	opts := &armcompute.SnapshotsClientBeginCreateOrUpdateOptions{ResumeToken: resumeToken}
+	poller, err = sc.snapshots.BeginCreateOrUpdate(ctx, spec.ResourceGroupName(), spec.ResourceName(), snapshot, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, sc.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	resp, err := poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return nil, poller, err
+	}
+
+	// if the operation completed, return a nil poller
+	return resp.Snapshot, nil, err
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
@@ -33,12 +34,17 @@ type DiskScope interface {
 	azure.ClusterDescriber
 	azure.AsyncStatusUpdater
 	DiskSpecs() []azure.ResourceSpecGetter
+	ExistingDiskSpecs() []azure.ResourceSpecGetter
+	ProviderID() string
+	UpdateAnnotationJSON(string, map[string]interface{}) error
 }
 
 // Service provides operations on Azure resources.
 type Service struct {
 	Scope DiskScope
+	async.Getter
 	async.Reconciler
+	snapshotReconciler async.Reconciler
 }
 
 // New creates a disks service.
@@ -47,10 +53,18 @@ func New(scope DiskScope) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
+	snapshotClient, err := newSnapshotClient(scope, scope.DefaultedAzureCallTimeout())
+	if err != nil {
+		return nil, err
+	}
 	return &Service{
-		Scope: scope,
-		Reconciler: async.New[armcompute.DisksClientCreateOrUpdateResponse,
-			armcompute.DisksClientDeleteResponse](scope, nil, client),
+		Scope:  scope,
+		Getter: client,
+		Reconciler: async.New[armcompute.DisksClientUpdateResponse,
+			armcompute.DisksClientDeleteResponse](scope, client, client),
+		// snapshots outlive the disk they were taken of and are never deleted by CAPZ.
+		snapshotReconciler: async.New[armcompute.SnapshotsClientCreateOrUpdateResponse,
+			armcompute.SnapshotsClientDeleteResponse](scope, snapshotClient, nil),
 	}, nil
 }
 
@@ -59,16 +73,73 @@ func (s *Service) Name() string {
 	return serviceName
 }
 
-// Reconcile on disk is currently no-op. OS disks should only be deleted and will create with the VM automatically.
+// Reconcile makes sure existing disks can be attached to the VM before it is created, and expands the disks created
+// by CAPZ once their desired size increases. Disks created by CAPZ are created with the VM automatically.
 func (s *Service) Reconcile(ctx context.Context) error {
-	_, _, done := tele.StartSpanWithLogger(ctx, "disks.Service.Reconcile")
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "disks.Service.Reconcile")
 	defer done()
 
-	// DisksReadyCondition is set in the VM service.
-	return nil
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	defer cancel()
+
+	if s.Scope.ProviderID() == "" {
+		// The VM doesn't exist yet, so its disks are created along with it. DisksReadyCondition is set by the VM
+		// service once they are.
+		return s.reconcileSpecs(ctx, s.Scope.ExistingDiskSpecs())
+	}
+
+	// Existing disks are already attached to the VM, and disks created by CAPZ only need to be updated when they
+	// should be larger than they were last made.
+	var specs []azure.ResourceSpecGetter
+	newAnnotation := make(map[string]interface{})
+	for _, spec := range s.Scope.DiskSpecs() {
+		diskSpec, ok := spec.(*DiskSpec)
+		if !ok {
+			return errors.Errorf("%T is not a valid disk spec", spec)
+		}
+		if diskSpec.DiskSizeGB > diskSpec.LastAppliedDiskSizeGB {
+			specs = append(specs, diskSpec)
+		}
+		if diskSpec.LastAppliedDiskSizeGB > 0 {
+			newAnnotation[diskSpec.Name] = diskSpec.LastAppliedDiskSizeGB
+		}
+	}
+	if len(specs) == 0 {
+		return nil
+	}
+
+	result := s.reconcileSpecs(ctx, specs)
+	if result == nil {
+		for _, spec := range specs {
+			diskSpec := spec.(*DiskSpec)
+			newAnnotation[diskSpec.Name] = diskSpec.DiskSizeGB
+		}
+		if err := s.Scope.UpdateAnnotationJSON(azure.DiskSizeLastAppliedAnnotation, newAnnotation); err != nil {
+			return err
+		}
+	}
+
+	s.Scope.UpdatePutStatus(infrav1.DisksReadyCondition, serviceName, result)
+	return result
+}
+
+// reconcileSpecs reconciles each disk spec, independently of the result of the previous one.
+func (s *Service) reconcileSpecs(ctx context.Context, specs []azure.ResourceSpecGetter) error {
+	// If multiple errors occur, we return the most pressing one.
+	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error updating) -> operationNotDoneError (i.e. updating in progress) -> no error (i.e. updated)
+	var result error
+	for _, diskSpec := range specs {
+		if _, err := s.CreateOrUpdateResource(ctx, diskSpec, serviceName); err != nil {
+			if !azure.IsOperationNotDoneError(err) || result == nil {
+				result = err
+			}
+		}
+	}
+	return result
 }
 
-// Delete deletes the disk associated with a VM.
+// Delete deletes the disks created by CAPZ for a VM according to their deletion policy. Existing disks attached to
+// the VM are never deleted.
 func (s *Service) Delete(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "disks.Service.Delete")
 	defer done()
@@ -86,7 +157,7 @@ func (s *Service) Delete(ctx context.Context) error {
 	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
 	var result error
 	for _, diskSpec := range specs {
-		if err := s.DeleteResource(ctx, diskSpec, serviceName); err != nil {
+		if err := s.deleteDisk(ctx, diskSpec); err != nil {
 			if !azure.IsOperationNotDoneError(err) || result == nil {
 				result = err
 			}
@@ -96,7 +167,52 @@ func (s *Service) Delete(ctx context.Context) error {
 	return result
 }
 
-// IsManaged returns always returns true as CAPZ does not support BYO disk.
+// deleteDisk deletes a disk created by CAPZ, unless it must be retained. A snapshot of the disk is taken first if
+// its deletion policy requires it.
+func (s *Service) deleteDisk(ctx context.Context, spec azure.ResourceSpecGetter) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "disks.Service.deleteDisk")
+	defer done()
+
+	diskSpec, ok := spec.(*DiskSpec)
+	if !ok {
+		return errors.Errorf("%T is not a valid disk spec", spec)
+	}
+
+	switch diskSpec.DeletionPolicy {
+	case infrav1.DiskDeletionPolicyRetain:
+		log.V(2).Info("retaining disk", "disk", diskSpec.Name, "resourceGroup", diskSpec.ResourceGroup)
+		return nil
+	case infrav1.DiskDeletionPolicySnapshot:
+		if err := s.snapshotDisk(ctx, diskSpec); err != nil {
+			return err
+		}
+	}
+
+	return s.DeleteResource(ctx, diskSpec, serviceName)
+}
+
+// snapshotDisk creates an incremental snapshot of a disk, if the disk still exists.
+func (s *Service) snapshotDisk(ctx context.Context, diskSpec *DiskSpec) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "disks.Service.snapshotDisk")
+	defer done()
+
+	existing, err := s.Get(ctx, diskSpec)
+	if azure.ResourceNotFound(err) {
+		// disk is already deleted, there is nothing left to snapshot.
+		return nil
+	} else if err != nil {
+		return errors.Wrapf(err, "failed to get disk %s/%s", diskSpec.ResourceGroup, diskSpec.Name)
+	}
+	disk, ok := existing.(armcompute.Disk)
+	if !ok {
+		return errors.Errorf("%T is not an armcompute.Disk", existing)
+	}
+
+	_, err = s.snapshotReconciler.CreateOrUpdateResource(ctx, newDiskSnapshotSpec(disk, diskSpec.ResourceGroup), serviceName)
+	return err
+}
+
+// IsManaged returns always returns true as the disks service only deletes disks created by CAPZ.
 func (s *Service) IsManaged(ctx context.Context) (bool, error) {
 	return true, nil
 }
@@ -24,8 +24,10 @@ import (
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
@@ -50,6 +52,56 @@ var (
 		&diskSpec2,
 	}
 
+	appliedDiskSpec = DiskSpec{
+		Name:                  "my-applied-disk",
+		ResourceGroup:         "my-group",
+		DiskSizeGB:            128,
+		LastAppliedDiskSizeGB: 128,
+	}
+
+	expandedDiskSpec = DiskSpec{
+		Name:                  "my-expanded-disk",
+		ResourceGroup:         "my-group",
+		DiskSizeGB:            256,
+		LastAppliedDiskSizeGB: 128,
+	}
+
+	retainedDiskSpec = DiskSpec{
+		Name:           "my-retained-disk",
+		ResourceGroup:  "my-group",
+		DeletionPolicy: infrav1.DiskDeletionPolicyRetain,
+	}
+
+	snapshotDiskSpec = DiskSpec{
+		Name:           "my-snapshot-disk",
+		ResourceGroup:  "my-group",
+		DeletionPolicy: infrav1.DiskDeletionPolicySnapshot,
+	}
+
+	snapshotDisk = armcompute.Disk{
+		ID:       ptr.To("/subscriptions/123/resourceGroups/my-group/providers/Microsoft.Compute/disks/my-snapshot-disk"),
+		Name:     ptr.To("my-snapshot-disk"),
+		Location: ptr.To("test-location"),
+		Properties: &armcompute.DiskProperties{
+			UniqueID: ptr.To("0a1b2c3d-0000-0000-0000-000000000000"),
+		},
+	}
+
+	diskSnapshotSpec = DiskSnapshotSpec{
+		Name:          "my-snapshot-disk-0a1b2c3d",
+		ResourceGroup: "my-group",
+		Location:      "test-location",
+		SourceDiskID:  "/subscriptions/123/resourceGroups/my-group/providers/Microsoft.Compute/disks/my-snapshot-disk",
+	}
+
+	existingDiskSpec = ExistingDiskSpec{
+		Name:          "my-existing-disk",
+		ResourceGroup: "my-group",
+		VMID:          "my-vm-id",
+	}
+
+	notFoundError = &azcore.ResponseError{StatusCode: http.StatusNotFound}
+
 	internalError = &azcore.ResponseError{
 		RawResponse: &http.Response{
 			Body:       io.NopCloser(strings.NewReader("#: Internal Server Error: StatusCode=500")),
@@ -58,16 +110,125 @@ var (
 	}
 )
 
-func TestDeleteDisk(t *testing.T) {
+func TestReconcileDisk(t *testing.T) {
 	testcases := []struct {
 		name          string
 		expectedError string
 		expect        func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
 	}{
 		{
-			name:          "noop if no disk specs are found",
+			name:          "noop if the VM doesn't exist and no existing disks are attached",
+			expectedError: "",
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ProviderID().Return("")
+				s.ExistingDiskSpecs().Return(nil)
+			},
+		},
+		{
+			name:          "check existing disks before the VM is created",
+			expectedError: "",
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.ProviderID().Return(""),
+					s.ExistingDiskSpecs().Return([]azure.ResourceSpecGetter{&existingDiskSpec}),
+					r.CreateOrUpdateResource(gomockinternal.AContext(), &existingDiskSpec, serviceName).Return(nil, nil),
+				)
+			},
+		},
+		{
+			name:          "error while checking an existing disk before the VM is created",
+			expectedError: "#: Internal Server Error: StatusCode=500",
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.ProviderID().Return(""),
+					s.ExistingDiskSpecs().Return([]azure.ResourceSpecGetter{&existingDiskSpec}),
+					r.CreateOrUpdateResource(gomockinternal.AContext(), &existingDiskSpec, serviceName).Return(nil, internalError),
+				)
+			},
+		},
+		{
+			name:          "noop if no disk size increased",
 			expectedError: "",
 			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ProviderID().Return("azure:///my-vm")
+				s.DiskSpecs().Return([]azure.ResourceSpecGetter{&diskSpec1, &appliedDiskSpec})
+			},
+		},
+		{
+			name:          "expand disks whose size increased",
+			expectedError: "",
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.ProviderID().Return("azure:///my-vm"),
+					s.DiskSpecs().Return([]azure.ResourceSpecGetter{&diskSpec1, &appliedDiskSpec, &expandedDiskSpec}),
+					r.CreateOrUpdateResource(gomockinternal.AContext(), &expandedDiskSpec, serviceName).Return(nil, nil),
+					s.UpdateAnnotationJSON(azure.DiskSizeLastAppliedAnnotation, map[string]interface{}{
+						"my-applied-disk":  int32(128),
+						"my-expanded-disk": int32(256),
+					}).Return(nil),
+					s.UpdatePutStatus(infrav1.DisksReadyCondition, serviceName, nil),
+				)
+			},
+		},
+		{
+			name:          "error while expanding a disk",
+			expectedError: "#: Internal Server Error: StatusCode=500",
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					s.ProviderID().Return("azure:///my-vm"),
+					s.DiskSpecs().Return([]azure.ResourceSpecGetter{&diskSpec1, &expandedDiskSpec}),
+					r.CreateOrUpdateResource(gomockinternal.AContext(), &expandedDiskSpec, serviceName).Return(nil, internalError),
+					s.UpdatePutStatus(infrav1.DisksReadyCondition, serviceName, internalError),
+				)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_disks.NewMockDiskScope(mockCtrl)
+			asyncMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), asyncMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: asyncMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestDeleteDisk(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder, sr *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if no disk specs are found",
+			expectedError: "",
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder, sr *mock_async.MockReconcilerMockRecorder) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.DiskSpecs().Return([]azure.ResourceSpecGetter{})
 			},
@@ -75,7 +236,7 @@ func TestDeleteDisk(t *testing.T) {
 		{
 			name:          "delete the disk",
 			expectedError: "",
-			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder, sr *mock_async.MockReconcilerMockRecorder) {
 				s.DiskSpecs().Return(fakeDiskSpecs)
 				gomock.InOrder(
 					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
@@ -88,7 +249,7 @@ func TestDeleteDisk(t *testing.T) {
 		{
 			name:          "disk already deleted",
 			expectedError: "",
-			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder, sr *mock_async.MockReconcilerMockRecorder) {
 				s.DiskSpecs().Return(fakeDiskSpecs)
 				gomock.InOrder(
 					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
@@ -101,7 +262,7 @@ func TestDeleteDisk(t *testing.T) {
 		{
 			name:          "error while trying to delete the disk",
 			expectedError: "#: Internal Server Error: StatusCode=500",
-			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder, sr *mock_async.MockReconcilerMockRecorder) {
 				s.DiskSpecs().Return(fakeDiskSpecs)
 				gomock.InOrder(
 					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
@@ -111,6 +272,59 @@ func TestDeleteDisk(t *testing.T) {
 				)
 			},
 		},
+		{
+			name:          "retain the disk",
+			expectedError: "",
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder, sr *mock_async.MockReconcilerMockRecorder) {
+				s.DiskSpecs().Return([]azure.ResourceSpecGetter{&diskSpec1, &retainedDiskSpec})
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					r.DeleteResource(gomockinternal.AContext(), &diskSpec1, serviceName).Return(nil),
+					s.UpdateDeleteStatus(infrav1.DisksReadyCondition, serviceName, nil),
+				)
+			},
+		},
+		{
+			name:          "snapshot the disk before deleting it",
+			expectedError: "",
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder, sr *mock_async.MockReconcilerMockRecorder) {
+				s.DiskSpecs().Return([]azure.ResourceSpecGetter{&snapshotDiskSpec})
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					g.Get(gomockinternal.AContext(), &snapshotDiskSpec).Return(snapshotDisk, nil),
+					sr.CreateOrUpdateResource(gomockinternal.AContext(), &diskSnapshotSpec, serviceName).Return(nil, nil),
+					r.DeleteResource(gomockinternal.AContext(), &snapshotDiskSpec, serviceName).Return(nil),
+					s.UpdateDeleteStatus(infrav1.DisksReadyCondition, serviceName, nil),
+				)
+			},
+		},
+		{
+			name:          "disk is not deleted while the snapshot is in progress",
+			expectedError: "operation type PUT on Azure resource my-group/my-snapshot-disk-0a1b2c3d is not done",
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder, sr *mock_async.MockReconcilerMockRecorder) {
+				s.DiskSpecs().Return([]azure.ResourceSpecGetter{&snapshotDiskSpec})
+				inProgress := azure.NewOperationNotDoneError(&infrav1.Future{Type: infrav1.PutFuture, ResourceGroup: "my-group", Name: "my-snapshot-disk-0a1b2c3d"})
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					g.Get(gomockinternal.AContext(), &snapshotDiskSpec).Return(snapshotDisk, nil),
+					sr.CreateOrUpdateResource(gomockinternal.AContext(), &diskSnapshotSpec, serviceName).Return(nil, inProgress),
+					s.UpdateDeleteStatus(infrav1.DisksReadyCondition, serviceName, inProgress),
+				)
+			},
+		},
+		{
+			name:          "snapshot disk already deleted",
+			expectedError: "",
+			expect: func(s *mock_disks.MockDiskScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder, sr *mock_async.MockReconcilerMockRecorder) {
+				s.DiskSpecs().Return([]azure.ResourceSpecGetter{&snapshotDiskSpec})
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					g.Get(gomockinternal.AContext(), &snapshotDiskSpec).Return(nil, notFoundError),
+					r.DeleteResource(gomockinternal.AContext(), &snapshotDiskSpec, serviceName).Return(nil),
+					s.UpdateDeleteStatus(infrav1.DisksReadyCondition, serviceName, nil),
+				)
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -123,12 +337,16 @@ func TestDeleteDisk(t *testing.T) {
 			defer mockCtrl.Finish()
 			scopeMock := mock_disks.NewMockDiskScope(mockCtrl)
 			asyncMock := mock_async.NewMockReconciler(mockCtrl)
+			getterMock := mock_async.NewMockGetter(mockCtrl)
+			snapshotMock := mock_async.NewMockReconciler(mockCtrl)
 
-			tc.expect(scopeMock.EXPECT(), asyncMock.EXPECT())
+			tc.expect(scopeMock.EXPECT(), asyncMock.EXPECT(), getterMock.EXPECT(), snapshotMock.EXPECT())
 
 			s := &Service{
-				Scope:      scopeMock,
-				Reconciler: asyncMock,
+				Scope:              scopeMock,
+				Getter:             getterMock,
+				Reconciler:         asyncMock,
+				snapshotReconciler: snapshotMock,
 			}
 
 			err := s.Delete(context.TODO())
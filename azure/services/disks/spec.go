@@ -16,12 +16,32 @@ limitations under the License.
 
 package disks
 
-import "context"
+import (
+	"context"
+	"strings"
+	"time"
 
-// DiskSpec defines the specification for a disk.
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+)
+
+// diskDetachRequeue is how long to wait before checking again whether an existing disk was detached from another VM.
+const diskDetachRequeue = 30 * time.Second
+
+// DiskSpec defines the specification for a disk created by CAPZ along with its VM.
 type DiskSpec struct {
 	Name          string
 	ResourceGroup string
+	// DiskSizeGB is the desired size of the disk. If it is larger than the current size, the disk is expanded.
+	DiskSizeGB int32
+	// LastAppliedDiskSizeGB is the size the disk was last expanded to, or created with. The disk is only checked when
+	// DiskSizeGB is larger.
+	LastAppliedDiskSizeGB int32
+	// DeletionPolicy specifies what happens to the disk when the VM is deleted.
+	DeletionPolicy infrav1.DiskDeletionPolicyType
 }
 
 // ResourceName returns the name of the disk.
@@ -39,7 +59,154 @@ func (s *DiskSpec) OwnerResourceName() string {
 	return ""
 }
 
-// Parameters is a no-op for disks.
+// Parameters returns the update that expands the disk to the desired size, if it is smaller.
+// Disks that don't exist yet are created with the VM, so nothing is returned for them.
 func (s *DiskSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
-	return nil, nil
+	if existing == nil || s.DiskSizeGB == 0 {
+		return nil, nil
+	}
+	disk, ok := existing.(armcompute.Disk)
+	if !ok {
+		return nil, errors.Errorf("%T is not an armcompute.Disk", existing)
+	}
+
+	if disk.Properties != nil && ptr.Deref(disk.Properties.DiskSizeGB, 0) >= s.DiskSizeGB {
+		// disk is already large enough, disks are never shrunk.
+		return nil, nil
+	}
+
+	return armcompute.DiskUpdate{
+		Properties: &armcompute.DiskUpdateProperties{
+			DiskSizeGB: ptr.To(s.DiskSizeGB),
+		},
+	}, nil
+}
+
+// ExistingDiskSpec defines the specification for an existing managed disk that is attached to a VM
+// but was not created by CAPZ.
+type ExistingDiskSpec struct {
+	Name          string
+	ResourceGroup string
+	// VMID is the resource ID of the VM the disk is attached to.
+	VMID string
+	// MaxShares is the number of VMs that must be able to attach the disk at the same time, if it is shared.
+	MaxShares *int32
+}
+
+// ResourceName returns the name of the disk.
+func (s *ExistingDiskSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group.
+func (s *ExistingDiskSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName is a no-op for disks.
+func (s *ExistingDiskSpec) OwnerResourceName() string {
+	return ""
+}
+
+// Parameters checks that the existing disk can be attached to the VM and returns the update to apply to it, if any.
+func (s *ExistingDiskSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	if existing == nil {
+		return nil, errors.Errorf("existing disk %s not found in resource group %s", s.Name, s.ResourceGroup)
+	}
+	disk, ok := existing.(armcompute.Disk)
+	if !ok {
+		return nil, errors.Errorf("%T is not an armcompute.Disk", existing)
+	}
+
+	if s.MaxShares == nil {
+		if managedBy := ptr.Deref(disk.ManagedBy, ""); managedBy != "" && !strings.EqualFold(managedBy, s.VMID) {
+			return nil, azure.WithTransientError(errors.Errorf("disk %s is attached to %s, waiting for it to be detached", s.Name, managedBy), diskDetachRequeue)
+		}
+		return nil, nil
+	}
+
+	var maxShares int32 = 1
+	var diskState armcompute.DiskState
+	if disk.Properties != nil {
+		maxShares = ptr.Deref(disk.Properties.MaxShares, 1)
+		diskState = ptr.Deref(disk.Properties.DiskState, "")
+	}
+	if maxShares >= *s.MaxShares {
+		// disk is already shared with enough VMs
+		return nil, nil
+	}
+	if diskState != armcompute.DiskStateUnattached {
+		return nil, azure.WithTerminalError(errors.Errorf("disk %s allows %d shares and cannot be updated to %d while it is attached to a VM", s.Name, maxShares, *s.MaxShares))
+	}
+
+	return armcompute.DiskUpdate{
+		Properties: &armcompute.DiskUpdateProperties{
+			MaxShares: s.MaxShares,
+		},
+	}, nil
+}
+
+// DiskSnapshotSpec defines the specification for an incremental snapshot taken of a disk before it is deleted.
+type DiskSnapshotSpec struct {
+	Name          string
+	ResourceGroup string
+	Location      string
+	// SourceDiskID is the resource ID of the disk to snapshot.
+	SourceDiskID string
+	Tags         map[string]*string
+}
+
+// newDiskSnapshotSpec returns the spec of the snapshot of an existing disk. The snapshot name includes part of the
+// disk's unique ID, so a disk that is later recreated with the same name doesn't reuse the snapshot of the old one.
+func newDiskSnapshotSpec(disk armcompute.Disk, resourceGroup string) *DiskSnapshotSpec {
+	name := ptr.Deref(disk.Name, "") + "-snapshot"
+	if disk.Properties != nil {
+		if uniqueID := ptr.Deref(disk.Properties.UniqueID, ""); len(uniqueID) >= 8 {
+			name = ptr.Deref(disk.Name, "") + "-" + uniqueID[:8]
+		}
+	}
+	return &DiskSnapshotSpec{
+		Name:          name,
+		ResourceGroup: resourceGroup,
+		Location:      ptr.Deref(disk.Location, ""),
+		SourceDiskID:  ptr.Deref(disk.ID, ""),
+		Tags:          disk.Tags,
+	}
+}
+
+// ResourceName returns the name of the snapshot.
+func (s *DiskSnapshotSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group.
+func (s *DiskSnapshotSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName is a no-op for snapshots.
+func (s *DiskSnapshotSpec) OwnerResourceName() string {
+	return ""
+}
+
+// Parameters returns the parameters for the snapshot. Snapshots are never updated once they exist.
+func (s *DiskSnapshotSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	if existing != nil {
+		if _, ok := existing.(armcompute.Snapshot); !ok {
+			return nil, errors.Errorf("%T is not an armcompute.Snapshot", existing)
+		}
+		return nil, nil
+	}
+
+	return armcompute.Snapshot{
+		Location: ptr.To(s.Location),
+		Tags:     s.Tags,
+		Properties: &armcompute.SnapshotProperties{
+			CreationData: &armcompute.CreationData{
+				CreateOption:     ptr.To(armcompute.DiskCreateOptionCopy),
+				SourceResourceID: ptr.To(s.SourceDiskID),
+			},
+			Incremental: ptr.To(true),
+		},
+	}, nil
 }
@@ -0,0 +1,256 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package disks
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+)
+
+func TestExistingDiskSpecParameters(t *testing.T) {
+	testcases := []struct {
+		name          string
+		spec          *ExistingDiskSpec
+		existing      interface{}
+		expected      interface{}
+		expectedError string
+		transient     bool
+	}{
+		{
+			name:          "disk does not exist",
+			spec:          &existingDiskSpec,
+			existing:      nil,
+			expectedError: "existing disk my-existing-disk not found in resource group my-group",
+		},
+		{
+			name:     "unattached disk",
+			spec:     &existingDiskSpec,
+			existing: armcompute.Disk{},
+			expected: nil,
+		},
+		{
+			name: "disk already attached to this VM",
+			spec: &existingDiskSpec,
+			existing: armcompute.Disk{
+				ManagedBy: ptr.To("MY-VM-ID"),
+			},
+			expected: nil,
+		},
+		{
+			name: "disk attached to another VM",
+			spec: &existingDiskSpec,
+			existing: armcompute.Disk{
+				ManagedBy: ptr.To("other-vm-id"),
+			},
+			expectedError: "disk my-existing-disk is attached to other-vm-id",
+			transient:     true,
+		},
+		{
+			name: "shared disk with enough shares",
+			spec: &ExistingDiskSpec{
+				Name:          "my-existing-disk",
+				ResourceGroup: "my-group",
+				VMID:          "my-vm-id",
+				MaxShares:     ptr.To[int32](2),
+			},
+			existing: armcompute.Disk{
+				ManagedBy: ptr.To("other-vm-id"),
+				Properties: &armcompute.DiskProperties{
+					MaxShares: ptr.To[int32](3),
+					DiskState: ptr.To(armcompute.DiskStateAttached),
+				},
+			},
+			expected: nil,
+		},
+		{
+			name: "unattached disk with too few shares is updated",
+			spec: &ExistingDiskSpec{
+				Name:          "my-existing-disk",
+				ResourceGroup: "my-group",
+				VMID:          "my-vm-id",
+				MaxShares:     ptr.To[int32](3),
+			},
+			existing: armcompute.Disk{
+				Properties: &armcompute.DiskProperties{
+					DiskState: ptr.To(armcompute.DiskStateUnattached),
+				},
+			},
+			expected: armcompute.DiskUpdate{
+				Properties: &armcompute.DiskUpdateProperties{
+					MaxShares: ptr.To[int32](3),
+				},
+			},
+		},
+		{
+			name: "attached disk with too few shares",
+			spec: &ExistingDiskSpec{
+				Name:          "my-existing-disk",
+				ResourceGroup: "my-group",
+				VMID:          "my-vm-id",
+				MaxShares:     ptr.To[int32](3),
+			},
+			existing: armcompute.Disk{
+				Properties: &armcompute.DiskProperties{
+					MaxShares: ptr.To[int32](2),
+					DiskState: ptr.To(armcompute.DiskStateAttached),
+				},
+			},
+			expectedError: "disk my-existing-disk allows 2 shares and cannot be updated to 3 while it is attached to a VM",
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+				var reconcileErr azure.ReconcileError
+				g.Expect(errors.As(err, &reconcileErr) && reconcileErr.IsTransient()).To(Equal(tc.transient))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expected == nil {
+				g.Expect(result).To(BeNil())
+			} else {
+				g.Expect(result).To(Equal(tc.expected))
+			}
+		})
+	}
+}
+
+func TestDiskSpecParameters(t *testing.T) {
+	testcases := []struct {
+		name     string
+		spec     *DiskSpec
+		existing interface{}
+		expected interface{}
+	}{
+		{
+			name:     "disk does not exist yet",
+			spec:     &DiskSpec{Name: "my-disk", ResourceGroup: "my-group", DiskSizeGB: 128},
+			existing: nil,
+			expected: nil,
+		},
+		{
+			name: "os disk is never resized",
+			spec: &DiskSpec{Name: "my-disk", ResourceGroup: "my-group"},
+			existing: armcompute.Disk{
+				Properties: &armcompute.DiskProperties{DiskSizeGB: ptr.To[int32](30)},
+			},
+			expected: nil,
+		},
+		{
+			name: "disk has the desired size",
+			spec: &DiskSpec{Name: "my-disk", ResourceGroup: "my-group", DiskSizeGB: 128},
+			existing: armcompute.Disk{
+				Properties: &armcompute.DiskProperties{DiskSizeGB: ptr.To[int32](128)},
+			},
+			expected: nil,
+		},
+		{
+			name: "disk is larger than the desired size",
+			spec: &DiskSpec{Name: "my-disk", ResourceGroup: "my-group", DiskSizeGB: 128},
+			existing: armcompute.Disk{
+				Properties: &armcompute.DiskProperties{DiskSizeGB: ptr.To[int32](256)},
+			},
+			expected: nil,
+		},
+		{
+			name: "disk is expanded",
+			spec: &DiskSpec{Name: "my-disk", ResourceGroup: "my-group", DiskSizeGB: 256},
+			existing: armcompute.Disk{
+				Properties: &armcompute.DiskProperties{DiskSizeGB: ptr.To[int32](128)},
+			},
+			expected: armcompute.DiskUpdate{
+				Properties: &armcompute.DiskUpdateProperties{
+					DiskSizeGB: ptr.To[int32](256),
+				},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expected == nil {
+				g.Expect(result).To(BeNil())
+			} else {
+				g.Expect(result).To(Equal(tc.expected))
+			}
+		})
+	}
+}
+
+func TestDiskSnapshotSpecParameters(t *testing.T) {
+	testcases := []struct {
+		name     string
+		existing interface{}
+		expected interface{}
+	}{
+		{
+			name:     "snapshot does not exist",
+			existing: nil,
+			expected: armcompute.Snapshot{
+				Location: ptr.To("test-location"),
+				Properties: &armcompute.SnapshotProperties{
+					CreationData: &armcompute.CreationData{
+						CreateOption:     ptr.To(armcompute.DiskCreateOptionCopy),
+						SourceResourceID: ptr.To("/subscriptions/123/resourceGroups/my-group/providers/Microsoft.Compute/disks/my-snapshot-disk"),
+					},
+					Incremental: ptr.To(true),
+				},
+			},
+		},
+		{
+			name:     "snapshot already exists",
+			existing: armcompute.Snapshot{},
+			expected: nil,
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			spec := newDiskSnapshotSpec(snapshotDisk, "my-group")
+			g.Expect(spec).To(Equal(&diskSnapshotSpec))
+
+			result, err := spec.Parameters(context.TODO(), tc.existing)
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expected == nil {
+				g.Expect(result).To(BeNil())
+			} else {
+				g.Expect(result).To(Equal(tc.expected))
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flowlogs
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// ServiceName is the name of this service.
+const ServiceName = "flowlogs"
+
+// FlowLogScope defines the scope interface for a flow logs service.
+type FlowLogScope interface {
+	azure.Authorizer
+	azure.AsyncStatusUpdater
+	FlowLogSpecs() []azure.ResourceSpecGetter
+	IsVnetManaged() bool
+}
+
+// Service provides operations on Azure resources.
+type Service struct {
+	Scope FlowLogScope
+	async.Reconciler
+}
+
+// New creates a new service.
+func New(scope FlowLogScope) (*Service, error) {
+	client, err := newClient(scope, scope.DefaultedAzureCallTimeout())
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		Scope: scope,
+		Reconciler: async.New[armnetwork.FlowLogsClientCreateOrUpdateResponse,
+			armnetwork.FlowLogsClientDeleteResponse](scope, client, client),
+	}, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return ServiceName
+}
+
+// Reconcile idempotently creates or updates the flow logs of the network security groups.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "flowlogs.Service.Reconcile")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	defer cancel()
+
+	// The flow logs are only reconciled for the network security groups managed by this controller.
+	if managed, err := s.IsManaged(ctx); err == nil && !managed {
+		log.V(4).Info("Skipping flow logs reconcile in custom VNet mode")
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "failed to check if flow logs are managed")
+	}
+
+	specs := s.Scope.FlowLogSpecs()
+	if len(specs) == 0 {
+		return nil
+	}
+
+	// We go through the list of flow logs to reconcile each one, independently of the result of the previous one.
+	// If multiple errors occur, we return the most pressing one.
+	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
+	var resErr error
+	for _, flowLogSpec := range specs {
+		if _, err := s.CreateOrUpdateResource(ctx, flowLogSpec, ServiceName); err != nil {
+			if !azure.IsOperationNotDoneError(err) || resErr == nil {
+				resErr = err
+			}
+		}
+	}
+
+	s.Scope.UpdatePutStatus(infrav1.FlowLogsReadyCondition, ServiceName, resErr)
+	return resErr
+}
+
+// Delete deletes the flow logs of the network security groups.
+func (s *Service) Delete(ctx context.Context) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "flowlogs.Service.Delete")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	defer cancel()
+
+	if managed, err := s.IsManaged(ctx); err == nil && !managed {
+		log.V(4).Info("Skipping flow logs delete in custom VNet mode")
+		return nil
+	} else if err != nil {
+		return errors.Wrap(err, "failed to check if flow logs are managed")
+	}
+
+	specs := s.Scope.FlowLogSpecs()
+	if len(specs) == 0 {
+		return nil
+	}
+
+	// We go through the list of flow logs to delete each one, independently of the result of the previous one.
+	// If multiple errors occur, we return the most pressing one.
+	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error deleting) -> operationNotDoneError (i.e. deleting in progress) -> no error (i.e. deleted)
+	var resErr error
+	for _, flowLogSpec := range specs {
+		if err := s.DeleteResource(ctx, flowLogSpec, ServiceName); err != nil {
+			if !azure.IsOperationNotDoneError(err) || resErr == nil {
+				resErr = err
+			}
+		}
+	}
+
+	s.Scope.UpdateDeleteStatus(infrav1.FlowLogsReadyCondition, ServiceName, resErr)
+	return resErr
+}
+
+// IsManaged returns true if the flow logs' lifecycles are managed.
+func (s *Service) IsManaged(ctx context.Context) (bool, error) {
+	_, _, done := tele.StartSpanWithLogger(ctx, "flowlogs.Service.IsManaged")
+	defer done()
+
+	return s.Scope.IsVnetManaged(), nil
+}
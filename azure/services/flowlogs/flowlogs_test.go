@@ -0,0 +1,222 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flowlogs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/flowlogs/mock_flowlogs"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+var (
+	fakeFlowLog = FlowLogSpec{
+		Name:               "test-nsg-flowlog",
+		ResourceGroup:      "NetworkWatcherRG",
+		NetworkWatcherName: "NetworkWatcher_westus",
+		Location:           "westus",
+		SecurityGroupID:    "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/test-nsg",
+		StorageAccountID:   "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.Storage/storageAccounts/flowlogs",
+		Enabled:            true,
+		RetentionDays:      ptr.To[int32](30),
+		TrafficAnalytics: &infrav1.TrafficAnalytics{
+			WorkspaceResourceID: "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.OperationalInsights/workspaces/my-workspace",
+			WorkspaceID:         "00000000-0000-0000-0000-000000000000",
+			WorkspaceRegion:     "westus",
+			IntervalInMinutes:   ptr.To[int32](10),
+		},
+		ClusterName: "test-cluster",
+	}
+	fakeFlowLog2 = FlowLogSpec{
+		Name:               "test-nsg-2-flowlog",
+		ResourceGroup:      "NetworkWatcherRG",
+		NetworkWatcherName: "NetworkWatcher_westus",
+		Location:           "westus",
+		SecurityGroupID:    "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/test-nsg-2",
+		StorageAccountID:   "/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.Storage/storageAccounts/flowlogs",
+		Enabled:            true,
+		ClusterName:        "test-cluster",
+	}
+	errFake      = errors.New("this is an error")
+	notDoneError = azure.NewOperationNotDoneError(&infrav1.Future{})
+)
+
+func TestReconcileFlowLogs(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_flowlogs.MockFlowLogScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if vnet is not managed",
+			expectedError: "",
+			expect: func(s *mock_flowlogs.MockFlowLogScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(false)
+			},
+		},
+		{
+			name:          "noop if no flow log specs are found",
+			expectedError: "",
+			expect: func(s *mock_flowlogs.MockFlowLogScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(true)
+				s.FlowLogSpecs().Return([]azure.ResourceSpecGetter{})
+			},
+		},
+		{
+			name:          "create multiple flow logs succeeds",
+			expectedError: "",
+			expect: func(s *mock_flowlogs.MockFlowLogScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(true)
+				s.FlowLogSpecs().Return([]azure.ResourceSpecGetter{&fakeFlowLog, &fakeFlowLog2})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeFlowLog, ServiceName).Return(nil, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeFlowLog2, ServiceName).Return(nil, nil)
+				s.UpdatePutStatus(infrav1.FlowLogsReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "first flow log create fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_flowlogs.MockFlowLogScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(true)
+				s.FlowLogSpecs().Return([]azure.ResourceSpecGetter{&fakeFlowLog, &fakeFlowLog2})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeFlowLog, ServiceName).Return(nil, errFake)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeFlowLog2, ServiceName).Return(nil, nil)
+				s.UpdatePutStatus(infrav1.FlowLogsReadyCondition, ServiceName, errFake)
+			},
+		},
+		{
+			name:          "second flow log create not done",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_flowlogs.MockFlowLogScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(true)
+				s.FlowLogSpecs().Return([]azure.ResourceSpecGetter{&fakeFlowLog, &fakeFlowLog2})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeFlowLog, ServiceName).Return(nil, errFake)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeFlowLog2, ServiceName).Return(nil, notDoneError)
+				s.UpdatePutStatus(infrav1.FlowLogsReadyCondition, ServiceName, errFake)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_flowlogs.NewMockFlowLogScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestDeleteFlowLogs(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_flowlogs.MockFlowLogScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "noop if vnet is not managed",
+			expectedError: "",
+			expect: func(s *mock_flowlogs.MockFlowLogScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(false)
+			},
+		},
+		{
+			name:          "delete multiple flow logs succeeds",
+			expectedError: "",
+			expect: func(s *mock_flowlogs.MockFlowLogScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(true)
+				s.FlowLogSpecs().Return([]azure.ResourceSpecGetter{&fakeFlowLog, &fakeFlowLog2})
+				r.DeleteResource(gomockinternal.AContext(), &fakeFlowLog, ServiceName).Return(nil)
+				r.DeleteResource(gomockinternal.AContext(), &fakeFlowLog2, ServiceName).Return(nil)
+				s.UpdateDeleteStatus(infrav1.FlowLogsReadyCondition, ServiceName, nil)
+			},
+		},
+		{
+			name:          "first flow log delete fails",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_flowlogs.MockFlowLogScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(true)
+				s.FlowLogSpecs().Return([]azure.ResourceSpecGetter{&fakeFlowLog, &fakeFlowLog2})
+				r.DeleteResource(gomockinternal.AContext(), &fakeFlowLog, ServiceName).Return(errFake)
+				r.DeleteResource(gomockinternal.AContext(), &fakeFlowLog2, ServiceName).Return(notDoneError)
+				s.UpdateDeleteStatus(infrav1.FlowLogsReadyCondition, ServiceName, errFake)
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_flowlogs.NewMockFlowLogScope(mockCtrl)
+			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), reconcilerMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: reconcilerMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flowlogs
+
+import (
+	"context"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
+)
+
+// flowLogFormatVersion is the version of the flow log format. Version 2 adds the bytes and packets of each flow.
+const flowLogFormatVersion = 2
+
+// FlowLogSpec defines the specification for an NSG flow log.
+type FlowLogSpec struct {
+	Name               string
+	ResourceGroup      string
+	NetworkWatcherName string
+	Location           string
+	SecurityGroupID    string
+	StorageAccountID   string
+	Enabled            bool
+	RetentionDays      *int32
+	// TrafficAnalytics is the traffic analytics configuration, with its defaults already applied.
+	TrafficAnalytics *infrav1.TrafficAnalytics
+	ClusterName      string
+	AdditionalTags   infrav1.Tags
+}
+
+// ResourceName returns the name of the flow log.
+func (s *FlowLogSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group of the Network Watcher.
+func (s *FlowLogSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName returns the name of the Network Watcher hosting the flow log.
+func (s *FlowLogSpec) OwnerResourceName() string {
+	return s.NetworkWatcherName
+}
+
+// Parameters returns the parameters for the flow log.
+func (s *FlowLogSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	if existing != nil {
+		existingFlowLog, ok := existing.(armnetwork.FlowLog)
+		if !ok {
+			return nil, errors.Errorf("%T is not an armnetwork.FlowLog", existing)
+		}
+		if s.isUpToDate(existingFlowLog) {
+			return nil, nil
+		}
+	}
+
+	retentionPolicy := &armnetwork.RetentionPolicyParameters{
+		Enabled: ptr.To(false),
+		Days:    ptr.To[int32](0),
+	}
+	if s.RetentionDays != nil {
+		retentionPolicy.Enabled = ptr.To(true)
+		retentionPolicy.Days = ptr.To(*s.RetentionDays)
+	}
+
+	trafficAnalytics := &armnetwork.TrafficAnalyticsConfigurationProperties{
+		Enabled: ptr.To(false),
+	}
+	if s.TrafficAnalytics != nil {
+		trafficAnalytics = &armnetwork.TrafficAnalyticsConfigurationProperties{
+			Enabled:                  ptr.To(true),
+			TrafficAnalyticsInterval: s.TrafficAnalytics.IntervalInMinutes,
+			WorkspaceID:              ptr.To(s.TrafficAnalytics.WorkspaceID),
+			WorkspaceRegion:          ptr.To(s.TrafficAnalytics.WorkspaceRegion),
+			WorkspaceResourceID:      ptr.To(s.TrafficAnalytics.WorkspaceResourceID),
+		}
+	}
+
+	return armnetwork.FlowLog{
+		Location: ptr.To(s.Location),
+		Properties: &armnetwork.FlowLogPropertiesFormat{
+			TargetResourceID: ptr.To(s.SecurityGroupID),
+			StorageID:        ptr.To(s.StorageAccountID),
+			Enabled:          ptr.To(s.Enabled),
+			Format: &armnetwork.FlowLogFormatParameters{
+				Type:    ptr.To(armnetwork.FlowLogFormatTypeJSON),
+				Version: ptr.To[int32](flowLogFormatVersion),
+			},
+			RetentionPolicy: retentionPolicy,
+			FlowAnalyticsConfiguration: &armnetwork.TrafficAnalyticsProperties{
+				NetworkWatcherFlowAnalyticsConfiguration: trafficAnalytics,
+			},
+		},
+		Tags: converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.ClusterName,
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        ptr.To(s.Name),
+			Additional:  s.AdditionalTags,
+		})),
+	}, nil
+}
+
+// isUpToDate returns true if the existing flow log matches the spec.
+func (s *FlowLogSpec) isUpToDate(existing armnetwork.FlowLog) bool {
+	props := existing.Properties
+	if props == nil {
+		return false
+	}
+
+	if !strings.EqualFold(ptr.Deref(props.TargetResourceID, ""), s.SecurityGroupID) ||
+		!strings.EqualFold(ptr.Deref(props.StorageID, ""), s.StorageAccountID) ||
+		ptr.Deref(props.Enabled, false) != s.Enabled {
+		return false
+	}
+
+	if props.Format == nil || ptr.Deref(props.Format.Version, 0) != flowLogFormatVersion {
+		return false
+	}
+
+	retentionEnabled := props.RetentionPolicy != nil && ptr.Deref(props.RetentionPolicy.Enabled, false)
+	if retentionEnabled != (s.RetentionDays != nil) {
+		return false
+	}
+	if s.RetentionDays != nil && ptr.Deref(props.RetentionPolicy.Days, 0) != *s.RetentionDays {
+		return false
+	}
+
+	var trafficAnalytics *armnetwork.TrafficAnalyticsConfigurationProperties
+	if props.FlowAnalyticsConfiguration != nil {
+		trafficAnalytics = props.FlowAnalyticsConfiguration.NetworkWatcherFlowAnalyticsConfiguration
+	}
+	trafficAnalyticsEnabled := trafficAnalytics != nil && ptr.Deref(trafficAnalytics.Enabled, false)
+	if trafficAnalyticsEnabled != (s.TrafficAnalytics != nil) {
+		return false
+	}
+	if s.TrafficAnalytics != nil {
+		if !strings.EqualFold(ptr.Deref(trafficAnalytics.WorkspaceResourceID, ""), s.TrafficAnalytics.WorkspaceResourceID) ||
+			!strings.EqualFold(ptr.Deref(trafficAnalytics.WorkspaceID, ""), s.TrafficAnalytics.WorkspaceID) ||
+			!strings.EqualFold(ptr.Deref(trafficAnalytics.WorkspaceRegion, ""), s.TrafficAnalytics.WorkspaceRegion) ||
+			ptr.Deref(trafficAnalytics.TrafficAnalyticsInterval, 0) != ptr.Deref(s.TrafficAnalytics.IntervalInMinutes, 0) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package flowlogs
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func TestFlowLogSpec_Parameters(t *testing.T) {
+	existingFlowLog := armnetwork.FlowLog{
+		ID:       ptr.To("fake-id"),
+		Location: ptr.To("westus"),
+		Properties: &armnetwork.FlowLogPropertiesFormat{
+			TargetResourceID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/test-nsg"),
+			StorageID:        ptr.To("/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.Storage/storageAccounts/flowlogs"),
+			Enabled:          ptr.To(true),
+			Format: &armnetwork.FlowLogFormatParameters{
+				Type:    ptr.To(armnetwork.FlowLogFormatTypeJSON),
+				Version: ptr.To[int32](2),
+			},
+			RetentionPolicy: &armnetwork.RetentionPolicyParameters{
+				Enabled: ptr.To(true),
+				Days:    ptr.To[int32](30),
+			},
+			FlowAnalyticsConfiguration: &armnetwork.TrafficAnalyticsProperties{
+				NetworkWatcherFlowAnalyticsConfiguration: &armnetwork.TrafficAnalyticsConfigurationProperties{
+					Enabled:                  ptr.To(true),
+					TrafficAnalyticsInterval: ptr.To[int32](10),
+					WorkspaceID:              ptr.To("00000000-0000-0000-0000-000000000000"),
+					WorkspaceRegion:          ptr.To("westus"),
+					WorkspaceResourceID:      ptr.To("/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.OperationalInsights/workspaces/my-workspace"),
+				},
+			},
+		},
+	}
+	disabledFlowLog := fakeFlowLog
+	disabledFlowLog.Enabled = false
+
+	testCases := []struct {
+		name          string
+		spec          *FlowLogSpec
+		existing      interface{}
+		expect        func(g *WithT, result interface{})
+		expectedError string
+	}{
+		{
+			name:     "error when existing is not of FlowLog type",
+			spec:     &fakeFlowLog,
+			existing: struct{}{},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "struct {} is not an armnetwork.FlowLog",
+		},
+		{
+			name:     "get result as nil when existing flow log is up to date",
+			spec:     &fakeFlowLog,
+			existing: existingFlowLog,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+		},
+		{
+			name:     "update flow log when it is disabled",
+			spec:     &disabledFlowLog,
+			existing: existingFlowLog,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.FlowLog{}))
+				g.Expect(result.(armnetwork.FlowLog).Properties.Enabled).To(Equal(ptr.To(false)))
+			},
+		},
+		{
+			name:     "update flow log when traffic analytics is removed",
+			spec:     &fakeFlowLog2,
+			existing: existingFlowLog,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.FlowLog{}))
+				g.Expect(result.(armnetwork.FlowLog).Properties.FlowAnalyticsConfiguration).To(Equal(&armnetwork.TrafficAnalyticsProperties{
+					NetworkWatcherFlowAnalyticsConfiguration: &armnetwork.TrafficAnalyticsConfigurationProperties{
+						Enabled: ptr.To(false),
+					},
+				}))
+			},
+		},
+		{
+			name:     "get flow log with retention and traffic analytics",
+			spec:     &fakeFlowLog,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(Equal(armnetwork.FlowLog{
+					Location: ptr.To("westus"),
+					Properties: &armnetwork.FlowLogPropertiesFormat{
+						TargetResourceID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/networkSecurityGroups/test-nsg"),
+						StorageID:        ptr.To("/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.Storage/storageAccounts/flowlogs"),
+						Enabled:          ptr.To(true),
+						Format: &armnetwork.FlowLogFormatParameters{
+							Type:    ptr.To(armnetwork.FlowLogFormatTypeJSON),
+							Version: ptr.To[int32](2),
+						},
+						RetentionPolicy: &armnetwork.RetentionPolicyParameters{
+							Enabled: ptr.To(true),
+							Days:    ptr.To[int32](30),
+						},
+						FlowAnalyticsConfiguration: &armnetwork.TrafficAnalyticsProperties{
+							NetworkWatcherFlowAnalyticsConfiguration: &armnetwork.TrafficAnalyticsConfigurationProperties{
+								Enabled:                  ptr.To(true),
+								TrafficAnalyticsInterval: ptr.To[int32](10),
+								WorkspaceID:              ptr.To("00000000-0000-0000-0000-000000000000"),
+								WorkspaceRegion:          ptr.To("westus"),
+								WorkspaceResourceID:      ptr.To("/subscriptions/123/resourceGroups/logs-rg/providers/Microsoft.OperationalInsights/workspaces/my-workspace"),
+							},
+						},
+					},
+					Tags: map[string]*string{
+						"sigs.k8s.io_cluster-api-provider-azure_cluster_test-cluster": ptr.To("owned"),
+						"Name": ptr.To("test-nsg-flowlog"),
+					},
+				}))
+			},
+		},
+		{
+			name:     "get flow log without retention policy",
+			spec:     &fakeFlowLog2,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.FlowLog{}))
+				g.Expect(result.(armnetwork.FlowLog).Properties.RetentionPolicy).To(Equal(&armnetwork.RetentionPolicyParameters{
+					Enabled: ptr.To(false),
+					Days:    ptr.To[int32](0),
+				}))
+			},
+		},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+			tc.expect(g, result)
+		})
+	}
+}
@@ -27,11 +27,14 @@ import (
 )
 
 const (
-	serviceName           = "loadbalancers"
-	httpsProbe            = "HTTPSProbe"
-	httpsProbeRequestPath = "/readyz"
-	lbRuleHTTPS           = "LBRuleHTTPS"
-	outboundNAT           = "OutboundNATAllProtocols"
+	serviceName                   = "loadbalancers"
+	httpsProbe                    = "HTTPSProbe"
+	httpsProbeRequestPath         = "/readyz"
+	defaultProbeRequestPath       = "/"
+	defaultProbeIntervalInSeconds = 15
+	defaultNumberOfProbes         = 4
+	lbRuleHTTPS                   = "LBRuleHTTPS"
+	outboundNAT                   = "OutboundNATAllProtocols"
 )
 
 // LBScope defines the scope interface for a load balancer service.
@@ -39,6 +42,7 @@ type LBScope interface {
 	azure.ClusterScoper
 	azure.AsyncStatusUpdater
 	LBSpecs() []azure.ResourceSpecGetter
+	UpdateAnnotationJSON(string, map[string]interface{}) error
 }
 
 // Service provides operations on Azure resources.
@@ -82,12 +86,32 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	// If multiple errors occur, we return the most pressing one.
 	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
 	var result error
-	for _, lbSpec := range specs {
+	newAnnotation := make(map[string]interface{})
+	for _, resourceSpec := range specs {
+		lbSpec := resourceSpec.(*LBSpec)
+		currentAnnotation := make(map[string]interface{})
+
 		if _, err := s.CreateOrUpdateResource(ctx, lbSpec, serviceName); err != nil {
 			if !azure.IsOperationNotDoneError(err) || result == nil {
 				result = err
 			}
+			// Keep tracking the previously applied rules until the load balancer is updated so that removed rules still get deleted.
+			for name, probe := range lbSpec.LastAppliedRules {
+				currentAnnotation[name] = probe
+			}
+		}
+
+		for _, rule := range lbSpec.AdditionalRules {
+			currentAnnotation[rule.Name] = additionalRuleProbeName(rule.Name)
 		}
+
+		if len(currentAnnotation) > 0 {
+			newAnnotation[lbSpec.Name] = currentAnnotation
+		}
+	}
+
+	if err := s.Scope.UpdateAnnotationJSON(azure.LoadBalancingRuleLastAppliedAnnotation, newAnnotation); err != nil {
+		return err
 	}
 
 	s.Scope.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, result)
@@ -36,6 +36,8 @@ import (
 )
 
 var (
+	fakeGatewayLoadBalancerFrontendIPID = "/subscriptions/123/resourceGroups/nva-rg/providers/Microsoft.Network/loadBalancers/my-gwlb/frontendIPConfigurations/my-gwlb-frontEnd"
+
 	fakePublicAPILBSpec = LBSpec{
 		Name:                 "my-publiclb",
 		ResourceGroup:        "my-rg",
@@ -60,6 +62,64 @@ var (
 		APIServerPort: 6443,
 	}
 
+	fakeAPILBSpecWithAdditionalRules = LBSpec{
+		Name:                 "my-publiclb",
+		ResourceGroup:        "my-rg",
+		SubscriptionID:       "123",
+		ClusterName:          "my-cluster",
+		Location:             "my-location",
+		Role:                 infrav1.APIServerRole,
+		Type:                 infrav1.Public,
+		SKU:                  infrav1.SKUStandard,
+		SubnetName:           "my-cp-subnet",
+		BackendPoolName:      "my-publiclb-backendPool",
+		IdleTimeoutInMinutes: ptr.To[int32](4),
+		FrontendIPConfigs: []infrav1.FrontendIP{
+			{
+				Name: "my-publiclb-frontEnd",
+				PublicIP: &infrav1.PublicIPSpec{
+					Name:    "my-publicip",
+					DNSName: "my-cluster.12345.mydomain.com",
+				},
+			},
+		},
+		APIServerPort: 6443,
+		AdditionalRules: []infrav1.LoadBalancingRule{
+			{
+				Name:         "konnectivity",
+				FrontendPort: 8132,
+				BackendPort:  8132,
+			},
+		},
+	}
+
+	fakeAPILBSpecWithRemovedRules = LBSpec{
+		Name:                 "my-publiclb",
+		ResourceGroup:        "my-rg",
+		SubscriptionID:       "123",
+		ClusterName:          "my-cluster",
+		Location:             "my-location",
+		Role:                 infrav1.APIServerRole,
+		Type:                 infrav1.Public,
+		SKU:                  infrav1.SKUStandard,
+		SubnetName:           "my-cp-subnet",
+		BackendPoolName:      "my-publiclb-backendPool",
+		IdleTimeoutInMinutes: ptr.To[int32](4),
+		FrontendIPConfigs: []infrav1.FrontendIP{
+			{
+				Name: "my-publiclb-frontEnd",
+				PublicIP: &infrav1.PublicIPSpec{
+					Name:    "my-publicip",
+					DNSName: "my-cluster.12345.mydomain.com",
+				},
+			},
+		},
+		APIServerPort: 6443,
+		LastAppliedRules: map[string]interface{}{
+			"ingress": "ingress-probe",
+		},
+	}
+
 	fakeInternalAPILBSpec = LBSpec{
 		Name:                 "my-private-lb",
 		ResourceGroup:        "my-rg",
@@ -133,6 +193,7 @@ func TestReconcileLoadBalancer(t *testing.T) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakePublicAPILBSpec})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicAPILBSpec, serviceName).Return(nil, internalError)
+				s.UpdateAnnotationJSON(azure.LoadBalancingRuleLastAppliedAnnotation, map[string]interface{}{}).Return(nil)
 				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, internalError)
 			},
 		},
@@ -143,6 +204,7 @@ func TestReconcileLoadBalancer(t *testing.T) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakePublicAPILBSpec})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicAPILBSpec, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.LoadBalancingRuleLastAppliedAnnotation, map[string]interface{}{}).Return(nil)
 				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
 			},
 		},
@@ -153,6 +215,7 @@ func TestReconcileLoadBalancer(t *testing.T) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakeInternalAPILBSpec})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeInternalAPILBSpec, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.LoadBalancingRuleLastAppliedAnnotation, map[string]interface{}{}).Return(nil)
 				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
 			},
 		},
@@ -163,6 +226,7 @@ func TestReconcileLoadBalancer(t *testing.T) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakeNodeOutboundLBSpec})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeNodeOutboundLBSpec, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.LoadBalancingRuleLastAppliedAnnotation, map[string]interface{}{}).Return(nil)
 				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
 			},
 		},
@@ -175,9 +239,40 @@ func TestReconcileLoadBalancer(t *testing.T) {
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicAPILBSpec, serviceName).Return(nil, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeInternalAPILBSpec, serviceName).Return(nil, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeNodeOutboundLBSpec, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.LoadBalancingRuleLastAppliedAnnotation, map[string]interface{}{}).Return(nil)
+				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "create apiserver LB with additional rules tracks the rules in the annotation",
+			expectedError: "",
+			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakeAPILBSpecWithAdditionalRules})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeAPILBSpecWithAdditionalRules, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.LoadBalancingRuleLastAppliedAnnotation, map[string]interface{}{
+					"my-publiclb": map[string]interface{}{
+						"konnectivity": "konnectivity-probe",
+					},
+				}).Return(nil)
 				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, nil)
 			},
 		},
+		{
+			name:          "fail to update apiserver LB keeps tracking the previously applied rules",
+			expectedError: "#: Internal Server Error: StatusCode=500",
+			expect: func(s *mock_loadbalancers.MockLBScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.LBSpecs().Return([]azure.ResourceSpecGetter{&fakeAPILBSpecWithRemovedRules})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeAPILBSpecWithRemovedRules, serviceName).Return(nil, internalError)
+				s.UpdateAnnotationJSON(azure.LoadBalancingRuleLastAppliedAnnotation, map[string]interface{}{
+					"my-publiclb": map[string]interface{}{
+						"ingress": "ingress-probe",
+					},
+				}).Return(nil)
+				s.UpdatePutStatus(infrav1.LoadBalancersReadyCondition, serviceName, internalError)
+			},
+		},
 	}
 
 	for _, tc := range testcases {
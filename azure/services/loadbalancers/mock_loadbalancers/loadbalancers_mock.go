@@ -117,6 +117,20 @@ func (mr *MockLBScopeMockRecorder) AdditionalTags() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdditionalTags", reflect.TypeOf((*MockLBScope)(nil).AdditionalTags))
 }
 
+// ApplicationSecurityGroups mocks base method.
+func (m *MockLBScope) ApplicationSecurityGroups() []v1beta1.ApplicationSecurityGroup {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplicationSecurityGroups")
+	ret0, _ := ret[0].([]v1beta1.ApplicationSecurityGroup)
+	return ret0
+}
+
+// ApplicationSecurityGroups indicates an expected call of ApplicationSecurityGroups.
+func (mr *MockLBScopeMockRecorder) ApplicationSecurityGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplicationSecurityGroups", reflect.TypeOf((*MockLBScope)(nil).ApplicationSecurityGroups))
+}
+
 // AvailabilitySetEnabled mocks base method.
 func (m *MockLBScope) AvailabilitySetEnabled() bool {
 	m.ctrl.T.Helper()
@@ -657,6 +671,20 @@ func (mr *MockLBScopeMockRecorder) Token() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Token", reflect.TypeOf((*MockLBScope)(nil).Token))
 }
 
+// UpdateAnnotationJSON mocks base method.
+func (m *MockLBScope) UpdateAnnotationJSON(arg0 string, arg1 map[string]any) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAnnotationJSON", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAnnotationJSON indicates an expected call of UpdateAnnotationJSON.
+func (mr *MockLBScopeMockRecorder) UpdateAnnotationJSON(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnnotationJSON", reflect.TypeOf((*MockLBScope)(nil).UpdateAnnotationJSON), arg0, arg1)
+}
+
 // UpdateDeleteStatus mocks base method.
 func (m *MockLBScope) UpdateDeleteStatus(arg0 v1beta10.ConditionType, arg1 string, arg2 error) {
 	m.ctrl.T.Helper()
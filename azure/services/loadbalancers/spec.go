@@ -18,6 +18,7 @@ package loadbalancers
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/pkg/errors"
@@ -45,6 +46,9 @@ type LBSpec struct {
 	FrontendIPConfigs    []infrav1.FrontendIP
 	APIServerPort        int32
 	IdleTimeoutInMinutes *int32
+	HealthProbe          *infrav1.LoadBalancerProbe
+	AdditionalRules      []infrav1.LoadBalancingRule
+	LastAppliedRules     map[string]interface{}
 	AdditionalTags       map[string]string
 }
 
@@ -92,14 +96,40 @@ func (s *LBSpec) Parameters(ctx context.Context, existing interface{}) (paramete
 			if !ipExists(frontendIPConfigs, *ip) {
 				update = true
 				frontendIPConfigs = append(frontendIPConfigs, ip)
+				continue
+			}
+			// The Gateway Load Balancer a frontend IP is chained to can be changed in place.
+			if i := frontendIPIndex(frontendIPConfigs, *ip); !gatewayLoadBalancerUpToDate(*frontendIPConfigs[i], *ip) {
+				update = true
+				frontendIPConfigs[i] = withGatewayLoadBalancer(*frontendIPConfigs[i], ip.Properties.GatewayLoadBalancer)
 			}
 		}
 
+		// Remove the additional rules and their probes which were previously applied but are no longer desired.
 		loadBalancingRules = existingLB.Properties.LoadBalancingRules
+		probes = existingLB.Properties.Probes
+		for ruleName, probeName := range s.LastAppliedRules {
+			if s.hasAdditionalRule(ruleName) {
+				continue
+			}
+			var removed bool
+			loadBalancingRules, removed = removeLBRule(loadBalancingRules, ruleName)
+			update = update || removed
+			if name, ok := probeName.(string); ok {
+				probes, removed = removeProbe(probes, name)
+				update = update || removed
+			}
+		}
+
+		// Only the rules and probes configured in the spec are updated in place, the defaults are left untouched once created.
 		for _, rule := range getLoadBalancingRules(*s, wantedFrontendIDs) {
-			if !lbRuleExists(loadBalancingRules, *rule) {
+			i := lbRuleIndex(loadBalancingRules, *rule)
+			if i < 0 {
 				update = true
 				loadBalancingRules = append(loadBalancingRules, rule)
+			} else if s.hasAdditionalRule(ptr.Deref(rule.Name, "")) && !lbRuleUpToDate(*loadBalancingRules[i], *rule) {
+				update = true
+				loadBalancingRules[i] = rule
 			}
 		}
 
@@ -119,11 +149,15 @@ func (s *LBSpec) Parameters(ctx context.Context, existing interface{}) (paramete
 			}
 		}
 
-		probes = existingLB.Properties.Probes
 		for _, probe := range getProbes(*s) {
-			if !probeExists(probes, *probe) {
+			i := probeIndex(probes, *probe)
+			if i < 0 {
 				update = true
 				probes = append(probes, probe)
+			} else if !probeUpToDate(*probes[i], *probe) {
+				// The desired probe uses the defaults for any unset field, so removing a custom health probe restores them.
+				update = true
+				probes[i] = probe
 			}
 		}
 
@@ -178,9 +212,14 @@ func getFrontendIPConfigs(lbSpec LBSpec) ([]*armnetwork.FrontendIPConfiguration,
 		} else {
 			properties = armnetwork.FrontendIPConfigurationPropertiesFormat{
 				PublicIPAddress: &armnetwork.PublicIPAddress{
-					ID: ptr.To(azure.PublicIPID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, ipConfig.PublicIP.Name)),
+					ID: ptr.To(azure.PublicIPSpecID(*ipConfig.PublicIP, lbSpec.SubscriptionID, lbSpec.ResourceGroup)),
 				},
 			}
+			if ipConfig.GatewayLoadBalancerFrontendIPID != "" {
+				properties.GatewayLoadBalancer = &armnetwork.SubResource{
+					ID: ptr.To(ipConfig.GatewayLoadBalancerFrontendIPID),
+				}
+			}
 		}
 		frontendIPConfigurations = append(frontendIPConfigurations, &armnetwork.FrontendIPConfiguration{
 			Properties: &properties,
@@ -220,31 +259,39 @@ func getLoadBalancingRules(lbSpec LBSpec, frontendIDs []*armnetwork.SubResource)
 		if len(frontendIDs) != 0 {
 			frontendIPConfig = frontendIDs[0]
 		}
-		return []*armnetwork.LoadBalancingRule{
-			{
-				Name: ptr.To(lbRuleHTTPS),
-				Properties: &armnetwork.LoadBalancingRulePropertiesFormat{
-					DisableOutboundSnat:     ptr.To(true),
-					Protocol:                ptr.To(armnetwork.TransportProtocolTCP),
-					FrontendPort:            ptr.To[int32](lbSpec.APIServerPort),
-					BackendPort:             ptr.To[int32](lbSpec.APIServerPort),
-					IdleTimeoutInMinutes:    lbSpec.IdleTimeoutInMinutes,
-					EnableFloatingIP:        ptr.To(false),
-					LoadDistribution:        ptr.To(armnetwork.LoadDistributionDefault),
-					FrontendIPConfiguration: frontendIPConfig,
-					BackendAddressPool: &armnetwork.SubResource{
-						ID: ptr.To(azure.AddressPoolID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, lbSpec.BackendPoolName)),
-					},
-					Probe: &armnetwork.SubResource{
-						ID: ptr.To(azure.ProbeID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, httpsProbe)),
-					},
-				},
-			},
+		rules := []*armnetwork.LoadBalancingRule{
+			newLoadBalancingRule(lbSpec, lbRuleHTTPS, armnetwork.TransportProtocolTCP, lbSpec.APIServerPort, lbSpec.APIServerPort, httpsProbe, frontendIPConfig),
 		}
+		for _, rule := range lbSpec.AdditionalRules {
+			rules = append(rules, newLoadBalancingRule(lbSpec, rule.Name, transportProtocolToSDK(rule.Protocol), rule.FrontendPort, rule.BackendPort, additionalRuleProbeName(rule.Name), frontendIPConfig))
+		}
+		return rules
 	}
 	return []*armnetwork.LoadBalancingRule{}
 }
 
+func newLoadBalancingRule(lbSpec LBSpec, name string, protocol armnetwork.TransportProtocol, frontendPort, backendPort int32, probeName string, frontendIPConfig *armnetwork.SubResource) *armnetwork.LoadBalancingRule {
+	return &armnetwork.LoadBalancingRule{
+		Name: ptr.To(name),
+		Properties: &armnetwork.LoadBalancingRulePropertiesFormat{
+			DisableOutboundSnat:     ptr.To(true),
+			Protocol:                ptr.To(protocol),
+			FrontendPort:            ptr.To[int32](frontendPort),
+			BackendPort:             ptr.To[int32](backendPort),
+			IdleTimeoutInMinutes:    lbSpec.IdleTimeoutInMinutes,
+			EnableFloatingIP:        ptr.To(false),
+			LoadDistribution:        ptr.To(armnetwork.LoadDistributionDefault),
+			FrontendIPConfiguration: frontendIPConfig,
+			BackendAddressPool: &armnetwork.SubResource{
+				ID: ptr.To(azure.AddressPoolID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, lbSpec.BackendPoolName)),
+			},
+			Probe: &armnetwork.SubResource{
+				ID: ptr.To(azure.ProbeID(lbSpec.SubscriptionID, lbSpec.ResourceGroup, lbSpec.Name, probeName)),
+			},
+		},
+	}
+}
+
 func getBackendAddressPools(lbSpec LBSpec) []*armnetwork.BackendAddressPool {
 	return []*armnetwork.BackendAddressPool{
 		{
@@ -255,31 +302,110 @@ func getBackendAddressPools(lbSpec LBSpec) []*armnetwork.BackendAddressPool {
 
 func getProbes(lbSpec LBSpec) []*armnetwork.Probe {
 	if lbSpec.Role == infrav1.APIServerRole {
-		return []*armnetwork.Probe{
-			{
-				Name: ptr.To(httpsProbe),
-				Properties: &armnetwork.ProbePropertiesFormat{
-					Protocol:          ptr.To(armnetwork.ProbeProtocolHTTPS),
-					Port:              ptr.To[int32](lbSpec.APIServerPort),
-					RequestPath:       ptr.To(httpsProbeRequestPath),
-					IntervalInSeconds: ptr.To[int32](15),
-					NumberOfProbes:    ptr.To[int32](4),
-				},
-			},
+		probes := []*armnetwork.Probe{
+			newProbe(httpsProbe, lbSpec.HealthProbe, infrav1.LoadBalancerProbeProtocolHTTPS, lbSpec.APIServerPort, httpsProbeRequestPath),
+		}
+		for _, rule := range lbSpec.AdditionalRules {
+			probes = append(probes, newProbe(additionalRuleProbeName(rule.Name), rule.HealthProbe, infrav1.LoadBalancerProbeProtocolTCP, rule.BackendPort, defaultProbeRequestPath))
 		}
+		return probes
 	}
 	return []*armnetwork.Probe{}
 }
 
-func probeExists(probes []*armnetwork.Probe, probe armnetwork.Probe) bool {
-	for _, p := range probes {
-		if ptr.Deref(p.Name, "") == ptr.Deref(probe.Name, "") {
+// newProbe returns a probe built from the configured health probe, falling back to the given defaults for any unset field.
+func newProbe(name string, healthProbe *infrav1.LoadBalancerProbe, defaultProtocol infrav1.LoadBalancerProbeProtocol, defaultPort int32, defaultRequestPath string) *armnetwork.Probe {
+	var probe infrav1.LoadBalancerProbe
+	if healthProbe != nil {
+		probe = *healthProbe
+	}
+
+	protocol := probe.Protocol
+	if protocol == "" {
+		protocol = defaultProtocol
+	}
+
+	properties := &armnetwork.ProbePropertiesFormat{
+		Protocol:          ptr.To(probeProtocolToSDK(protocol)),
+		Port:              ptr.To(ptr.Deref(probe.Port, defaultPort)),
+		IntervalInSeconds: ptr.To(ptr.Deref(probe.IntervalInSeconds, defaultProbeIntervalInSeconds)),
+		NumberOfProbes:    ptr.To(ptr.Deref(probe.NumberOfProbes, defaultNumberOfProbes)),
+	}
+	if protocol != infrav1.LoadBalancerProbeProtocolTCP {
+		requestPath := probe.RequestPath
+		if requestPath == "" {
+			requestPath = defaultRequestPath
+		}
+		properties.RequestPath = ptr.To(requestPath)
+	}
+
+	return &armnetwork.Probe{
+		Name:       ptr.To(name),
+		Properties: properties,
+	}
+}
+
+// additionalRuleProbeName returns the name of the health probe of an additional load balancing rule.
+func additionalRuleProbeName(ruleName string) string {
+	return ruleName + "-probe"
+}
+
+func probeProtocolToSDK(protocol infrav1.LoadBalancerProbeProtocol) armnetwork.ProbeProtocol {
+	switch protocol {
+	case infrav1.LoadBalancerProbeProtocolHTTP:
+		return armnetwork.ProbeProtocolHTTP
+	case infrav1.LoadBalancerProbeProtocolTCP:
+		return armnetwork.ProbeProtocolTCP
+	default:
+		return armnetwork.ProbeProtocolHTTPS
+	}
+}
+
+func transportProtocolToSDK(protocol infrav1.LoadBalancingRuleProtocol) armnetwork.TransportProtocol {
+	if protocol == infrav1.LoadBalancingRuleProtocolUDP {
+		return armnetwork.TransportProtocolUDP
+	}
+	return armnetwork.TransportProtocolTCP
+}
+
+func (s *LBSpec) hasAdditionalRule(name string) bool {
+	for _, rule := range s.AdditionalRules {
+		if rule.Name == name {
 			return true
 		}
 	}
 	return false
 }
 
+func probeIndex(probes []*armnetwork.Probe, probe armnetwork.Probe) int {
+	for i, p := range probes {
+		if ptr.Deref(p.Name, "") == ptr.Deref(probe.Name, "") {
+			return i
+		}
+	}
+	return -1
+}
+
+// probeUpToDate returns true if the existing probe matches the configurable properties of the desired probe.
+func probeUpToDate(existing, desired armnetwork.Probe) bool {
+	if existing.Properties == nil || desired.Properties == nil {
+		return existing.Properties == desired.Properties
+	}
+	return ptr.Equal(existing.Properties.Protocol, desired.Properties.Protocol) &&
+		ptr.Equal(existing.Properties.Port, desired.Properties.Port) &&
+		ptr.Deref(existing.Properties.RequestPath, "") == ptr.Deref(desired.Properties.RequestPath, "") &&
+		ptr.Equal(existing.Properties.IntervalInSeconds, desired.Properties.IntervalInSeconds) &&
+		ptr.Equal(existing.Properties.NumberOfProbes, desired.Properties.NumberOfProbes)
+}
+
+func removeProbe(probes []*armnetwork.Probe, name string) ([]*armnetwork.Probe, bool) {
+	i := probeIndex(probes, armnetwork.Probe{Name: ptr.To(name)})
+	if i < 0 {
+		return probes, false
+	}
+	return append(probes[:i:i], probes[i+1:]...), true
+}
+
 func outboundRuleExists(rules []*armnetwork.OutboundRule, rule armnetwork.OutboundRule) bool {
 	for _, r := range rules {
 		if ptr.Deref(r.Name, "") == ptr.Deref(rule.Name, "") {
@@ -298,13 +424,73 @@ func poolExists(pools []*armnetwork.BackendAddressPool, pool armnetwork.BackendA
 	return false
 }
 
-func lbRuleExists(rules []*armnetwork.LoadBalancingRule, rule armnetwork.LoadBalancingRule) bool {
-	for _, r := range rules {
+func lbRuleIndex(rules []*armnetwork.LoadBalancingRule, rule armnetwork.LoadBalancingRule) int {
+	for i, r := range rules {
 		if ptr.Deref(r.Name, "") == ptr.Deref(rule.Name, "") {
-			return true
+			return i
 		}
 	}
-	return false
+	return -1
+}
+
+// lbRuleUpToDate returns true if the existing rule matches the configurable properties of the desired rule.
+func lbRuleUpToDate(existing, desired armnetwork.LoadBalancingRule) bool {
+	if existing.Properties == nil || desired.Properties == nil {
+		return existing.Properties == desired.Properties
+	}
+	var existingProbeID, desiredProbeID string
+	if existing.Properties.Probe != nil {
+		existingProbeID = ptr.Deref(existing.Properties.Probe.ID, "")
+	}
+	if desired.Properties.Probe != nil {
+		desiredProbeID = ptr.Deref(desired.Properties.Probe.ID, "")
+	}
+	return ptr.Equal(existing.Properties.Protocol, desired.Properties.Protocol) &&
+		ptr.Equal(existing.Properties.FrontendPort, desired.Properties.FrontendPort) &&
+		ptr.Equal(existing.Properties.BackendPort, desired.Properties.BackendPort) &&
+		strings.EqualFold(existingProbeID, desiredProbeID)
+}
+
+func removeLBRule(rules []*armnetwork.LoadBalancingRule, name string) ([]*armnetwork.LoadBalancingRule, bool) {
+	i := lbRuleIndex(rules, armnetwork.LoadBalancingRule{Name: ptr.To(name)})
+	if i < 0 {
+		return rules, false
+	}
+	return append(rules[:i:i], rules[i+1:]...), true
+}
+
+// frontendIPIndex returns the index of the frontend IP configuration with the same name as config, or -1.
+func frontendIPIndex(configs []*armnetwork.FrontendIPConfiguration, config armnetwork.FrontendIPConfiguration) int {
+	for i, ip := range configs {
+		if ptr.Deref(ip.Name, "") == ptr.Deref(config.Name, "") {
+			return i
+		}
+	}
+	return -1
+}
+
+// gatewayLoadBalancerUpToDate returns whether an existing frontend IP configuration is chained to the wanted Gateway
+// Load Balancer frontend IP configuration, if any.
+func gatewayLoadBalancerUpToDate(existing, wanted armnetwork.FrontendIPConfiguration) bool {
+	gatewayID := func(config armnetwork.FrontendIPConfiguration) string {
+		if config.Properties == nil || config.Properties.GatewayLoadBalancer == nil {
+			return ""
+		}
+		return ptr.Deref(config.Properties.GatewayLoadBalancer.ID, "")
+	}
+	return strings.EqualFold(gatewayID(existing), gatewayID(wanted))
+}
+
+// withGatewayLoadBalancer returns a copy of a frontend IP configuration chained to the given Gateway Load Balancer
+// frontend IP configuration, or to none if it is nil.
+func withGatewayLoadBalancer(config armnetwork.FrontendIPConfiguration, gatewayLoadBalancer *armnetwork.SubResource) *armnetwork.FrontendIPConfiguration {
+	properties := armnetwork.FrontendIPConfigurationPropertiesFormat{}
+	if config.Properties != nil {
+		properties = *config.Properties
+	}
+	properties.GatewayLoadBalancer = gatewayLoadBalancer
+	config.Properties = &properties
+	return &config
 }
 
 func ipExists(configs []*armnetwork.FrontendIPConfiguration, config armnetwork.FrontendIPConfiguration) bool {
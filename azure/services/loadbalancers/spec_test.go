@@ -61,6 +61,92 @@ func getExistingLBWithMissingOutboundRules() armnetwork.LoadBalancer {
 	return existingLB
 }
 
+func getExistingLBWithAdditionalRule(name string, backendPort int32) armnetwork.LoadBalancer {
+	existingLB := newSamplePublicAPIServerLB(false, false, false, false, false)
+	existingLB.Properties.LoadBalancingRules = append(existingLB.Properties.LoadBalancingRules, newSampleAdditionalRule(name, backendPort))
+	existingLB.Properties.Probes = append(existingLB.Properties.Probes, newSampleAdditionalRuleProbe(name, backendPort))
+
+	return existingLB
+}
+
+func newSampleAdditionalRule(name string, backendPort int32) *armnetwork.LoadBalancingRule {
+	return &armnetwork.LoadBalancingRule{
+		Name: ptr.To(name),
+		Properties: &armnetwork.LoadBalancingRulePropertiesFormat{
+			DisableOutboundSnat:  ptr.To(true),
+			Protocol:             ptr.To(armnetwork.TransportProtocolTCP),
+			FrontendPort:         ptr.To[int32](8132),
+			BackendPort:          ptr.To(backendPort),
+			IdleTimeoutInMinutes: ptr.To[int32](4),
+			EnableFloatingIP:     ptr.To(false),
+			LoadDistribution:     ptr.To(armnetwork.LoadDistributionDefault),
+			FrontendIPConfiguration: &armnetwork.SubResource{
+				ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/frontendIPConfigurations/my-publiclb-frontEnd"),
+			},
+			BackendAddressPool: &armnetwork.SubResource{
+				ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/backendAddressPools/my-publiclb-backendPool"),
+			},
+			Probe: &armnetwork.SubResource{
+				ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/loadBalancers/my-publiclb/probes/" + name + "-probe"),
+			},
+		},
+	}
+}
+
+func newSampleAdditionalRuleProbe(name string, port int32) *armnetwork.Probe {
+	return &armnetwork.Probe{
+		Name: ptr.To(name + "-probe"),
+		Properties: &armnetwork.ProbePropertiesFormat{
+			Protocol:          ptr.To(armnetwork.ProbeProtocolTCP),
+			Port:              ptr.To(port),
+			IntervalInSeconds: ptr.To[int32](15),
+			NumberOfProbes:    ptr.To[int32](4),
+		},
+	}
+}
+
+func getExistingLBWithConfiguredProbe() armnetwork.LoadBalancer {
+	existingLB := newSamplePublicAPIServerLB(false, false, false, false, false)
+	existingLB.Properties.Probes[0].Properties = &armnetwork.ProbePropertiesFormat{
+		Protocol:          ptr.To(armnetwork.ProbeProtocolTCP),
+		Port:              ptr.To[int32](6443),
+		IntervalInSeconds: ptr.To[int32](5),
+		NumberOfProbes:    ptr.To[int32](2),
+	}
+
+	return existingLB
+}
+
+func getPublicAPILBSpecWithHealthProbe() *LBSpec {
+	spec := fakePublicAPILBSpec
+	spec.HealthProbe = &infrav1.LoadBalancerProbe{
+		Protocol:          infrav1.LoadBalancerProbeProtocolTCP,
+		IntervalInSeconds: ptr.To[int32](5),
+		NumberOfProbes:    ptr.To[int32](2),
+	}
+	return &spec
+}
+
+func getPublicAPILBSpecWithGatewayLoadBalancer() *LBSpec {
+	spec := fakePublicAPILBSpec
+	spec.FrontendIPConfigs = []infrav1.FrontendIP{
+		{
+			Name:                            "my-publiclb-frontEnd",
+			PublicIP:                        fakePublicAPILBSpec.FrontendIPConfigs[0].PublicIP,
+			GatewayLoadBalancerFrontendIPID: fakeGatewayLoadBalancerFrontendIPID,
+		},
+	}
+	return &spec
+}
+
+func getExistingLBWithGatewayLoadBalancer() armnetwork.LoadBalancer {
+	existingLB := newSamplePublicAPIServerLB(false, false, false, false, false)
+	existingLB.Properties.FrontendIPConfigurations[0].Properties.GatewayLoadBalancer = &armnetwork.SubResource{
+		ID: ptr.To(fakeGatewayLoadBalancerFrontendIPID),
+	}
+	return existingLB
+}
+
 func TestParameters(t *testing.T) {
 	testcases := []struct {
 		name          string
@@ -102,7 +188,7 @@ func TestParameters(t *testing.T) {
 			existing: getExistingLBWithMissingFrontendIPConfigs(),
 			expect: func(g *WithT, result interface{}) {
 				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
-				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(newSamplePublicAPIServerLB(false, true, true, true, true)))
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(newSamplePublicAPIServerLB(false, true, true, false, true)))
 			},
 			expectedError: "",
 		},
@@ -112,7 +198,7 @@ func TestParameters(t *testing.T) {
 			existing: getExistingLBWithMissingBackendPool(),
 			expect: func(g *WithT, result interface{}) {
 				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
-				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(newSamplePublicAPIServerLB(true, false, true, true, true)))
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(newSamplePublicAPIServerLB(true, false, true, false, true)))
 			},
 			expectedError: "",
 		},
@@ -122,7 +208,7 @@ func TestParameters(t *testing.T) {
 			existing: getExistingLBWithMissingLBRules(),
 			expect: func(g *WithT, result interface{}) {
 				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
-				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(newSamplePublicAPIServerLB(true, true, false, true, true)))
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(newSamplePublicAPIServerLB(true, true, false, false, true)))
 			},
 			expectedError: "",
 		},
@@ -142,7 +228,134 @@ func TestParameters(t *testing.T) {
 			existing: getExistingLBWithMissingOutboundRules(),
 			expect: func(g *WithT, result interface{}) {
 				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
-				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(newSamplePublicAPIServerLB(true, true, true, true, false)))
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(newSamplePublicAPIServerLB(true, true, true, false, false)))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "public API load balancer with a configured health probe updates the existing probe",
+			spec:     getPublicAPILBSpecWithHealthProbe(),
+			existing: newSamplePublicAPIServerLB(false, false, false, false, false),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				expected := newSamplePublicAPIServerLB(false, false, false, false, false)
+				expected.Properties.Probes = []*armnetwork.Probe{
+					{
+						Name: ptr.To(httpsProbe),
+						Properties: &armnetwork.ProbePropertiesFormat{
+							Protocol:          ptr.To(armnetwork.ProbeProtocolTCP),
+							Port:              ptr.To[int32](6443),
+							IntervalInSeconds: ptr.To[int32](5),
+							NumberOfProbes:    ptr.To[int32](2),
+						},
+					},
+				}
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(expected))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "public API load balancer without a configured health probe restores the default probe",
+			spec:     &fakePublicAPILBSpec,
+			existing: getExistingLBWithConfiguredProbe(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(newSamplePublicAPIServerLB(false, false, false, false, false)))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "public API load balancer with a configured health probe that is up to date",
+			spec:     getPublicAPILBSpecWithHealthProbe(),
+			existing: getExistingLBWithConfiguredProbe(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name:     "public API load balancer chained to a gateway load balancer updates the existing frontend IP",
+			spec:     getPublicAPILBSpecWithGatewayLoadBalancer(),
+			existing: newSamplePublicAPIServerLB(false, false, false, false, false),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(getExistingLBWithGatewayLoadBalancer()))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "public API load balancer chained to a gateway load balancer that is up to date",
+			spec:     getPublicAPILBSpecWithGatewayLoadBalancer(),
+			existing: getExistingLBWithGatewayLoadBalancer(),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name:     "public API load balancer does not exist with a gateway load balancer",
+			spec:     getPublicAPILBSpecWithGatewayLoadBalancer(),
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				lb := result.(armnetwork.LoadBalancer)
+				g.Expect(lb.Properties.FrontendIPConfigurations).To(HaveLen(1))
+				g.Expect(lb.Properties.FrontendIPConfigurations[0].Properties.GatewayLoadBalancer).To(Equal(&armnetwork.SubResource{
+					ID: ptr.To(fakeGatewayLoadBalancerFrontendIPID),
+				}))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "load balancer exists with missing additional rules",
+			spec:     &fakeAPILBSpecWithAdditionalRules,
+			existing: newSamplePublicAPIServerLB(false, false, false, false, false),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(getExistingLBWithAdditionalRule("konnectivity", 8132)))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "load balancer exists with all expected additional rules",
+			spec:     &fakeAPILBSpecWithAdditionalRules,
+			existing: getExistingLBWithAdditionalRule("konnectivity", 8132),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name:     "load balancer exists with an outdated additional rule",
+			spec:     &fakeAPILBSpecWithAdditionalRules,
+			existing: getExistingLBWithAdditionalRule("konnectivity", 8133),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(getExistingLBWithAdditionalRule("konnectivity", 8132)))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "load balancer exists with a previously applied rule that is no longer desired",
+			spec:     &fakeAPILBSpecWithRemovedRules,
+			existing: getExistingLBWithAdditionalRule("ingress", 443),
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				g.Expect(result.(armnetwork.LoadBalancer)).To(Equal(newSamplePublicAPIServerLB(false, false, false, false, false)))
+			},
+			expectedError: "",
+		},
+		{
+			name:     "load balancer does not exist with additional rules",
+			spec:     &fakeAPILBSpecWithAdditionalRules,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.LoadBalancer{}))
+				lb := result.(armnetwork.LoadBalancer)
+				g.Expect(lb.Properties.LoadBalancingRules).To(HaveLen(2))
+				g.Expect(lb.Properties.LoadBalancingRules[1]).To(Equal(newSampleAdditionalRule("konnectivity", 8132)))
+				g.Expect(lb.Properties.Probes).To(HaveLen(2))
+				g.Expect(lb.Properties.Probes[1]).To(Equal(newSampleAdditionalRuleProbe("konnectivity", 8132)))
 			},
 			expectedError: "",
 		},
@@ -62,6 +62,7 @@ type ManagedClusterScope interface {
 	IsAADEnabled() bool
 	AreLocalAccountsDisabled() bool
 	SetOIDCIssuerProfileStatus(*infrav1.OIDCIssuerProfileStatus)
+	SetStorageProfileStatus(*infrav1.ManagedClusterStorageProfileStatus)
 	MakeClusterCA() *corev1.Secret
 	StoreClusterInfo(context.Context, []byte) error
 	SetAutoUpgradeVersionStatus(version string)
@@ -120,6 +121,7 @@ func postCreateOrUpdateResourceHook(ctx context.Context, scope ManagedClusterSco
 			IssuerURL: managedCluster.Status.OidcIssuerProfile.IssuerURL,
 		})
 	}
+	scope.SetStorageProfileStatus(getStorageProfileStatus(managedCluster.Status.StorageProfile))
 	if managedCluster.Status.CurrentKubernetesVersion != nil {
 		currentKubernetesVersion := fmt.Sprintf("v%s", *managedCluster.Status.CurrentKubernetesVersion)
 		scope.SetVersionStatus(currentKubernetesVersion)
@@ -131,6 +133,27 @@ func postCreateOrUpdateResourceHook(ctx context.Context, scope ManagedClusterSco
 	return nil
 }
 
+// getStorageProfileStatus reports which CSI drivers and storage features are enabled on the managed cluster.
+func getStorageProfileStatus(storageProfile *asocontainerservicev1hub.ManagedClusterStorageProfile_STATUS) *infrav1.ManagedClusterStorageProfileStatus {
+	if storageProfile == nil {
+		return nil
+	}
+	status := &infrav1.ManagedClusterStorageProfileStatus{}
+	if storageProfile.BlobCSIDriver != nil {
+		status.BlobCSIDriverEnabled = ptr.Deref(storageProfile.BlobCSIDriver.Enabled, false)
+	}
+	if storageProfile.DiskCSIDriver != nil {
+		status.DiskCSIDriverEnabled = ptr.Deref(storageProfile.DiskCSIDriver.Enabled, false)
+	}
+	if storageProfile.FileCSIDriver != nil {
+		status.FileCSIDriverEnabled = ptr.Deref(storageProfile.FileCSIDriver.Enabled, false)
+	}
+	if storageProfile.SnapshotController != nil {
+		status.SnapshotControllerEnabled = ptr.Deref(storageProfile.SnapshotController.Enabled, false)
+	}
+	return status
+}
+
 // reconcileKubeconfig will reconcile admin kubeconfig and user kubeconfig.
 /*
   Returns the admin kubeconfig and user kubeconfig
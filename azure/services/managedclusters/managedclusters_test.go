@@ -66,6 +66,14 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 				OidcIssuerProfile: &asocontainerservicev1.ManagedClusterOIDCIssuerProfile_STATUS{
 					IssuerURL: ptr.To("oidc"),
 				},
+				StorageProfile: &asocontainerservicev1.ManagedClusterStorageProfile_STATUS{
+					BlobCSIDriver: &asocontainerservicev1.ManagedClusterStorageProfileBlobCSIDriver_STATUS{
+						Enabled: ptr.To(true),
+					},
+					DiskCSIDriver: &asocontainerservicev1.ManagedClusterStorageProfileDiskCSIDriver_STATUS{
+						Enabled: ptr.To(true),
+					},
+				},
 				CurrentKubernetesVersion: ptr.To("1.19.0"),
 			},
 		}
@@ -95,6 +103,14 @@ func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 				OidcIssuerProfile: &asocontainerservicev1preview.ManagedClusterOIDCIssuerProfile_STATUS{
 					IssuerURL: ptr.To("oidc"),
 				},
+				StorageProfile: &asocontainerservicev1preview.ManagedClusterStorageProfile_STATUS{
+					BlobCSIDriver: &asocontainerservicev1preview.ManagedClusterStorageProfileBlobCSIDriver_STATUS{
+						Enabled: ptr.To(true),
+					},
+					DiskCSIDriver: &asocontainerservicev1preview.ManagedClusterStorageProfileDiskCSIDriver_STATUS{
+						Enabled: ptr.To(true),
+					},
+				},
 				CurrentKubernetesVersion: ptr.To("1.19.0"),
 			},
 		}
@@ -183,6 +199,10 @@ func setupMockScope(t *testing.T) *mock_managedclusters.MockManagedClusterScope
 	scope.EXPECT().SetOIDCIssuerProfileStatus(&infrav1.OIDCIssuerProfileStatus{
 		IssuerURL: ptr.To("oidc"),
 	})
+	scope.EXPECT().SetStorageProfileStatus(&infrav1.ManagedClusterStorageProfileStatus{
+		BlobCSIDriverEnabled: true,
+		DiskCSIDriverEnabled: true,
+	})
 	scope.EXPECT().SetVersionStatus("v1.19.0")
 	scope.EXPECT().IsManagedVersionUpgrade().Return(true)
 	scope.EXPECT().SetAutoUpgradeVersionStatus("v1.19.0")
@@ -415,6 +415,18 @@ func (mr *MockManagedClusterScopeMockRecorder) SetOIDCIssuerProfileStatus(arg0 a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetOIDCIssuerProfileStatus", reflect.TypeOf((*MockManagedClusterScope)(nil).SetOIDCIssuerProfileStatus), arg0)
 }
 
+// SetStorageProfileStatus mocks base method.
+func (m *MockManagedClusterScope) SetStorageProfileStatus(arg0 *v1beta1.ManagedClusterStorageProfileStatus) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetStorageProfileStatus", arg0)
+}
+
+// SetStorageProfileStatus indicates an expected call of SetStorageProfileStatus.
+func (mr *MockManagedClusterScopeMockRecorder) SetStorageProfileStatus(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetStorageProfileStatus", reflect.TypeOf((*MockManagedClusterScope)(nil).SetStorageProfileStatus), arg0)
+}
+
 // SetUserKubeconfigData mocks base method.
 func (m *MockManagedClusterScope) SetUserKubeconfigData(arg0 []byte) {
 	m.ctrl.T.Helper()
@@ -142,6 +142,18 @@ type ManagedClusterSpec struct {
 	// SecurityProfile defines the security profile for the cluster.
 	SecurityProfile *ManagedClusterSecurityProfile
 
+	// ServiceMeshProfile defines the service mesh profile for the cluster.
+	ServiceMeshProfile *ServiceMeshProfile
+
+	// WorkloadAutoScalerProfile defines the workload autoscaler profile for the cluster.
+	WorkloadAutoScalerProfile *ManagedClusterWorkloadAutoScalerProfile
+
+	// AzureMonitorProfile defines the Azure Monitor add-on profiles for the cluster.
+	AzureMonitorProfile *ManagedClusterAzureMonitorProfile
+
+	// StorageProfile defines the CSI drivers and storage features enabled on the cluster.
+	StorageProfile *ManagedClusterStorageProfile
+
 	// Patches are extra patches to be applied to the ASO resource.
 	Patches []string
 
@@ -339,6 +351,232 @@ type AzureKeyVaultKms struct {
 	KeyVaultResourceID *string
 }
 
+// ServiceMeshProfile defines the service mesh profile for the cluster.
+type ServiceMeshProfile struct {
+	// Mode is the mode of the service mesh.
+	Mode string
+
+	// Istio is the Istio service mesh configuration.
+	Istio *IstioServiceMesh
+}
+
+// IstioServiceMesh defines the Istio service mesh configuration.
+type IstioServiceMesh struct {
+	// Revisions is the list of revisions of the Istio control plane.
+	Revisions []string
+
+	// IngressGateways is the list of Istio ingress gateways.
+	IngressGateways []IstioIngressGateway
+
+	// EgressGateways is the list of Istio egress gateways.
+	EgressGateways []IstioEgressGateway
+
+	// PluginCertificateAuthority defines the plug-in CA certificates stored in Azure Key Vault.
+	PluginCertificateAuthority *IstioPluginCertificateAuthority
+}
+
+// IstioIngressGateway defines an Istio ingress gateway.
+type IstioIngressGateway struct {
+	// Mode is the mode of the ingress gateway.
+	Mode string
+
+	// Enabled defines whether the ingress gateway is enabled.
+	Enabled bool
+}
+
+// IstioEgressGateway defines an Istio egress gateway.
+type IstioEgressGateway struct {
+	// Enabled defines whether the egress gateway is enabled.
+	Enabled bool
+
+	// NodeSelector is the node selector for the egress gateway.
+	NodeSelector map[string]string
+}
+
+// IstioPluginCertificateAuthority defines the plug-in CA certificates for the service mesh.
+type IstioPluginCertificateAuthority struct {
+	// KeyVaultResourceID is the resource ID of the Key Vault containing the certificate objects.
+	KeyVaultResourceID string
+
+	// CertObjectName is the intermediate certificate object name in Azure Key Vault.
+	CertObjectName string
+
+	// KeyObjectName is the intermediate certificate private key object name in Azure Key Vault.
+	KeyObjectName string
+
+	// RootCertObjectName is the root certificate object name in Azure Key Vault.
+	RootCertObjectName string
+
+	// CertChainObjectName is the certificate chain object name in Azure Key Vault.
+	CertChainObjectName string
+}
+
+// ManagedClusterWorkloadAutoScalerProfile defines the workload autoscaler profile for the cluster.
+type ManagedClusterWorkloadAutoScalerProfile struct {
+	// KedaEnabled enables KEDA. Nil leaves KEDA unconfigured.
+	KedaEnabled *bool
+
+	// VerticalPodAutoscalerEnabled enables VPA. Nil leaves VPA unconfigured.
+	VerticalPodAutoscalerEnabled *bool
+}
+
+// ManagedClusterAzureMonitorProfile defines the Azure Monitor add-on profiles for the cluster.
+type ManagedClusterAzureMonitorProfile struct {
+	// Metrics defines the metrics profile for the Azure Monitor managed service for Prometheus add-on.
+	Metrics *ManagedClusterAzureMonitorProfileMetrics
+}
+
+// ManagedClusterAzureMonitorProfileMetrics defines the metrics profile for the Azure Monitor managed service for Prometheus add-on.
+type ManagedClusterAzureMonitorProfileMetrics struct {
+	// Enabled enables the Prometheus collector.
+	Enabled bool
+
+	// MetricAnnotationsAllowList is the kube-state-metrics annotation allow list.
+	MetricAnnotationsAllowList *string
+
+	// MetricLabelsAllowlist is the kube-state-metrics label allow list.
+	MetricLabelsAllowlist *string
+}
+
+// ManagedClusterStorageProfile defines the storage profile for the cluster.
+// A nil field leaves the corresponding driver at its AKS default.
+type ManagedClusterStorageProfile struct {
+	// BlobCSIDriverEnabled enables the AzureBlob CSI Driver.
+	BlobCSIDriverEnabled *bool
+
+	// DiskCSIDriverEnabled enables the AzureDisk CSI Driver.
+	DiskCSIDriverEnabled *bool
+
+	// FileCSIDriverEnabled enables the AzureFile CSI Driver.
+	FileCSIDriverEnabled *bool
+
+	// SnapshotControllerEnabled enables the Snapshot Controller.
+	SnapshotControllerEnabled *bool
+}
+
+// buildStorageProfile builds the StorageProfile for the ManagedCluster.
+func buildStorageProfile(profile *ManagedClusterStorageProfile) *asocontainerservicev1hub.ManagedClusterStorageProfile {
+	if profile == nil {
+		return nil
+	}
+
+	mcProfile := &asocontainerservicev1hub.ManagedClusterStorageProfile{}
+	if profile.BlobCSIDriverEnabled != nil {
+		mcProfile.BlobCSIDriver = &asocontainerservicev1hub.ManagedClusterStorageProfileBlobCSIDriver{
+			Enabled: profile.BlobCSIDriverEnabled,
+		}
+	}
+	if profile.DiskCSIDriverEnabled != nil {
+		mcProfile.DiskCSIDriver = &asocontainerservicev1hub.ManagedClusterStorageProfileDiskCSIDriver{
+			Enabled: profile.DiskCSIDriverEnabled,
+		}
+	}
+	if profile.FileCSIDriverEnabled != nil {
+		mcProfile.FileCSIDriver = &asocontainerservicev1hub.ManagedClusterStorageProfileFileCSIDriver{
+			Enabled: profile.FileCSIDriverEnabled,
+		}
+	}
+	if profile.SnapshotControllerEnabled != nil {
+		mcProfile.SnapshotController = &asocontainerservicev1hub.ManagedClusterStorageProfileSnapshotController{
+			Enabled: profile.SnapshotControllerEnabled,
+		}
+	}
+
+	return mcProfile
+}
+
+// buildWorkloadAutoScalerProfile builds the WorkloadAutoScalerProfile for the ManagedCluster.
+func buildWorkloadAutoScalerProfile(profile *ManagedClusterWorkloadAutoScalerProfile) *asocontainerservicev1hub.ManagedClusterWorkloadAutoScalerProfile {
+	if profile == nil {
+		return nil
+	}
+
+	mcProfile := &asocontainerservicev1hub.ManagedClusterWorkloadAutoScalerProfile{}
+	if profile.KedaEnabled != nil {
+		mcProfile.Keda = &asocontainerservicev1hub.ManagedClusterWorkloadAutoScalerProfileKeda{
+			Enabled: profile.KedaEnabled,
+		}
+	}
+	if profile.VerticalPodAutoscalerEnabled != nil {
+		mcProfile.VerticalPodAutoscaler = &asocontainerservicev1hub.ManagedClusterWorkloadAutoScalerProfileVerticalPodAutoscaler{
+			Enabled: profile.VerticalPodAutoscalerEnabled,
+		}
+	}
+
+	return mcProfile
+}
+
+// buildAzureMonitorProfile builds the AzureMonitorProfile for the ManagedCluster.
+func buildAzureMonitorProfile(profile *ManagedClusterAzureMonitorProfile) *asocontainerservicev1hub.ManagedClusterAzureMonitorProfile {
+	if profile == nil {
+		return nil
+	}
+
+	mcProfile := &asocontainerservicev1hub.ManagedClusterAzureMonitorProfile{}
+	if profile.Metrics != nil {
+		mcProfile.Metrics = &asocontainerservicev1hub.ManagedClusterAzureMonitorProfileMetrics{
+			Enabled: ptr.To(profile.Metrics.Enabled),
+		}
+		if profile.Metrics.MetricAnnotationsAllowList != nil || profile.Metrics.MetricLabelsAllowlist != nil {
+			mcProfile.Metrics.KubeStateMetrics = &asocontainerservicev1hub.ManagedClusterAzureMonitorProfileKubeStateMetrics{
+				MetricAnnotationsAllowList: profile.Metrics.MetricAnnotationsAllowList,
+				MetricLabelsAllowlist:      profile.Metrics.MetricLabelsAllowlist,
+			}
+		}
+	}
+
+	return mcProfile
+}
+
+// buildServiceMeshProfile builds the ServiceMeshProfile for the ManagedCluster.
+func buildServiceMeshProfile(serviceMeshProfile *ServiceMeshProfile) *asocontainerservicev1hub.ServiceMeshProfile {
+	if serviceMeshProfile == nil {
+		return nil
+	}
+
+	mcServiceMeshProfile := &asocontainerservicev1hub.ServiceMeshProfile{
+		Mode: ptr.To(string(asocontainerservicev1.ServiceMeshProfile_Mode(serviceMeshProfile.Mode))),
+	}
+	if serviceMeshProfile.Istio == nil {
+		return mcServiceMeshProfile
+	}
+
+	istio := &asocontainerservicev1hub.IstioServiceMesh{
+		Revisions: serviceMeshProfile.Istio.Revisions,
+	}
+	if len(serviceMeshProfile.Istio.IngressGateways) > 0 || len(serviceMeshProfile.Istio.EgressGateways) > 0 {
+		istio.Components = &asocontainerservicev1hub.IstioComponents{}
+		for _, gateway := range serviceMeshProfile.Istio.IngressGateways {
+			istio.Components.IngressGateways = append(istio.Components.IngressGateways, asocontainerservicev1hub.IstioIngressGateway{
+				Enabled: ptr.To(gateway.Enabled),
+				Mode:    ptr.To(string(asocontainerservicev1.IstioIngressGateway_Mode(gateway.Mode))),
+			})
+		}
+		for _, gateway := range serviceMeshProfile.Istio.EgressGateways {
+			istio.Components.EgressGateways = append(istio.Components.EgressGateways, asocontainerservicev1hub.IstioEgressGateway{
+				Enabled:      ptr.To(gateway.Enabled),
+				NodeSelector: gateway.NodeSelector,
+			})
+		}
+	}
+	if plugin := serviceMeshProfile.Istio.PluginCertificateAuthority; plugin != nil {
+		istio.CertificateAuthority = &asocontainerservicev1hub.IstioCertificateAuthority{
+			Plugin: &asocontainerservicev1hub.IstioPluginCertificateAuthority{
+				KeyVaultReference: &genruntime.ResourceReference{
+					ARMID: plugin.KeyVaultResourceID,
+				},
+				CertObjectName:      ptr.To(plugin.CertObjectName),
+				KeyObjectName:       ptr.To(plugin.KeyObjectName),
+				RootCertObjectName:  ptr.To(plugin.RootCertObjectName),
+				CertChainObjectName: ptr.To(plugin.CertChainObjectName),
+			},
+		}
+	}
+	mcServiceMeshProfile.Istio = istio
+
+	return mcServiceMeshProfile
+}
+
 // buildAutoScalerProfile builds the AutoScalerProfile for the ManagedClusterProperties.
 func buildAutoScalerProfile(autoScalerProfile *AutoScalerProfile) *asocontainerservicev1hub.ManagedClusterProperties_AutoScalerProfile {
 	if autoScalerProfile == nil {
@@ -673,6 +911,22 @@ func (s *ManagedClusterSpec) Parameters(ctx context.Context, existingObj genrunt
 		managedCluster.Spec.SecurityProfile = securityProfile
 	}
 
+	if s.ServiceMeshProfile != nil {
+		managedCluster.Spec.ServiceMeshProfile = buildServiceMeshProfile(s.ServiceMeshProfile)
+	}
+
+	if s.WorkloadAutoScalerProfile != nil {
+		managedCluster.Spec.WorkloadAutoScalerProfile = buildWorkloadAutoScalerProfile(s.WorkloadAutoScalerProfile)
+	}
+
+	if s.AzureMonitorProfile != nil {
+		managedCluster.Spec.AzureMonitorProfile = buildAzureMonitorProfile(s.AzureMonitorProfile)
+	}
+
+	if s.StorageProfile != nil {
+		managedCluster.Spec.StorageProfile = buildStorageProfile(s.StorageProfile)
+	}
+
 	// Only include AgentPoolProfiles during initial cluster creation. Agent pools are managed solely by the
 	// AzureManagedMachinePool controller thereafter.
 	var prevAgentPoolProfiles []asocontainerservicev1hub.ManagedClusterAgentPoolProfile
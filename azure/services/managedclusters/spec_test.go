@@ -125,6 +125,35 @@ func TestParameters(t *testing.T) {
 					Enabled: ptr.To(true),
 				},
 			},
+			ServiceMeshProfile: &ServiceMeshProfile{
+				Mode: "Istio",
+				Istio: &IstioServiceMesh{
+					Revisions: []string{"asm-1-19"},
+					IngressGateways: []IstioIngressGateway{
+						{Mode: "External", Enabled: true},
+					},
+					PluginCertificateAuthority: &IstioPluginCertificateAuthority{
+						KeyVaultResourceID:  "KeyVaultResourceID",
+						CertObjectName:      "ca-cert",
+						KeyObjectName:       "ca-key",
+						RootCertObjectName:  "root-cert",
+						CertChainObjectName: "cert-chain",
+					},
+				},
+			},
+			WorkloadAutoScalerProfile: &ManagedClusterWorkloadAutoScalerProfile{
+				KedaEnabled: ptr.To(true),
+			},
+			AzureMonitorProfile: &ManagedClusterAzureMonitorProfile{
+				Metrics: &ManagedClusterAzureMonitorProfileMetrics{
+					Enabled:               true,
+					MetricLabelsAllowlist: ptr.To("namespaces=[k8s-label-1]"),
+				},
+			},
+			StorageProfile: &ManagedClusterStorageProfile{
+				BlobCSIDriverEnabled:      ptr.To(true),
+				SnapshotControllerEnabled: ptr.To(false),
+			},
 		}
 
 		expected := &asocontainerservicev1.ManagedCluster{
@@ -283,6 +312,52 @@ func TestParameters(t *testing.T) {
 						Enabled: ptr.To(true),
 					},
 				},
+				ServiceMeshProfile: &asocontainerservicev1.ServiceMeshProfile{
+					Mode: ptr.To(asocontainerservicev1.ServiceMeshProfile_Mode_Istio),
+					Istio: &asocontainerservicev1.IstioServiceMesh{
+						Revisions: []string{"asm-1-19"},
+						Components: &asocontainerservicev1.IstioComponents{
+							IngressGateways: []asocontainerservicev1.IstioIngressGateway{
+								{
+									Enabled: ptr.To(true),
+									Mode:    ptr.To(asocontainerservicev1.IstioIngressGateway_Mode_External),
+								},
+							},
+						},
+						CertificateAuthority: &asocontainerservicev1.IstioCertificateAuthority{
+							Plugin: &asocontainerservicev1.IstioPluginCertificateAuthority{
+								KeyVaultReference: &genruntime.ResourceReference{
+									ARMID: "KeyVaultResourceID",
+								},
+								CertObjectName:      ptr.To("ca-cert"),
+								KeyObjectName:       ptr.To("ca-key"),
+								RootCertObjectName:  ptr.To("root-cert"),
+								CertChainObjectName: ptr.To("cert-chain"),
+							},
+						},
+					},
+				},
+				WorkloadAutoScalerProfile: &asocontainerservicev1.ManagedClusterWorkloadAutoScalerProfile{
+					Keda: &asocontainerservicev1.ManagedClusterWorkloadAutoScalerProfileKeda{
+						Enabled: ptr.To(true),
+					},
+				},
+				AzureMonitorProfile: &asocontainerservicev1.ManagedClusterAzureMonitorProfile{
+					Metrics: &asocontainerservicev1.ManagedClusterAzureMonitorProfileMetrics{
+						Enabled: ptr.To(true),
+						KubeStateMetrics: &asocontainerservicev1.ManagedClusterAzureMonitorProfileKubeStateMetrics{
+							MetricLabelsAllowlist: ptr.To("namespaces=[k8s-label-1]"),
+						},
+					},
+				},
+				StorageProfile: &asocontainerservicev1.ManagedClusterStorageProfile{
+					BlobCSIDriver: &asocontainerservicev1.ManagedClusterStorageProfileBlobCSIDriver{
+						Enabled: ptr.To(true),
+					},
+					SnapshotController: &asocontainerservicev1.ManagedClusterStorageProfileSnapshotController{
+						Enabled: ptr.To(false),
+					},
+				},
 			},
 		}
 
@@ -34,6 +34,10 @@ type NatGatewaySpec struct {
 	SubscriptionID string
 	Location       string
 	NatGatewayIP   infrav1.PublicIPSpec
+	AdditionalIPs  []infrav1.PublicIPSpec
+	IPPrefixName   string
+	IPPrefixID     string
+	IdleTimeout    *int32
 	ClusterName    string
 	AdditionalTags infrav1.Tags
 	IsVnetManaged  bool
@@ -68,10 +72,34 @@ func (s *NatGatewaySpec) Parameters(ctx context.Context, existingNatGateway *aso
 	natGateway.Spec.PublicIpAddresses = []asonetworkv1.ApplicationGatewaySubResource{
 		{
 			Reference: &genruntime.ResourceReference{
-				ARMID: azure.PublicIPID(s.SubscriptionID, s.ResourceGroup, s.NatGatewayIP.Name),
+				ARMID: azure.PublicIPSpecID(s.NatGatewayIP, s.SubscriptionID, s.ResourceGroup),
 			},
 		},
 	}
+	for _, ip := range s.AdditionalIPs {
+		natGateway.Spec.PublicIpAddresses = append(natGateway.Spec.PublicIpAddresses, asonetworkv1.ApplicationGatewaySubResource{
+			Reference: &genruntime.ResourceReference{
+				ARMID: azure.PublicIPSpecID(ip, s.SubscriptionID, s.ResourceGroup),
+			},
+		})
+	}
+	natGateway.Spec.PublicIpPrefixes = nil
+	if s.IPPrefixName != "" {
+		ipPrefixID := s.IPPrefixID
+		if ipPrefixID == "" {
+			ipPrefixID = azure.PublicIPPrefixID(s.SubscriptionID, s.ResourceGroup, s.IPPrefixName)
+		}
+		natGateway.Spec.PublicIpPrefixes = []asonetworkv1.ApplicationGatewaySubResource{
+			{
+				Reference: &genruntime.ResourceReference{
+					ARMID: ipPrefixID,
+				},
+			},
+		}
+	}
+	if s.IdleTimeout != nil {
+		natGateway.Spec.IdleTimeoutInMinutes = ptr.To(int(*s.IdleTimeout))
+	}
 	natGateway.Spec.Tags = infrav1.Build(infrav1.BuildParams{
 		ClusterName: s.ClusterName,
 		Lifecycle:   infrav1.ResourceLifecycleOwned,
@@ -43,6 +43,23 @@ var (
 		IsVnetManaged:  true,
 		AdditionalTags: infrav1.Tags{},
 	}
+	fakeNatGatewaySpecWithMultipleIPs = &NatGatewaySpec{
+		Name:           "my-natgateway",
+		ResourceGroup:  "my-rg",
+		SubscriptionID: "123",
+		Location:       "eastus",
+		NatGatewayIP: infrav1.PublicIPSpec{
+			Name: "my-natgateway-ip",
+		},
+		AdditionalIPs: []infrav1.PublicIPSpec{
+			{Name: "my-natgateway-ip-1"},
+		},
+		IPPrefixName:   "my-natgateway-ip-prefix",
+		IdleTimeout:    ptr.To[int32](30),
+		ClusterName:    "my-cluster",
+		IsVnetManaged:  true,
+		AdditionalTags: infrav1.Tags{},
+	}
 	locationPtr        = ptr.To("eastus")
 	standardSKUPtr     = ptr.To(asonetworkv1.NatGatewaySku_Name_Standard)
 	existingNatGateway = &asonetworkv1.NatGateway{
@@ -94,6 +111,18 @@ var (
 	}
 )
 
+func getExistingNatGatewayWithIPPrefix() *asonetworkv1.NatGateway {
+	natGateway := existingNatGateway.DeepCopy()
+	natGateway.Spec.PublicIpPrefixes = []asonetworkv1.ApplicationGatewaySubResource{
+		{
+			Reference: &genruntime.ResourceReference{
+				ARMID: "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicipprefixes/my-natgateway-ip-prefix",
+			},
+		},
+	}
+	return natGateway
+}
+
 func TestParameters(t *testing.T) {
 	testcases := []struct {
 		name         string
@@ -122,6 +151,54 @@ func TestParameters(t *testing.T) {
 				g.Expect(parameters.Spec.Tags).To(HaveKeyWithValue("sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster", "owned"))
 			},
 		},
+		{
+			name:         "create a new NAT Gateway spec with additional public IPs, a public IP prefix and an idle timeout",
+			spec:         fakeNatGatewaySpecWithMultipleIPs,
+			existingSpec: nil,
+			expect: func(g *WithT, existing *asonetworkv1.NatGateway, parameters *asonetworkv1.NatGateway) {
+				g.Expect(parameters).NotTo(BeNil())
+				g.Expect(parameters.Spec.PublicIpAddresses).To(HaveLen(2))
+				g.Expect(parameters.Spec.PublicIpAddresses[0].Reference.ARMID).To(Equal("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPAddresses/my-natgateway-ip"))
+				g.Expect(parameters.Spec.PublicIpAddresses[1].Reference.ARMID).To(Equal("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicIPAddresses/my-natgateway-ip-1"))
+				g.Expect(parameters.Spec.PublicIpPrefixes).To(HaveLen(1))
+				g.Expect(parameters.Spec.PublicIpPrefixes[0].Reference.ARMID).To(Equal("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/publicipprefixes/my-natgateway-ip-prefix"))
+				g.Expect(parameters.Spec.IdleTimeoutInMinutes).To(Equal(ptr.To(30)))
+			},
+		},
+		{
+			name: "create a new NAT Gateway spec referencing an existing public IP and public IP prefix",
+			spec: &NatGatewaySpec{
+				Name:           "my-natgateway",
+				ResourceGroup:  "my-rg",
+				SubscriptionID: "123",
+				Location:       "eastus",
+				NatGatewayIP: infrav1.PublicIPSpec{
+					Name: "reserved-ip",
+					ID:   "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip",
+				},
+				IPPrefixName:   "reserved-prefix",
+				IPPrefixID:     "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPPrefixes/reserved-prefix",
+				ClusterName:    "my-cluster",
+				IsVnetManaged:  true,
+				AdditionalTags: infrav1.Tags{},
+			},
+			existingSpec: nil,
+			expect: func(g *WithT, existing *asonetworkv1.NatGateway, parameters *asonetworkv1.NatGateway) {
+				g.Expect(parameters.Spec.PublicIpAddresses).To(HaveLen(1))
+				g.Expect(parameters.Spec.PublicIpAddresses[0].Reference.ARMID).To(Equal("/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip"))
+				g.Expect(parameters.Spec.PublicIpPrefixes).To(HaveLen(1))
+				g.Expect(parameters.Spec.PublicIpPrefixes[0].Reference.ARMID).To(Equal("/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPPrefixes/reserved-prefix"))
+			},
+		},
+		{
+			name:         "reconcile a NAT Gateway spec when there is an existing aso resource and a public IP prefix was removed",
+			spec:         fakeNatGatewaySpec,
+			existingSpec: getExistingNatGatewayWithIPPrefix(),
+			expect: func(g *WithT, existing *asonetworkv1.NatGateway, parameters *asonetworkv1.NatGateway) {
+				g.Expect(parameters.Spec.PublicIpPrefixes).To(BeEmpty())
+				g.Expect(parameters.Spec.IdleTimeoutInMinutes).To(Equal(ptr.To(6)))
+			},
+		},
 		{
 			name:         "reconcile a NAT Gateway spec when there is an existing aso resource. User added extra spec fields",
 			spec:         fakeNatGatewaySpec,
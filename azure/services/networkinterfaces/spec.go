@@ -19,6 +19,7 @@ package networkinterfaces
 import (
 	"context"
 	"strconv"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/pkg/errors"
@@ -55,6 +56,8 @@ type NICSpec struct {
 	AdditionalTags            infrav1.Tags
 	ClusterName               string
 	IPConfigs                 []IPConfig
+	// ApplicationSecurityGroupIDs are the resource IDs of the application security groups the IP configurations join.
+	ApplicationSecurityGroupIDs []string
 }
 
 // IPConfig defines the specification for an IP address configuration.
@@ -81,11 +84,12 @@ func (s *NICSpec) OwnerResourceName() string {
 // Parameters returns the parameters for the network interface.
 func (s *NICSpec) Parameters(ctx context.Context, existing interface{}) (parameters interface{}, err error) {
 	if existing != nil {
-		if _, ok := existing.(armnetwork.Interface); !ok {
+		existingNIC, ok := existing.(armnetwork.Interface)
+		if !ok {
 			return nil, errors.Errorf("%T is not an armnetwork.Interface", existing)
 		}
-		// network interface already exists
-		return nil, nil
+		// network interface already exists, only its application security group membership is reconciled
+		return s.applicationSecurityGroupsUpdate(existingNIC), nil
 	}
 
 	primaryIPConfig := &armnetwork.InterfaceIPConfigurationPropertiesFormat{
@@ -201,6 +205,17 @@ func (s *NICSpec) Parameters(ctx context.Context, existing interface{}) (paramet
 		ipConfigurations = append(ipConfigurations, ipv6Config)
 	}
 
+	if len(s.ApplicationSecurityGroupIDs) > 0 {
+		// All the IP configurations of a network interface must be members of the same application security groups.
+		asgs := make([]*armnetwork.ApplicationSecurityGroup, 0, len(s.ApplicationSecurityGroupIDs))
+		for _, id := range s.ApplicationSecurityGroupIDs {
+			asgs = append(asgs, &armnetwork.ApplicationSecurityGroup{ID: ptr.To(id)})
+		}
+		for _, config := range ipConfigurations {
+			config.Properties.ApplicationSecurityGroups = asgs
+		}
+	}
+
 	return armnetwork.Interface{
 		Location:         ptr.To(s.Location),
 		ExtendedLocation: converters.ExtendedLocationToNetworkSDK(s.ExtendedLocation),
@@ -218,3 +233,60 @@ func (s *NICSpec) Parameters(ctx context.Context, existing interface{}) (paramet
 		})),
 	}, nil
 }
+
+// applicationSecurityGroupsUpdate returns the existing network interface with its IP configurations joined to the
+// desired application security groups, or nil if they already are.
+func (s *NICSpec) applicationSecurityGroupsUpdate(existing armnetwork.Interface) interface{} {
+	if existing.Properties == nil {
+		return nil
+	}
+
+	update := false
+	ipConfigurations := make([]*armnetwork.InterfaceIPConfiguration, 0, len(existing.Properties.IPConfigurations))
+	for _, config := range existing.Properties.IPConfigurations {
+		if config == nil || config.Properties == nil {
+			ipConfigurations = append(ipConfigurations, config)
+			continue
+		}
+		if applicationSecurityGroupIDsEqual(config.Properties.ApplicationSecurityGroups, s.ApplicationSecurityGroupIDs) {
+			ipConfigurations = append(ipConfigurations, config)
+			continue
+		}
+		update = true
+		properties := *config.Properties
+		properties.ApplicationSecurityGroups = make([]*armnetwork.ApplicationSecurityGroup, 0, len(s.ApplicationSecurityGroupIDs))
+		for _, id := range s.ApplicationSecurityGroupIDs {
+			properties.ApplicationSecurityGroups = append(properties.ApplicationSecurityGroups, &armnetwork.ApplicationSecurityGroup{ID: ptr.To(id)})
+		}
+		updated := *config
+		updated.Properties = &properties
+		ipConfigurations = append(ipConfigurations, &updated)
+	}
+	if !update {
+		return nil
+	}
+
+	properties := *existing.Properties
+	properties.IPConfigurations = ipConfigurations
+	existing.Properties = &properties
+	return existing
+}
+
+// applicationSecurityGroupIDsEqual returns true if asgs are the application security groups with the given IDs, in any order.
+func applicationSecurityGroupIDsEqual(asgs []*armnetwork.ApplicationSecurityGroup, ids []string) bool {
+	if len(asgs) != len(ids) {
+		return false
+	}
+	want := make(map[string]int, len(ids))
+	for _, id := range ids {
+		want[strings.ToLower(id)]++
+	}
+	for _, asg := range asgs {
+		id := strings.ToLower(ptr.Deref(asg.ID, ""))
+		if want[id] == 0 {
+			return false
+		}
+		want[id]--
+	}
+	return true
+}
@@ -97,6 +97,24 @@ var (
 		ClusterName:             "my-cluster",
 	}
 
+	fakeApplicationSecurityGroupsNICSpec = NICSpec{
+		Name:                  "my-net-interface",
+		ResourceGroup:         "my-rg",
+		Location:              "fake-location",
+		SubscriptionID:        "123",
+		MachineName:           "azure-test1",
+		SubnetName:            "my-subnet",
+		VNetName:              "my-vnet",
+		VNetResourceGroup:     "my-rg",
+		AcceleratedNetworking: nil,
+		SKU:                   &fakeSku,
+		ClusterName:           "my-cluster",
+		IPConfigs:             []IPConfig{{}, {}},
+		ApplicationSecurityGroupIDs: []string{
+			"/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/applicationSecurityGroups/my-cluster-node-asg",
+		},
+	}
+
 	fakeControlPlaneNICSpec = NICSpec{
 		Name:                      "my-net-interface",
 		ResourceGroup:             "my-rg",
@@ -668,6 +686,114 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name:     "get parameters for network interface with application security groups",
+			spec:     &fakeApplicationSecurityGroupsNICSpec,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				asgs := []*armnetwork.ApplicationSecurityGroup{
+					{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/applicationSecurityGroups/my-cluster-node-asg")},
+				}
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.Interface{}))
+				g.Expect(result.(armnetwork.Interface)).To(Equal(armnetwork.Interface{
+					Tags: map[string]*string{
+						"Name": ptr.To("my-net-interface"),
+						"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": ptr.To("owned"),
+					},
+					Location: ptr.To("fake-location"),
+					Properties: &armnetwork.InterfacePropertiesFormat{
+						EnableAcceleratedNetworking: ptr.To(true),
+						EnableIPForwarding:          ptr.To(false),
+						DNSSettings:                 &armnetwork.InterfaceDNSSettings{},
+						IPConfigurations: []*armnetwork.InterfaceIPConfiguration{
+							{
+								Name: ptr.To("pipConfig"),
+								Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
+									Primary:                         ptr.To(true),
+									LoadBalancerBackendAddressPools: []*armnetwork.BackendAddressPool{},
+									PrivateIPAllocationMethod:       ptr.To(armnetwork.IPAllocationMethodDynamic),
+									Subnet:                          &armnetwork.Subnet{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-subnet")},
+									ApplicationSecurityGroups:       asgs,
+								},
+							},
+							{
+								Name: ptr.To("my-net-interface-1"),
+								Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
+									Primary:                   ptr.To(false),
+									PrivateIPAllocationMethod: ptr.To(armnetwork.IPAllocationMethodDynamic),
+									Subnet:                    &armnetwork.Subnet{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/virtualNetworks/my-vnet/subnets/my-subnet")},
+									ApplicationSecurityGroups: asgs,
+								},
+							},
+						},
+					},
+				}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "existing network interface already in its application security groups is not updated",
+			spec: &fakeApplicationSecurityGroupsNICSpec,
+			existing: armnetwork.Interface{
+				Name: ptr.To("my-net-interface"),
+				Properties: &armnetwork.InterfacePropertiesFormat{
+					IPConfigurations: []*armnetwork.InterfaceIPConfiguration{
+						{
+							Name: ptr.To("pipConfig"),
+							Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
+								ApplicationSecurityGroups: []*armnetwork.ApplicationSecurityGroup{
+									{ID: ptr.To("/subscriptions/123/resourceGroups/MY-RG/providers/Microsoft.Network/applicationSecurityGroups/my-cluster-node-asg")},
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name: "existing network interface joins its application security groups",
+			spec: &fakeApplicationSecurityGroupsNICSpec,
+			existing: armnetwork.Interface{
+				Name: ptr.To("my-net-interface"),
+				Properties: &armnetwork.InterfacePropertiesFormat{
+					IPConfigurations: []*armnetwork.InterfaceIPConfiguration{
+						{
+							Name: ptr.To("pipConfig"),
+							Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
+								Primary: ptr.To(true),
+								ApplicationSecurityGroups: []*armnetwork.ApplicationSecurityGroup{
+									{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/applicationSecurityGroups/my-cluster-control-plane-asg")},
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.Interface{}))
+				g.Expect(result.(armnetwork.Interface)).To(Equal(armnetwork.Interface{
+					Name: ptr.To("my-net-interface"),
+					Properties: &armnetwork.InterfacePropertiesFormat{
+						IPConfigurations: []*armnetwork.InterfaceIPConfiguration{
+							{
+								Name: ptr.To("pipConfig"),
+								Properties: &armnetwork.InterfaceIPConfigurationPropertiesFormat{
+									Primary: ptr.To(true),
+									ApplicationSecurityGroups: []*armnetwork.ApplicationSecurityGroup{
+										{ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Network/applicationSecurityGroups/my-cluster-node-asg")},
+									},
+								},
+							},
+						},
+					},
+				}))
+			},
+			expectedError: "",
+		},
 	}
 	format.MaxLength = 10000
 	for _, tc := range testcases {
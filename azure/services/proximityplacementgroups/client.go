@@ -0,0 +1,96 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proximityplacementgroups
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/runtime"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// AzureClient contains the Azure go-sdk Client.
+type AzureClient struct {
+	proximityPlacementGroups *armcompute.ProximityPlacementGroupsClient
+}
+
+// NewClient creates a new proximity placement groups client from an authorizer.
+func NewClient(auth azure.Authorizer) (*AzureClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create proximityplacementgroups client options")
+	}
+	factory, err := armcompute.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create armcompute client factory")
+	}
+	return &AzureClient{factory.NewProximityPlacementGroupsClient()}, nil
+}
+
+// Get gets a proximity placement group.
+func (ac *AzureClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (result interface{}, err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "proximityplacementgroups.AzureClient.Get")
+	defer done()
+
+	resp, err := ac.proximityPlacementGroups.Get(ctx, spec.ResourceGroupName(), spec.ResourceName(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.ProximityPlacementGroup, nil
+}
+
+// CreateOrUpdateAsync creates or updates a proximity placement group asynchronously.
+// It sends a PUT request to Azure and if accepted without error, the func will return a Poller which can be used to track the ongoing
+// progress of the operation.
+func (ac *AzureClient) CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, _resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armcompute.ProximityPlacementGroupsClientCreateOrUpdateResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "proximityplacementgroups.AzureClient.CreateOrUpdateAsync")
+	defer done()
+
+	ppg, ok := parameters.(armcompute.ProximityPlacementGroup)
+	if !ok && parameters != nil {
+		return nil, nil, errors.Errorf("%T is not an armcompute.ProximityPlacementGroup", parameters)
+	}
+
+	// Note: there is no async `BeginCreateOrUpdate` implementation for proximity placement groups, so this func will never return a poller.
+	resp, err := ac.proximityPlacementGroups.CreateOrUpdate(ctx, spec.ResourceGroupName(), spec.ResourceName(), ppg, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// if the operation completed, return a nil poller
+	return resp.ProximityPlacementGroup, nil, err
+}
+
+// DeleteAsync deletes a proximity placement group asynchronously. DeleteAsync sends a DELETE
+// request to Azure and if accepted without error, the func will return a Poller which can be used to track the ongoing
+// progress of the operation.
+func (ac *AzureClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecGetter, _resumeToken string) (poller *runtime.Poller[armcompute.ProximityPlacementGroupsClientDeleteResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "proximityplacementgroups.AzureClient.DeleteAsync")
+	defer done()
+
+	// Note: there is no async `BeginDelete` implementation for proximity placement groups, so this func will never return a poller.
+	_, err = ac.proximityPlacementGroups.Delete(ctx, spec.ResourceGroupName(), spec.ResourceName(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// if the operation completed, return a nil poller.
+	return nil, err
+}
@@ -0,0 +1,132 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proximityplacementgroups
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+const serviceName = "proximityplacementgroups"
+
+// ProximityPlacementGroupScope defines the scope interface for a proximity placement groups service.
+type ProximityPlacementGroupScope interface {
+	azure.Authorizer
+	azure.AsyncStatusUpdater
+	ProximityPlacementGroupSpec() azure.ResourceSpecGetter
+}
+
+// Service provides operations on Azure resources.
+type Service struct {
+	Scope ProximityPlacementGroupScope
+	async.Getter
+	async.Reconciler
+}
+
+// New creates a new proximity placement groups service.
+func New(scope ProximityPlacementGroupScope) (*Service, error) {
+	client, err := NewClient(scope)
+	if err != nil {
+		return nil, err
+	}
+	return &Service{
+		Scope:  scope,
+		Getter: client,
+		Reconciler: async.New[armcompute.ProximityPlacementGroupsClientCreateOrUpdateResponse,
+			armcompute.ProximityPlacementGroupsClientDeleteResponse](scope, client, client),
+	}, nil
+}
+
+// Name returns the service name.
+func (s *Service) Name() string {
+	return serviceName
+}
+
+// Reconcile idempotently creates a proximity placement group.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "proximityplacementgroups.Service.Reconcile")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	defer cancel()
+
+	ppgSpec := s.Scope.ProximityPlacementGroupSpec()
+	if ppgSpec == nil {
+		log.V(2).Info("skip creation when no proximity placement group spec is found")
+		return nil
+	}
+
+	_, err := s.CreateOrUpdateResource(ctx, ppgSpec, serviceName)
+	s.Scope.UpdatePutStatus(infrav1.ProximityPlacementGroupReadyCondition, serviceName, err)
+	return err
+}
+
+// Delete deletes the proximity placement group if no VMs, scale sets or availability sets still belong to it.
+func (s *Service) Delete(ctx context.Context) error {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "proximityplacementgroups.Service.Delete")
+	defer done()
+
+	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
+	defer cancel()
+
+	ppgSpec := s.Scope.ProximityPlacementGroupSpec()
+	if ppgSpec == nil {
+		log.V(2).Info("skip deletion when no proximity placement group spec is found")
+		return nil
+	}
+
+	var resultingErr error
+	existing, err := s.Get(ctx, ppgSpec)
+	if err != nil {
+		if !azure.ResourceNotFound(err) {
+			resultingErr = errors.Wrapf(err, "failed to get proximity placement group %s in resource group %s", ppgSpec.ResourceName(), ppgSpec.ResourceGroupName())
+		}
+	} else {
+		ppg, ok := existing.(armcompute.ProximityPlacementGroup)
+		if !ok {
+			resultingErr = errors.Errorf("%T is not an armcompute.ProximityPlacementGroup", existing)
+		} else if inUse(ppg) {
+			log.V(2).Info("skip deleting proximity placement group that is still in use", "proximity placement group", ppgSpec.ResourceName())
+		} else {
+			resultingErr = s.DeleteResource(ctx, ppgSpec, serviceName)
+		}
+	}
+
+	s.Scope.UpdateDeleteStatus(infrav1.ProximityPlacementGroupReadyCondition, serviceName, resultingErr)
+	return resultingErr
+}
+
+// IsManaged returns always returns true as the service only handles proximity placement groups owned by CAPZ.
+func (s *Service) IsManaged(ctx context.Context) (bool, error) {
+	return true, nil
+}
+
+// inUse returns true if any VMs, scale sets or availability sets belong to the proximity placement group.
+func inUse(ppg armcompute.ProximityPlacementGroup) bool {
+	if ppg.Properties == nil {
+		return false
+	}
+	return len(ppg.Properties.VirtualMachines) > 0 ||
+		len(ppg.Properties.VirtualMachineScaleSets) > 0 ||
+		len(ppg.Properties.AvailabilitySets) > 0
+}
@@ -0,0 +1,213 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proximityplacementgroups
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async/mock_async"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/proximityplacementgroups/mock_proximityplacementgroups"
+	gomockinternal "sigs.k8s.io/cluster-api-provider-azure/internal/test/matchers/gomock"
+	"sigs.k8s.io/cluster-api-provider-azure/util/reconciler"
+)
+
+var (
+	fakePPGSpec = ProximityPlacementGroupSpec{
+		Name:           "test-ppg",
+		ResourceGroup:  "test-rg",
+		ClusterName:    "test-cluster",
+		Location:       "test-location",
+		AdditionalTags: map[string]string{},
+	}
+	notFoundError   = &azcore.ResponseError{StatusCode: http.StatusNotFound}
+	fakePPGWithVMSS = armcompute.ProximityPlacementGroup{
+		Properties: &armcompute.ProximityPlacementGroupProperties{
+			VirtualMachineScaleSets: []*armcompute.SubResourceWithColocationStatus{
+				{ID: ptr.To("vmss-id")},
+			},
+		},
+	}
+)
+
+func TestReconcileProximityPlacementGroups(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_proximityplacementgroups.MockProximityPlacementGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "create or update proximity placement group",
+			expectedError: "",
+			expect: func(s *mock_proximityplacementgroups.MockProximityPlacementGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ProximityPlacementGroupSpec().Return(&fakePPGSpec)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePPGSpec, serviceName).Return(armcompute.ProximityPlacementGroup{}, nil)
+				s.UpdatePutStatus(infrav1.ProximityPlacementGroupReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "noop if no proximity placement group spec returns nil",
+			expectedError: "",
+			expect: func(s *mock_proximityplacementgroups.MockProximityPlacementGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ProximityPlacementGroupSpec().Return(nil)
+			},
+		},
+		{
+			name:          "error in creating proximity placement group",
+			expectedError: "some error",
+			expect: func(s *mock_proximityplacementgroups.MockProximityPlacementGroupScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ProximityPlacementGroupSpec().Return(&fakePPGSpec)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePPGSpec, serviceName).Return(nil, errors.New("some error"))
+				s.UpdatePutStatus(infrav1.ProximityPlacementGroupReadyCondition, serviceName, gomockinternal.ErrStrEq("some error"))
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_proximityplacementgroups.NewMockProximityPlacementGroupScope(mockCtrl)
+			asyncMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), asyncMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Reconciler: asyncMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestDeleteProximityPlacementGroups(t *testing.T) {
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_proximityplacementgroups.MockProximityPlacementGroupScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+	}{
+		{
+			name:          "deletes proximity placement group",
+			expectedError: "",
+			expect: func(s *mock_proximityplacementgroups.MockProximityPlacementGroupScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.ProximityPlacementGroupSpec().Return(&fakePPGSpec)
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					m.Get(gomockinternal.AContext(), &fakePPGSpec).Return(armcompute.ProximityPlacementGroup{}, nil),
+					r.DeleteResource(gomockinternal.AContext(), &fakePPGSpec, serviceName).Return(nil),
+					s.UpdateDeleteStatus(infrav1.ProximityPlacementGroupReadyCondition, serviceName, nil),
+				)
+			},
+		},
+		{
+			name:          "noop if ProximityPlacementGroupSpec returns nil",
+			expectedError: "",
+			expect: func(s *mock_proximityplacementgroups.MockProximityPlacementGroupScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.ProximityPlacementGroupSpec().Return(nil)
+			},
+		},
+		{
+			name:          "noop if proximity placement group is still in use",
+			expectedError: "",
+			expect: func(s *mock_proximityplacementgroups.MockProximityPlacementGroupScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.ProximityPlacementGroupSpec().Return(&fakePPGSpec)
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					m.Get(gomockinternal.AContext(), &fakePPGSpec).Return(fakePPGWithVMSS, nil),
+					s.UpdateDeleteStatus(infrav1.ProximityPlacementGroupReadyCondition, serviceName, nil),
+				)
+			},
+		},
+		{
+			name:          "proximity placement group not found",
+			expectedError: "",
+			expect: func(s *mock_proximityplacementgroups.MockProximityPlacementGroupScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.ProximityPlacementGroupSpec().Return(&fakePPGSpec)
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					m.Get(gomockinternal.AContext(), &fakePPGSpec).Return(nil, notFoundError),
+					s.UpdateDeleteStatus(infrav1.ProximityPlacementGroupReadyCondition, serviceName, nil),
+				)
+			},
+		},
+		{
+			name:          "error in deleting proximity placement group",
+			expectedError: "some error",
+			expect: func(s *mock_proximityplacementgroups.MockProximityPlacementGroupScopeMockRecorder, m *mock_async.MockGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.ProximityPlacementGroupSpec().Return(&fakePPGSpec)
+				gomock.InOrder(
+					s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout),
+					m.Get(gomockinternal.AContext(), &fakePPGSpec).Return(armcompute.ProximityPlacementGroup{}, nil),
+					r.DeleteResource(gomockinternal.AContext(), &fakePPGSpec, serviceName).Return(errors.New("some error")),
+					s.UpdateDeleteStatus(infrav1.ProximityPlacementGroupReadyCondition, serviceName, gomockinternal.ErrStrEq("some error")),
+				)
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+			scopeMock := mock_proximityplacementgroups.NewMockProximityPlacementGroupScope(mockCtrl)
+			getterMock := mock_async.NewMockGetter(mockCtrl)
+			asyncMock := mock_async.NewMockReconciler(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), getterMock.EXPECT(), asyncMock.EXPECT())
+
+			s := &Service{
+				Scope:      scopeMock,
+				Getter:     getterMock,
+				Reconciler: asyncMock,
+			}
+
+			err := s.Delete(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
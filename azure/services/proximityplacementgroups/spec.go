@@ -0,0 +1,89 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proximityplacementgroups
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
+)
+
+// ProximityPlacementGroupSpec defines the specification for a proximity placement group.
+type ProximityPlacementGroupSpec struct {
+	Name           string
+	ResourceGroup  string
+	ClusterName    string
+	Location       string
+	Zones          []string
+	VMSizes        []string
+	AdditionalTags infrav1.Tags
+}
+
+// ResourceName returns the name of the proximity placement group.
+func (s *ProximityPlacementGroupSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group.
+func (s *ProximityPlacementGroupSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName is a no-op for proximity placement groups.
+func (s *ProximityPlacementGroupSpec) OwnerResourceName() string {
+	return ""
+}
+
+// Parameters returns the parameters for the proximity placement group.
+func (s *ProximityPlacementGroupSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	if existing != nil {
+		if _, ok := existing.(armcompute.ProximityPlacementGroup); !ok {
+			return nil, errors.Errorf("%T is not an armcompute.ProximityPlacementGroup", existing)
+		}
+		// proximity placement group already exists
+		return nil, nil
+	}
+
+	ppg := armcompute.ProximityPlacementGroup{
+		Properties: &armcompute.ProximityPlacementGroupProperties{
+			ProximityPlacementGroupType: ptr.To(armcompute.ProximityPlacementGroupTypeStandard),
+		},
+		Tags: converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.ClusterName,
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        ptr.To(s.Name),
+			Role:        ptr.To(infrav1.CommonRole),
+			Additional:  s.AdditionalTags,
+		})),
+		Location: ptr.To(s.Location),
+	}
+	if len(s.Zones) > 0 {
+		ppg.Zones = azure.PtrSlice(&s.Zones)
+	}
+	if len(s.VMSizes) > 0 {
+		ppg.Properties.Intent = &armcompute.ProximityPlacementGroupPropertiesIntent{
+			VMSizes: azure.PtrSlice(&s.VMSizes),
+		}
+	}
+
+	return ppg, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package proximityplacementgroups
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func TestParameters(t *testing.T) {
+	testcases := []struct {
+		name     string
+		spec     *ProximityPlacementGroupSpec
+		existing interface{}
+		expected interface{}
+	}{
+		{
+			name:     "proximity placement group already exists",
+			spec:     &fakePPGSpec,
+			existing: armcompute.ProximityPlacementGroup{},
+			expected: nil,
+		},
+		{
+			name: "new proximity placement group with zone and intent",
+			spec: &ProximityPlacementGroupSpec{
+				Name:           "test-ppg",
+				ResourceGroup:  "test-rg",
+				ClusterName:    "test-cluster",
+				Location:       "test-location",
+				Zones:          []string{"1"},
+				VMSizes:        []string{"Standard_D2s_v3"},
+				AdditionalTags: map[string]string{"foo": "bar"},
+			},
+			existing: nil,
+			expected: armcompute.ProximityPlacementGroup{
+				Location: ptr.To("test-location"),
+				Properties: &armcompute.ProximityPlacementGroupProperties{
+					ProximityPlacementGroupType: ptr.To(armcompute.ProximityPlacementGroupTypeStandard),
+					Intent: &armcompute.ProximityPlacementGroupPropertiesIntent{
+						VMSizes: []*string{ptr.To("Standard_D2s_v3")},
+					},
+				},
+				Tags: map[string]*string{
+					"sigs.k8s.io_cluster-api-provider-azure_cluster_test-cluster": ptr.To("owned"),
+					"sigs.k8s.io_cluster-api-provider-azure_role":                 ptr.To("common"),
+					"Name": ptr.To("test-ppg"),
+					"foo":  ptr.To("bar"),
+				},
+				Zones: []*string{ptr.To("1")},
+			},
+		},
+	}
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			g.Expect(err).NotTo(HaveOccurred())
+			if tc.expected == nil {
+				g.Expect(result).To(BeNil())
+			} else {
+				g.Expect(result).To(Equal(tc.expected))
+			}
+		})
+	}
+}
@@ -119,3 +119,95 @@ func (ac *AzureClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecG
 	// if the operation completed, return a nil poller.
 	return nil, err
 }
+
+// prefixClient contains the Azure go-sdk Client for public IP prefixes.
+type prefixClient struct {
+	prefixes       *armnetwork.PublicIPPrefixesClient
+	apiCallTimeout time.Duration
+}
+
+// newPrefixClient creates a new public IP prefix client from an authorizer.
+func newPrefixClient(auth azure.Authorizer, apiCallTimeout time.Duration) (*prefixClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create public IP prefixes client options")
+	}
+
+	factory, err := armnetwork.NewClientFactory(auth.SubscriptionID(), auth.Token(), opts)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create public IP prefixes client factory")
+	}
+	return &prefixClient{factory.NewPublicIPPrefixesClient(), apiCallTimeout}, nil
+}
+
+// Get gets the specified public IP prefix in a specified resource group.
+func (pc *prefixClient) Get(ctx context.Context, spec azure.ResourceSpecGetter) (result interface{}, err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "publicips.prefixClient.Get")
+	defer done()
+
+	resp, err := pc.prefixes.Get(ctx, spec.ResourceGroupName(), spec.ResourceName(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return resp.PublicIPPrefix, nil
+}
+
+// CreateOrUpdateAsync creates or updates a public IP prefix.
+// It sends a PUT request to Azure and if accepted without error, the func will return a Poller which can be used to track the ongoing
+// progress of the operation.
+func (pc *prefixClient) CreateOrUpdateAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string, parameters interface{}) (result interface{}, poller *runtime.Poller[armnetwork.PublicIPPrefixesClientCreateOrUpdateResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "publicips.prefixClient.CreateOrUpdate")
+	defer done()
+
+	prefix, ok := parameters.(armnetwork.PublicIPPrefix)
+	if !ok && parameters != nil {
+		return nil, nil, errors.Errorf("%T is not an armnetwork.PublicIPPrefix", parameters)
+	}
+
+	opts := &armnetwork.PublicIPPrefixesClientBeginCreateOrUpdateOptions{ResumeToken: resumeToken}
+	poller, err = pc.prefixes.BeginCreateOrUpdate(ctx, spec.ResourceGroupName(), spec.ResourceName(), prefix, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pc.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	resp, err := poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return nil, poller, err
+	}
+
+	// if the operation completed, return a nil poller.
+	return resp.PublicIPPrefix, nil, err
+}
+
+// DeleteAsync deletes the specified public IP prefix asynchronously. DeleteAsync sends a DELETE
+// request to Azure and if accepted without error, the func will return a Poller which can be used to track the ongoing
+// progress of the operation.
+func (pc *prefixClient) DeleteAsync(ctx context.Context, spec azure.ResourceSpecGetter, resumeToken string) (poller *runtime.Poller[armnetwork.PublicIPPrefixesClientDeleteResponse], err error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "publicips.prefixClient.DeleteAsync")
+	defer done()
+
+	opts := &armnetwork.PublicIPPrefixesClientBeginDeleteOptions{ResumeToken: resumeToken}
+	poller, err = pc.prefixes.BeginDelete(ctx, spec.ResourceGroupName(), spec.ResourceName(), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, pc.apiCallTimeout)
+	defer cancel()
+
+	pollOpts := &runtime.PollUntilDoneOptions{Frequency: async.DefaultPollerFrequency}
+	_, err = poller.PollUntilDone(ctx, pollOpts)
+	if err != nil {
+		// if an error occurs, return the poller.
+		// this means the long-running operation didn't finish in the specified timeout.
+		return poller, err
+	}
+	// if the operation completed, return a nil poller.
+	return nil, err
+}
@@ -337,6 +337,20 @@ func (mr *MockPublicIPScopeMockRecorder) NodeResourceGroup() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NodeResourceGroup", reflect.TypeOf((*MockPublicIPScope)(nil).NodeResourceGroup))
 }
 
+// PublicIPPrefixSpecs mocks base method.
+func (m *MockPublicIPScope) PublicIPPrefixSpecs() []azure.ResourceSpecGetter {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublicIPPrefixSpecs")
+	ret0, _ := ret[0].([]azure.ResourceSpecGetter)
+	return ret0
+}
+
+// PublicIPPrefixSpecs indicates an expected call of PublicIPPrefixSpecs.
+func (mr *MockPublicIPScopeMockRecorder) PublicIPPrefixSpecs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicIPPrefixSpecs", reflect.TypeOf((*MockPublicIPScope)(nil).PublicIPPrefixSpecs))
+}
+
 // PublicIPSpecs mocks base method.
 func (m *MockPublicIPScope) PublicIPSpecs() []azure.ResourceSpecGetter {
 	m.ctrl.T.Helper()
@@ -377,6 +391,30 @@ func (mr *MockPublicIPScopeMockRecorder) SetLongRunningOperationState(arg0 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLongRunningOperationState", reflect.TypeOf((*MockPublicIPScope)(nil).SetLongRunningOperationState), arg0)
 }
 
+// SetPublicIPAddress mocks base method.
+func (m *MockPublicIPScope) SetPublicIPAddress(name, address string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPublicIPAddress", name, address)
+}
+
+// SetPublicIPAddress indicates an expected call of SetPublicIPAddress.
+func (mr *MockPublicIPScopeMockRecorder) SetPublicIPAddress(name, address any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublicIPAddress", reflect.TypeOf((*MockPublicIPScope)(nil).SetPublicIPAddress), name, address)
+}
+
+// SetPublicIPPrefix mocks base method.
+func (m *MockPublicIPScope) SetPublicIPPrefix(name, prefix string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetPublicIPPrefix", name, prefix)
+}
+
+// SetPublicIPPrefix indicates an expected call of SetPublicIPPrefix.
+func (mr *MockPublicIPScopeMockRecorder) SetPublicIPPrefix(name, prefix any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetPublicIPPrefix", reflect.TypeOf((*MockPublicIPScope)(nil).SetPublicIPPrefix), name, prefix)
+}
+
 // SubscriptionID mocks base method.
 func (m *MockPublicIPScope) SubscriptionID() string {
 	m.ctrl.T.Helper()
@@ -18,6 +18,7 @@ package publicips
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/pkg/errors"
@@ -26,6 +27,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/tags"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 )
 
@@ -37,6 +39,9 @@ type PublicIPScope interface {
 	azure.AsyncStatusUpdater
 	azure.ClusterDescriber
 	PublicIPSpecs() []azure.ResourceSpecGetter
+	PublicIPPrefixSpecs() []azure.ResourceSpecGetter
+	SetPublicIPAddress(name, address string)
+	SetPublicIPPrefix(name, prefix string)
 }
 
 // Service provides operations on Azure resources.
@@ -45,6 +50,8 @@ type Service struct {
 	async.Reconciler
 	async.Getter
 	async.TagsGetter
+	prefixReconciler async.Reconciler
+	prefixGetter     async.Getter
 }
 
 // New creates a new service.
@@ -53,6 +60,10 @@ func New(scope PublicIPScope) (*Service, error) {
 	if err != nil {
 		return nil, err
 	}
+	prefixClient, err := newPrefixClient(scope, scope.DefaultedAzureCallTimeout())
+	if err != nil {
+		return nil, err
+	}
 	tagsClient, err := tags.NewClient(scope)
 	if err != nil {
 		return nil, err
@@ -62,6 +73,9 @@ func New(scope PublicIPScope) (*Service, error) {
 		Getter:     client,
 		TagsGetter: tagsClient,
 		Reconciler: async.New[armnetwork.PublicIPAddressesClientCreateOrUpdateResponse, armnetwork.PublicIPAddressesClientDeleteResponse](scope, client, client),
+		prefixReconciler: async.New[armnetwork.PublicIPPrefixesClientCreateOrUpdateResponse,
+			armnetwork.PublicIPPrefixesClientDeleteResponse](scope, prefixClient, prefixClient),
+		prefixGetter: prefixClient,
 	}, nil
 }
 
@@ -70,7 +84,7 @@ func (s *Service) Name() string {
 	return serviceName
 }
 
-// Reconcile idempotently creates or updates a public IP.
+// Reconcile idempotently creates or updates public IPs and public IP prefixes.
 func (s *Service) Reconcile(ctx context.Context) error {
 	ctx, _, done := tele.StartSpanWithLogger(ctx, "publicips.Service.Reconcile")
 	defer done()
@@ -79,19 +93,50 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	defer cancel()
 
 	specs := s.Scope.PublicIPSpecs()
-	if len(specs) == 0 {
+	prefixSpecs := s.Scope.PublicIPPrefixSpecs()
+	if len(specs) == 0 && len(prefixSpecs) == 0 {
 		return nil
 	}
 
-	// We go through the list of PublicIPSpecs to reconcile each one, independently of the result of the previous one.
+	// We go through the list of PublicIPPrefixSpecs and PublicIPSpecs to reconcile each one, independently of the result of the previous one.
 	// If multiple errors occur, we return the most pressing one.
 	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
 	var result error
+	for _, prefixSpec := range prefixSpecs {
+		var prefix interface{}
+		var err error
+		if spec, ok := prefixSpec.(*PublicIPPrefixSpec); ok && spec.ID != "" {
+			prefix, err = s.getExistingPublicIPPrefix(ctx, spec)
+		} else {
+			prefix, err = s.prefixReconciler.CreateOrUpdateResource(ctx, prefixSpec, serviceName)
+		}
+		if err != nil {
+			if !azure.IsOperationNotDoneError(err) || result == nil {
+				result = err
+			}
+			continue
+		}
+		if p, ok := prefix.(armnetwork.PublicIPPrefix); ok && p.Properties != nil && p.Properties.IPPrefix != nil {
+			s.Scope.SetPublicIPPrefix(prefixSpec.ResourceName(), *p.Properties.IPPrefix)
+		}
+	}
+
 	for _, publicIPSpec := range specs {
-		if _, err := s.CreateOrUpdateResource(ctx, publicIPSpec, serviceName); err != nil {
+		var publicIP interface{}
+		var err error
+		if spec, ok := publicIPSpec.(*PublicIPSpec); ok && spec.ID != "" {
+			publicIP, err = s.getExistingPublicIP(ctx, spec)
+		} else {
+			publicIP, err = s.CreateOrUpdateResource(ctx, publicIPSpec, serviceName)
+		}
+		if err != nil {
 			if !azure.IsOperationNotDoneError(err) || result == nil {
 				result = err
 			}
+			continue
+		}
+		if ip, ok := publicIP.(armnetwork.PublicIPAddress); ok && ip.Properties != nil && ip.Properties.IPAddress != nil {
+			s.Scope.SetPublicIPAddress(publicIPSpec.ResourceName(), *ip.Properties.IPAddress)
 		}
 	}
 
@@ -99,7 +144,7 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	return result
 }
 
-// Delete deletes the public IP with the provided scope.
+// Delete deletes the public IPs and public IP prefixes with the provided scope.
 func (s *Service) Delete(ctx context.Context) error {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "publicips.Service.Delete")
 	defer done()
@@ -108,7 +153,8 @@ func (s *Service) Delete(ctx context.Context) error {
 	defer cancel()
 
 	specs := s.Scope.PublicIPSpecs()
-	if len(specs) == 0 {
+	prefixSpecs := s.Scope.PublicIPPrefixSpecs()
+	if len(specs) == 0 && len(prefixSpecs) == 0 {
 		return nil
 	}
 
@@ -119,7 +165,12 @@ func (s *Service) Delete(ctx context.Context) error {
 	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error deleting) -> operationNotDoneError (i.e. deleting in progress) -> no error (i.e. deleted)
 	var result error
 	for _, publicIPSpec := range specs {
-		managed, err := s.isIPManaged(ctx, publicIPSpec)
+		if spec, ok := publicIPSpec.(*PublicIPSpec); ok && spec.ID != "" {
+			log.V(2).Info("Skipping IP deletion for referenced public IP", "public ip", spec.ID)
+			continue
+		}
+
+		managed, err := s.isManaged(ctx, azure.PublicIPID(s.Scope.SubscriptionID(), publicIPSpec.ResourceGroupName(), publicIPSpec.ResourceName()))
 		if err != nil && !azure.ResourceNotFound(err) {
 			return errors.Wrap(err, "could not get public IP management state")
 		}
@@ -140,6 +191,34 @@ func (s *Service) Delete(ctx context.Context) error {
 		log.V(2).Info("deleted public IP", "public ip", publicIPSpec.ResourceName())
 	}
 
+	// Public IP prefixes are deleted after the public IPs, as the NAT gateways using them are gone by then.
+	for _, prefixSpec := range prefixSpecs {
+		if spec, ok := prefixSpec.(*PublicIPPrefixSpec); ok && spec.ID != "" {
+			log.V(2).Info("Skipping deletion for referenced public IP prefix", "public ip prefix", spec.ID)
+			continue
+		}
+
+		managed, err := s.isManaged(ctx, azure.PublicIPPrefixID(s.Scope.SubscriptionID(), prefixSpec.ResourceGroupName(), prefixSpec.ResourceName()))
+		if err != nil && !azure.ResourceNotFound(err) {
+			return errors.Wrap(err, "could not get public IP prefix management state")
+		}
+
+		if !managed {
+			log.V(2).Info("Skipping deletion for unmanaged public IP prefix", "public ip prefix", prefixSpec.ResourceName())
+			continue
+		}
+
+		log.V(2).Info("deleting public IP prefix", "public ip prefix", prefixSpec.ResourceName())
+		hasManagedPublicIPs = true
+		if err := s.prefixReconciler.DeleteResource(ctx, prefixSpec, serviceName); err != nil {
+			if !azure.IsOperationNotDoneError(err) || result == nil {
+				result = err
+			}
+		}
+
+		log.V(2).Info("deleted public IP prefix", "public ip prefix", prefixSpec.ResourceName())
+	}
+
 	if hasManagedPublicIPs {
 		s.Scope.UpdateDeleteStatus(infrav1.PublicIPsReadyCondition, serviceName, result)
 	}
@@ -147,11 +226,63 @@ func (s *Service) Delete(ctx context.Context) error {
 	return result
 }
 
-// isIPManaged returns true if the IP has an owned tag with the cluster name as value,
-// meaning that the IP's lifecycle is managed.
-func (s *Service) isIPManaged(ctx context.Context, spec azure.ResourceSpecGetter) (bool, error) {
-	scope := azure.PublicIPID(s.Scope.SubscriptionID(), spec.ResourceGroupName(), spec.ResourceName())
-	result, err := s.TagsGetter.GetAtScope(ctx, scope)
+// getExistingPublicIP gets the existing public IP referenced by the spec, which CAPZ doesn't create,
+// and checks that the cluster can use it.
+func (s *Service) getExistingPublicIP(ctx context.Context, spec *PublicIPSpec) (interface{}, error) {
+	if err := s.validateReferenceSubscription(spec.ID); err != nil {
+		return nil, err
+	}
+	existing, err := s.Getter.Get(ctx, spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get existing public IP %s", spec.ID)
+	}
+	publicIP, ok := existing.(armnetwork.PublicIPAddress)
+	if !ok {
+		return nil, errors.Errorf("%T is not an armnetwork.PublicIPAddress", existing)
+	}
+	if err := spec.validateExisting(publicIP); err != nil {
+		return nil, azure.WithTerminalError(err)
+	}
+	return publicIP, nil
+}
+
+// getExistingPublicIPPrefix gets the existing public IP prefix referenced by the spec, which CAPZ doesn't create,
+// and checks that the cluster can use it.
+func (s *Service) getExistingPublicIPPrefix(ctx context.Context, spec *PublicIPPrefixSpec) (interface{}, error) {
+	if err := s.validateReferenceSubscription(spec.ID); err != nil {
+		return nil, err
+	}
+	existing, err := s.prefixGetter.Get(ctx, spec)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get existing public IP prefix %s", spec.ID)
+	}
+	prefix, ok := existing.(armnetwork.PublicIPPrefix)
+	if !ok {
+		return nil, errors.Errorf("%T is not an armnetwork.PublicIPPrefix", existing)
+	}
+	if err := spec.validateExisting(prefix); err != nil {
+		return nil, azure.WithTerminalError(err)
+	}
+	return prefix, nil
+}
+
+// validateReferenceSubscription checks that a referenced resource is in the cluster's subscription,
+// as load balancers and NAT gateways can't use public IPs from other subscriptions.
+func (s *Service) validateReferenceSubscription(id string) error {
+	resourceID, err := azureutil.ParseResourceID(id)
+	if err != nil {
+		return azure.WithTerminalError(errors.Wrapf(err, "failed to parse resource ID %s", id))
+	}
+	if !strings.EqualFold(resourceID.SubscriptionID, s.Scope.SubscriptionID()) {
+		return azure.WithTerminalError(errors.Errorf("%s must be in the cluster's subscription %s", id, s.Scope.SubscriptionID()))
+	}
+	return nil
+}
+
+// isManaged returns true if the public IP or public IP prefix with the given resource ID has an owned tag
+// with the cluster name as value, meaning that its lifecycle is managed.
+func (s *Service) isManaged(ctx context.Context, resourceID string) (bool, error) {
+	result, err := s.TagsGetter.GetAtScope(ctx, resourceID)
 	if err != nil {
 		return false, err
 	}
@@ -18,12 +18,14 @@ package publicips
 
 import (
 	"context"
+	"errors"
 	"io"
 	"net/http"
 	"strings"
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/resources/armresources"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
@@ -97,6 +99,15 @@ var (
 		},
 	}
 
+	fakePublicIPPrefixSpec = PublicIPPrefixSpec{
+		Name:           "my-publicip-prefix",
+		ResourceGroup:  "my-rg",
+		ClusterName:    "my-cluster",
+		Location:       "centralIndia",
+		PrefixLength:   31,
+		FailureDomains: []*string{ptr.To("failure-domain-id-1"), ptr.To("failure-domain-id-2"), ptr.To("failure-domain-id-3")},
+	}
+
 	managedTags = armresources.TagsResource{
 		Properties: &armresources.Tags{
 			Tags: map[string]*string{
@@ -127,22 +138,24 @@ func TestReconcilePublicIP(t *testing.T) {
 	testcases := []struct {
 		name          string
 		expectedError string
-		expect        func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+		expect        func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r, p *mock_async.MockReconcilerMockRecorder)
 	}{
 		{
 			name:          "noop if no public IPs",
 			expectedError: "",
-			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r, p *mock_async.MockReconcilerMockRecorder) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{})
+				s.PublicIPPrefixSpecs().Return(nil)
 			},
 		},
 		{
 			name:          "successfully create public IPs",
 			expectedError: "",
-			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r, p *mock_async.MockReconcilerMockRecorder) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&fakePublicIPSpec1, &fakePublicIPSpec2, &fakePublicIPSpec3, &fakePublicIPSpecIpv6})
+				s.PublicIPPrefixSpecs().Return(nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicIPSpec1, serviceName).Return(nil, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicIPSpec2, serviceName).Return(nil, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicIPSpec3, serviceName).Return(nil, nil)
@@ -150,12 +163,44 @@ func TestReconcilePublicIP(t *testing.T) {
 				s.UpdatePutStatus(infrav1.PublicIPsReadyCondition, serviceName, nil)
 			},
 		},
+		{
+			name:          "successfully create public IPs and public IP prefixes and report their addresses",
+			expectedError: "",
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r, p *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&fakePublicIPSpec1, &fakePublicIPSpec3})
+				s.PublicIPPrefixSpecs().Return([]azure.ResourceSpecGetter{&fakePublicIPPrefixSpec})
+				p.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicIPPrefixSpec, serviceName).Return(armnetwork.PublicIPPrefix{
+					Properties: &armnetwork.PublicIPPrefixPropertiesFormat{IPPrefix: ptr.To("20.1.2.0/31")},
+				}, nil)
+				s.SetPublicIPPrefix("my-publicip-prefix", "20.1.2.0/31")
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicIPSpec1, serviceName).Return(armnetwork.PublicIPAddress{
+					Properties: &armnetwork.PublicIPAddressPropertiesFormat{IPAddress: ptr.To("20.1.3.4")},
+				}, nil)
+				s.SetPublicIPAddress("my-publicip", "20.1.3.4")
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicIPSpec3, serviceName).Return(armnetwork.PublicIPAddress{}, nil)
+				s.UpdatePutStatus(infrav1.PublicIPsReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "fail to create a public IP prefix",
+			expectedError: internalError.Error(),
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r, p *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&fakePublicIPSpec1})
+				s.PublicIPPrefixSpecs().Return([]azure.ResourceSpecGetter{&fakePublicIPPrefixSpec})
+				p.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicIPPrefixSpec, serviceName).Return(nil, internalError)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicIPSpec1, serviceName).Return(nil, nil)
+				s.UpdatePutStatus(infrav1.PublicIPsReadyCondition, serviceName, internalError)
+			},
+		},
 		{
 			name:          "fail to create a public IP",
 			expectedError: internalError.Error(),
-			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r, p *mock_async.MockReconcilerMockRecorder) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&fakePublicIPSpec1, &fakePublicIPSpec2, &fakePublicIPSpec3, &fakePublicIPSpecIpv6})
+				s.PublicIPPrefixSpecs().Return(nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicIPSpec1, serviceName).Return(nil, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicIPSpec2, serviceName).Return(nil, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePublicIPSpec3, serviceName).Return(nil, internalError)
@@ -177,13 +222,15 @@ func TestReconcilePublicIP(t *testing.T) {
 			scopeMock := mock_publicips.NewMockPublicIPScope(mockCtrl)
 			tagsGetterMock := mock_async.NewMockTagsGetter(mockCtrl)
 			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+			prefixReconcilerMock := mock_async.NewMockReconciler(mockCtrl)
 
-			tc.expect(scopeMock.EXPECT(), tagsGetterMock.EXPECT(), reconcilerMock.EXPECT())
+			tc.expect(scopeMock.EXPECT(), tagsGetterMock.EXPECT(), reconcilerMock.EXPECT(), prefixReconcilerMock.EXPECT())
 
 			s := &Service{
-				Scope:      scopeMock,
-				TagsGetter: tagsGetterMock,
-				Reconciler: reconcilerMock,
+				Scope:            scopeMock,
+				TagsGetter:       tagsGetterMock,
+				Reconciler:       reconcilerMock,
+				prefixReconciler: prefixReconcilerMock,
 			}
 
 			err := s.Reconcile(context.TODO())
@@ -197,26 +244,178 @@ func TestReconcilePublicIP(t *testing.T) {
 	}
 }
 
+func TestReconcileReferencedPublicIPs(t *testing.T) {
+	referencedPublicIPSpec := PublicIPSpec{
+		Name:           "reserved-ip",
+		ResourceGroup:  "reserved-ips",
+		ClusterName:    "my-cluster",
+		Location:       "centralindia",
+		FailureDomains: []*string{ptr.To("1"), ptr.To("2")},
+		ID:             "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip",
+	}
+	referencedPrefixSpec := PublicIPPrefixSpec{
+		Name:           "reserved-prefix",
+		ResourceGroup:  "reserved-ips",
+		ClusterName:    "my-cluster",
+		Location:       "centralindia",
+		PrefixLength:   30,
+		FailureDomains: []*string{ptr.To("1"), ptr.To("2")},
+		ID:             "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPPrefixes/reserved-prefix",
+	}
+	otherSubscriptionPublicIPSpec := referencedPublicIPSpec
+	otherSubscriptionPublicIPSpec.ID = "/subscriptions/456/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip"
+
+	testcases := []struct {
+		name          string
+		expectedError string
+		expect        func(s *mock_publicips.MockPublicIPScopeMockRecorder, g, pg *mock_async.MockGetterMockRecorder)
+	}{
+		{
+			name:          "use existing public IP and public IP prefix without creating them",
+			expectedError: "",
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, g, pg *mock_async.MockGetterMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&referencedPublicIPSpec})
+				s.PublicIPPrefixSpecs().Return([]azure.ResourceSpecGetter{&referencedPrefixSpec})
+				s.SubscriptionID().Return("123").Times(2)
+				pg.Get(gomockinternal.AContext(), &referencedPrefixSpec).Return(armnetwork.PublicIPPrefix{
+					Location: ptr.To("centralindia"),
+					SKU:      &armnetwork.PublicIPPrefixSKU{Name: ptr.To(armnetwork.PublicIPPrefixSKUNameStandard)},
+					Properties: &armnetwork.PublicIPPrefixPropertiesFormat{
+						IPPrefix:     ptr.To("20.1.2.0/30"),
+						PrefixLength: ptr.To[int32](30),
+					},
+				}, nil)
+				s.SetPublicIPPrefix("reserved-prefix", "20.1.2.0/30")
+				g.Get(gomockinternal.AContext(), &referencedPublicIPSpec).Return(armnetwork.PublicIPAddress{
+					Location:   ptr.To("centralindia"),
+					SKU:        &armnetwork.PublicIPAddressSKU{Name: ptr.To(armnetwork.PublicIPAddressSKUNameStandard)},
+					Properties: &armnetwork.PublicIPAddressPropertiesFormat{IPAddress: ptr.To("20.1.3.4")},
+					Zones:      []*string{ptr.To("1"), ptr.To("2"), ptr.To("3")},
+				}, nil)
+				s.SetPublicIPAddress("reserved-ip", "20.1.3.4")
+				s.UpdatePutStatus(infrav1.PublicIPsReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "fail if the existing public IP is a Basic SKU public IP",
+			expectedError: "public IP /subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip must be a Standard SKU public IP",
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, g, pg *mock_async.MockGetterMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&referencedPublicIPSpec})
+				s.PublicIPPrefixSpecs().Return(nil)
+				s.SubscriptionID().Return("123")
+				g.Get(gomockinternal.AContext(), &referencedPublicIPSpec).Return(armnetwork.PublicIPAddress{
+					Location: ptr.To("centralindia"),
+					SKU:      &armnetwork.PublicIPAddressSKU{Name: ptr.To(armnetwork.PublicIPAddressSKUNameBasic)},
+				}, nil)
+				s.UpdatePutStatus(infrav1.PublicIPsReadyCondition, serviceName, gomock.Any())
+			},
+		},
+		{
+			name:          "fail if the existing public IP is in a single zone",
+			expectedError: "public IP /subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip must be zone-redundant across the cluster's failure domains or non-zonal",
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, g, pg *mock_async.MockGetterMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&referencedPublicIPSpec})
+				s.PublicIPPrefixSpecs().Return(nil)
+				s.SubscriptionID().Return("123")
+				g.Get(gomockinternal.AContext(), &referencedPublicIPSpec).Return(armnetwork.PublicIPAddress{
+					Location: ptr.To("centralindia"),
+					SKU:      &armnetwork.PublicIPAddressSKU{Name: ptr.To(armnetwork.PublicIPAddressSKUNameStandard)},
+					Zones:    []*string{ptr.To("1")},
+				}, nil)
+				s.UpdatePutStatus(infrav1.PublicIPsReadyCondition, serviceName, gomock.Any())
+			},
+		},
+		{
+			name:          "fail if the existing public IP prefix has another prefix length",
+			expectedError: "public IP prefix /subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPPrefixes/reserved-prefix must have a prefix length of 30",
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, g, pg *mock_async.MockGetterMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PublicIPSpecs().Return(nil)
+				s.PublicIPPrefixSpecs().Return([]azure.ResourceSpecGetter{&referencedPrefixSpec})
+				s.SubscriptionID().Return("123")
+				pg.Get(gomockinternal.AContext(), &referencedPrefixSpec).Return(armnetwork.PublicIPPrefix{
+					Location:   ptr.To("centralindia"),
+					SKU:        &armnetwork.PublicIPPrefixSKU{Name: ptr.To(armnetwork.PublicIPPrefixSKUNameStandard)},
+					Properties: &armnetwork.PublicIPPrefixPropertiesFormat{PrefixLength: ptr.To[int32](28)},
+				}, nil)
+				s.UpdatePutStatus(infrav1.PublicIPsReadyCondition, serviceName, gomock.Any())
+			},
+		},
+		{
+			name:          "fail if the existing public IP is in another subscription",
+			expectedError: "/subscriptions/456/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip must be in the cluster's subscription 123",
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, g, pg *mock_async.MockGetterMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&otherSubscriptionPublicIPSpec})
+				s.PublicIPPrefixSpecs().Return(nil)
+				s.SubscriptionID().Return("123").Times(2)
+				s.UpdatePutStatus(infrav1.PublicIPsReadyCondition, serviceName, gomock.Any())
+			},
+		},
+	}
+
+	for _, tc := range testcases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+
+			t.Parallel()
+			mockCtrl := gomock.NewController(t)
+			defer mockCtrl.Finish()
+
+			scopeMock := mock_publicips.NewMockPublicIPScope(mockCtrl)
+			getterMock := mock_async.NewMockGetter(mockCtrl)
+			prefixGetterMock := mock_async.NewMockGetter(mockCtrl)
+
+			tc.expect(scopeMock.EXPECT(), getterMock.EXPECT(), prefixGetterMock.EXPECT())
+
+			s := &Service{
+				Scope:            scopeMock,
+				Getter:           getterMock,
+				Reconciler:       mock_async.NewMockReconciler(mockCtrl),
+				prefixReconciler: mock_async.NewMockReconciler(mockCtrl),
+				prefixGetter:     prefixGetterMock,
+			}
+
+			err := s.Reconcile(context.TODO())
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(tc.expectedError))
+				var reconcileError azure.ReconcileError
+				g.Expect(errors.As(err, &reconcileError)).To(BeTrue())
+				g.Expect(reconcileError.IsTerminal()).To(BeTrue())
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
 func TestDeletePublicIP(t *testing.T) {
 	testcases := []struct {
 		name          string
 		expectedError string
-		expect        func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+		expect        func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r, p *mock_async.MockReconcilerMockRecorder)
 	}{
 		{
 			name:          "noop if no public IPs",
 			expectedError: "",
-			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r, p *mock_async.MockReconcilerMockRecorder) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{})
+				s.PublicIPPrefixSpecs().Return(nil)
 			},
 		},
 		{
 			name:          "successfully delete managed public IPs and ignore unmanaged public IPs",
 			expectedError: "",
-			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r, p *mock_async.MockReconcilerMockRecorder) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&fakePublicIPSpec1, &fakePublicIPSpec2, &fakePublicIPSpec3, &fakePublicIPSpecIpv6})
+				s.PublicIPPrefixSpecs().Return(nil)
 
 				s.SubscriptionID().Return("123")
 				m.GetAtScope(gomockinternal.AContext(), azure.PublicIPID("123", fakePublicIPSpec1.ResourceGroupName(), fakePublicIPSpec1.ResourceName())).Return(managedTags, nil)
@@ -243,9 +442,10 @@ func TestDeletePublicIP(t *testing.T) {
 		{
 			name:          "noop if no managed public IPs",
 			expectedError: "",
-			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r, p *mock_async.MockReconcilerMockRecorder) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&fakePublicIPSpec1, &fakePublicIPSpec2, &fakePublicIPSpec3, &fakePublicIPSpecIpv6})
+				s.PublicIPPrefixSpecs().Return(nil)
 
 				s.SubscriptionID().Return("123")
 				m.GetAtScope(gomockinternal.AContext(), azure.PublicIPID("123", fakePublicIPSpec1.ResourceGroupName(), fakePublicIPSpec1.ResourceName())).Return(unmanagedTags, nil)
@@ -264,12 +464,55 @@ func TestDeletePublicIP(t *testing.T) {
 				s.ClusterName().Return("my-cluster")
 			},
 		},
+		{
+			name:          "successfully delete managed public IP prefixes and ignore unmanaged public IP prefixes",
+			expectedError: "",
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r, p *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&fakePublicIPSpec1})
+				s.PublicIPPrefixSpecs().Return([]azure.ResourceSpecGetter{&fakePublicIPPrefixSpec, &PublicIPPrefixSpec{Name: "byo-prefix", ResourceGroup: "my-rg"}})
+
+				s.SubscriptionID().Return("123")
+				m.GetAtScope(gomockinternal.AContext(), azure.PublicIPID("123", fakePublicIPSpec1.ResourceGroupName(), fakePublicIPSpec1.ResourceName())).Return(managedTags, nil)
+				s.ClusterName().Return("my-cluster")
+				r.DeleteResource(gomockinternal.AContext(), &fakePublicIPSpec1, serviceName).Return(nil)
+
+				s.SubscriptionID().Return("123")
+				m.GetAtScope(gomockinternal.AContext(), azure.PublicIPPrefixID("123", "my-rg", "my-publicip-prefix")).Return(managedTags, nil)
+				s.ClusterName().Return("my-cluster")
+				p.DeleteResource(gomockinternal.AContext(), &fakePublicIPPrefixSpec, serviceName).Return(nil)
+
+				s.SubscriptionID().Return("123")
+				m.GetAtScope(gomockinternal.AContext(), azure.PublicIPPrefixID("123", "my-rg", "byo-prefix")).Return(unmanagedTags, nil)
+				s.ClusterName().Return("my-cluster")
+
+				s.UpdateDeleteStatus(infrav1.PublicIPsReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "skip deleting referenced public IPs and public IP prefixes",
+			expectedError: "",
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r, p *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&PublicIPSpec{
+					Name:          "reserved-ip",
+					ResourceGroup: "reserved-ips",
+					ID:            "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPAddresses/reserved-ip",
+				}})
+				s.PublicIPPrefixSpecs().Return([]azure.ResourceSpecGetter{&PublicIPPrefixSpec{
+					Name:          "reserved-prefix",
+					ResourceGroup: "reserved-ips",
+					ID:            "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPPrefixes/reserved-prefix",
+				}})
+			},
+		},
 		{
 			name:          "fail to delete managed public IP",
 			expectedError: internalError.Error(),
-			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(s *mock_publicips.MockPublicIPScopeMockRecorder, m *mock_async.MockTagsGetterMockRecorder, r, p *mock_async.MockReconcilerMockRecorder) {
 				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				s.PublicIPSpecs().Return([]azure.ResourceSpecGetter{&fakePublicIPSpec1, &fakePublicIPSpec2, &fakePublicIPSpec3, &fakePublicIPSpecIpv6})
+				s.PublicIPPrefixSpecs().Return(nil)
 
 				s.SubscriptionID().Return("123")
 				m.GetAtScope(gomockinternal.AContext(), azure.PublicIPID("123", fakePublicIPSpec1.ResourceGroupName(), fakePublicIPSpec1.ResourceName())).Return(managedTags, nil)
@@ -308,13 +551,15 @@ func TestDeletePublicIP(t *testing.T) {
 			scopeMock := mock_publicips.NewMockPublicIPScope(mockCtrl)
 			tagsGetterMock := mock_async.NewMockTagsGetter(mockCtrl)
 			reconcilerMock := mock_async.NewMockReconciler(mockCtrl)
+			prefixReconcilerMock := mock_async.NewMockReconciler(mockCtrl)
 
-			tc.expect(scopeMock.EXPECT(), tagsGetterMock.EXPECT(), reconcilerMock.EXPECT())
+			tc.expect(scopeMock.EXPECT(), tagsGetterMock.EXPECT(), reconcilerMock.EXPECT(), prefixReconcilerMock.EXPECT())
 
 			s := &Service{
-				Scope:      scopeMock,
-				TagsGetter: tagsGetterMock,
-				Reconciler: reconcilerMock,
+				Scope:            scopeMock,
+				TagsGetter:       tagsGetterMock,
+				Reconciler:       reconcilerMock,
+				prefixReconciler: prefixReconcilerMock,
 			}
 
 			err := s.Delete(context.TODO())
@@ -39,6 +39,10 @@ type PublicIPSpec struct {
 	FailureDomains   []*string
 	AdditionalTags   infrav1.Tags
 	IPTags           []infrav1.IPTag
+	// ID is the resource ID of an existing public IP, which is neither created nor deleted.
+	ID string
+	// PublicIPPrefixID is the resource ID of an existing public IP prefix the public IP is allocated from.
+	PublicIPPrefixID string
 }
 
 // ResourceName returns the name of the public IP.
@@ -80,6 +84,11 @@ func (s *PublicIPSpec) Parameters(ctx context.Context, existing interface{}) (pa
 		}
 	}
 
+	var publicIPPrefix *armnetwork.SubResource
+	if s.PublicIPPrefixID != "" {
+		publicIPPrefix = &armnetwork.SubResource{ID: ptr.To(s.PublicIPPrefixID)}
+	}
+
 	return armnetwork.PublicIPAddress{
 		Tags: converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
 			ClusterName: s.ClusterName,
@@ -96,7 +105,133 @@ func (s *PublicIPSpec) Parameters(ctx context.Context, existing interface{}) (pa
 			PublicIPAllocationMethod: ptr.To(armnetwork.IPAllocationMethodStatic),
 			DNSSettings:              dnsSettings,
 			IPTags:                   converters.IPTagsToSDK(s.IPTags),
+			PublicIPPrefix:           publicIPPrefix,
 		},
 		Zones: s.FailureDomains,
 	}, nil
 }
+
+// validateExisting checks that the existing public IP referenced by ID can be used by the cluster.
+func (s *PublicIPSpec) validateExisting(existing armnetwork.PublicIPAddress) error {
+	if existing.SKU == nil || ptr.Deref(existing.SKU.Name, "") != armnetwork.PublicIPAddressSKUNameStandard {
+		return errors.Errorf("public IP %s must be a Standard SKU public IP", s.ID)
+	}
+	if !strings.EqualFold(ptr.Deref(existing.Location, ""), s.Location) {
+		return errors.Errorf("public IP %s must be in location %s", s.ID, s.Location)
+	}
+	addressVersion := armnetwork.IPVersionIPv4
+	if s.IsIPv6 {
+		addressVersion = armnetwork.IPVersionIPv6
+	}
+	if existing.Properties != nil && ptr.Deref(existing.Properties.PublicIPAddressVersion, armnetwork.IPVersionIPv4) != addressVersion {
+		return errors.Errorf("public IP %s must be an %s public IP", s.ID, addressVersion)
+	}
+	if !zonesCover(existing.Zones, s.FailureDomains) {
+		return errors.Errorf("public IP %s must be zone-redundant across the cluster's failure domains or non-zonal", s.ID)
+	}
+	return nil
+}
+
+// PublicIPPrefixSpec defines the specification for a Public IP Prefix.
+type PublicIPPrefixSpec struct {
+	Name             string
+	ResourceGroup    string
+	ClusterName      string
+	Location         string
+	ExtendedLocation *infrav1.ExtendedLocationSpec
+	PrefixLength     int32
+	FailureDomains   []*string
+	AdditionalTags   infrav1.Tags
+	// ID is the resource ID of an existing public IP prefix, which is neither created nor deleted.
+	ID string
+}
+
+// ResourceName returns the name of the public IP prefix.
+func (s *PublicIPPrefixSpec) ResourceName() string {
+	return s.Name
+}
+
+// ResourceGroupName returns the name of the resource group.
+func (s *PublicIPPrefixSpec) ResourceGroupName() string {
+	return s.ResourceGroup
+}
+
+// OwnerResourceName is a no-op for public IP prefixes.
+func (s *PublicIPPrefixSpec) OwnerResourceName() string {
+	return ""
+}
+
+// Parameters returns the parameters for the public IP prefix.
+func (s *PublicIPPrefixSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	if existing != nil {
+		if _, ok := existing.(armnetwork.PublicIPPrefix); !ok {
+			return nil, errors.Errorf("%T is not an armnetwork.PublicIPPrefix", existing)
+		}
+		// public IP prefix already exists, its length cannot be changed
+		return nil, nil
+	}
+
+	return armnetwork.PublicIPPrefix{
+		Tags: converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
+			ClusterName: s.ClusterName,
+			Lifecycle:   infrav1.ResourceLifecycleOwned,
+			Name:        ptr.To(s.Name),
+			Additional:  s.AdditionalTags,
+		})),
+		SKU: &armnetwork.PublicIPPrefixSKU{
+			Name: ptr.To(armnetwork.PublicIPPrefixSKUNameStandard),
+			Tier: ptr.To(armnetwork.PublicIPPrefixSKUTierRegional),
+		},
+		Name:             ptr.To(s.Name),
+		Location:         ptr.To(s.Location),
+		ExtendedLocation: converters.ExtendedLocationToNetworkSDK(s.ExtendedLocation),
+		Properties: &armnetwork.PublicIPPrefixPropertiesFormat{
+			PrefixLength:           ptr.To(s.PrefixLength),
+			PublicIPAddressVersion: ptr.To(armnetwork.IPVersionIPv4),
+		},
+		Zones: s.FailureDomains,
+	}, nil
+}
+
+// validateExisting checks that the existing public IP prefix referenced by ID can be used by the cluster.
+func (s *PublicIPPrefixSpec) validateExisting(existing armnetwork.PublicIPPrefix) error {
+	if existing.SKU == nil || ptr.Deref(existing.SKU.Name, "") != armnetwork.PublicIPPrefixSKUNameStandard {
+		return errors.Errorf("public IP prefix %s must be a Standard SKU public IP prefix", s.ID)
+	}
+	if !strings.EqualFold(ptr.Deref(existing.Location, ""), s.Location) {
+		return errors.Errorf("public IP prefix %s must be in location %s", s.ID, s.Location)
+	}
+	if existing.Properties != nil {
+		if ptr.Deref(existing.Properties.PublicIPAddressVersion, armnetwork.IPVersionIPv4) != armnetwork.IPVersionIPv4 {
+			return errors.Errorf("public IP prefix %s must be an IPv4 public IP prefix", s.ID)
+		}
+		if ptr.Deref(existing.Properties.PrefixLength, s.PrefixLength) != s.PrefixLength {
+			return errors.Errorf("public IP prefix %s must have a prefix length of %d", s.ID, s.PrefixLength)
+		}
+	}
+	if !zonesCover(existing.Zones, s.FailureDomains) {
+		return errors.Errorf("public IP prefix %s must be zone-redundant across the cluster's failure domains or non-zonal", s.ID)
+	}
+	return nil
+}
+
+// zonesCover returns true if a resource in the given zones can serve all the failure domains,
+// i.e. it is non-zonal or in all of the failure domains.
+func zonesCover(zones []*string, failureDomains []*string) bool {
+	if len(zones) == 0 {
+		return true
+	}
+	for _, fd := range failureDomains {
+		found := false
+		for _, zone := range zones {
+			if ptr.Deref(zone, "") == ptr.Deref(fd, "") {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
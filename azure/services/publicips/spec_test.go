@@ -50,6 +50,13 @@ var (
 		FailureDomains: []*string{ptr.To("failure-domain-id-1"), ptr.To("failure-domain-id-2"), ptr.To("failure-domain-id-3")},
 	}
 
+	fakePublicIPSpecFromPrefix = PublicIPSpec{
+		Name:             "my-publicip-3",
+		Location:         "centralIndia",
+		ClusterName:      "my-cluster",
+		PublicIPPrefixID: "/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPPrefixes/reserved-prefix",
+	}
+
 	fakePublicIPWithDNS = armnetwork.PublicIPAddress{
 		Name:     ptr.To("my-publicip"),
 		SKU:      &armnetwork.PublicIPAddressSKU{Name: ptr.To(armnetwork.PublicIPAddressSKUNameStandard)},
@@ -86,6 +93,23 @@ var (
 		Zones: []*string{ptr.To("failure-domain-id-1"), ptr.To("failure-domain-id-2"), ptr.To("failure-domain-id-3")},
 	}
 
+	fakePublicIPFromPrefix = armnetwork.PublicIPAddress{
+		Name:     ptr.To("my-publicip-3"),
+		SKU:      &armnetwork.PublicIPAddressSKU{Name: ptr.To(armnetwork.PublicIPAddressSKUNameStandard)},
+		Location: ptr.To("centralIndia"),
+		Tags: map[string]*string{
+			"Name": ptr.To("my-publicip-3"),
+			"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": ptr.To("owned"),
+		},
+		Properties: &armnetwork.PublicIPAddressPropertiesFormat{
+			PublicIPAddressVersion:   ptr.To(armnetwork.IPVersionIPv4),
+			PublicIPAllocationMethod: ptr.To(armnetwork.IPAllocationMethodStatic),
+			PublicIPPrefix: &armnetwork.SubResource{
+				ID: ptr.To("/subscriptions/123/resourceGroups/reserved-ips/providers/Microsoft.Network/publicIPPrefixes/reserved-prefix"),
+			},
+		},
+	}
+
 	fakePublicIPIpv6 = armnetwork.PublicIPAddress{
 		Name:     ptr.To("my-publicip-ipv6"),
 		SKU:      &armnetwork.PublicIPAddressSKU{Name: ptr.To(armnetwork.PublicIPAddressSKUNameStandard)},
@@ -136,6 +160,13 @@ func TestParameters(t *testing.T) {
 			expected:      fakePublicIPWithoutDNS,
 			expectedError: "",
 		},
+		{
+			name:          "public ipv4 address allocated from an existing public IP prefix",
+			existing:      nil,
+			spec:          fakePublicIPSpecFromPrefix,
+			expected:      fakePublicIPFromPrefix,
+			expectedError: "",
+		},
 		{
 			name:          "public ipv6 address with dns",
 			existing:      nil,
@@ -165,3 +196,71 @@ func TestParameters(t *testing.T) {
 		})
 	}
 }
+
+func TestPublicIPPrefixParameters(t *testing.T) {
+	testCases := []struct {
+		name          string
+		existing      interface{}
+		spec          PublicIPPrefixSpec
+		expected      interface{}
+		expectedError string
+	}{
+		{
+			name:          "noop if public IP prefix exists",
+			existing:      armnetwork.PublicIPPrefix{Name: ptr.To("my-publicip-prefix")},
+			spec:          fakePublicIPPrefixSpec, // In publicips_test.go
+			expected:      nil,
+			expectedError: "",
+		},
+		{
+			name:          "public ipv4 prefix",
+			existing:      nil,
+			spec:          fakePublicIPPrefixSpec,
+			expectedError: "",
+			expected: armnetwork.PublicIPPrefix{
+				Name:     ptr.To("my-publicip-prefix"),
+				Location: ptr.To("centralIndia"),
+				Tags: map[string]*string{
+					"Name": ptr.To("my-publicip-prefix"),
+					"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": ptr.To("owned"),
+				},
+				SKU: &armnetwork.PublicIPPrefixSKU{
+					Name: ptr.To(armnetwork.PublicIPPrefixSKUNameStandard),
+					Tier: ptr.To(armnetwork.PublicIPPrefixSKUTierRegional),
+				},
+				Properties: &armnetwork.PublicIPPrefixPropertiesFormat{
+					PrefixLength:           ptr.To[int32](31),
+					PublicIPAddressVersion: ptr.To(armnetwork.IPVersionIPv4),
+				},
+				Zones: []*string{ptr.To("failure-domain-id-1"), ptr.To("failure-domain-id-2"), ptr.To("failure-domain-id-3")},
+			},
+		},
+		{
+			name:          "error if existing is not a public IP prefix",
+			existing:      armnetwork.PublicIPAddress{},
+			spec:          fakePublicIPPrefixSpec,
+			expected:      nil,
+			expectedError: "armnetwork.PublicIPAddress is not an armnetwork.PublicIPPrefix",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewWithT(t)
+			t.Parallel()
+
+			result, err := tc.spec.Parameters(context.TODO(), tc.existing)
+			if tc.expectedError != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err).To(MatchError(tc.expectedError))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("Diff between expected result and actual result:\n%s", cmp.Diff(tc.expected, result))
+			}
+		})
+	}
+}
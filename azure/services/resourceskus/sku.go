@@ -75,8 +75,26 @@ const (
 	ConfidentialComputingType = "ConfidentialComputingType"
 	// CPUArchitectureType identifies the capability for cpu architecture.
 	CPUArchitectureType = "CpuArchitectureType"
+	// PremiumIO identifies the capability for premium storage support.
+	PremiumIO = "PremiumIO"
+	// SupportedEphemeralOSDiskPlacements identifies the capability for the local storage ephemeral OS disks can be placed on.
+	SupportedEphemeralOSDiskPlacements = "SupportedEphemeralOSDiskPlacements"
+	// CachedDiskBytes identifies the capability for the size of the cache disk in bytes.
+	CachedDiskBytes = "CachedDiskBytes"
+	// MaxResourceVolumeMB identifies the capability for the size of the temporary resource disk in MB.
+	MaxResourceVolumeMB = "MaxResourceVolumeMB"
+	// NvmeDiskSizeInMiB identifies the capability for the size of the local NVMe disks in MiB.
+	NvmeDiskSizeInMiB = "NvmeDiskSizeInMiB"
 )
 
+// ephemeralOSDiskPlacements is the order in which ephemeral OS disk placements are picked by default,
+// which matches the order Azure uses when no placement is requested.
+var ephemeralOSDiskPlacements = []armcompute.DiffDiskPlacement{
+	armcompute.DiffDiskPlacementCacheDisk,
+	armcompute.DiffDiskPlacementResourceDisk,
+	armcompute.DiffDiskPlacementNvmeDisk,
+}
+
 // HasCapability return true for a capability which can be either
 // supported or not. Examples include "EphemeralOSDiskSupported",
 // "UltraSSDAvavailable" "EncryptionAtHostSupported",
@@ -169,3 +187,103 @@ func (s SKU) HasLocationCapability(capabilityName, location, zone string) bool {
 	}
 	return false
 }
+
+// EphemeralOSDiskPlacement returns the local storage an ephemeral OS disk of the given size is placed on.
+// If placement is empty, the first supported placement with enough space for the disk is returned.
+// An error is returned if the SKU doesn't support the placement or its local storage is too small for the disk.
+// The disk size isn't checked if it is unknown, and if the SKU doesn't report its local storage, the placement is
+// returned as is so that Azure can pick or validate it.
+func (s SKU) EphemeralOSDiskPlacement(placement armcompute.DiffDiskPlacement, diskSizeGB *int32) (armcompute.DiffDiskPlacement, error) {
+	if !s.HasCapability(EphemeralOSDisk) {
+		return "", errors.Errorf("vm size %s does not support ephemeral os", ptr.Deref(s.Name, ""))
+	}
+
+	if !s.reportsLocalStorage() {
+		return placement, nil
+	}
+
+	if placement != "" {
+		if !s.supportsEphemeralOSDiskPlacement(placement) {
+			return "", errors.Errorf("vm size %s does not support ephemeral os disk placement %s", ptr.Deref(s.Name, ""), placement)
+		}
+		if !s.fitsEphemeralOSDisk(placement, diskSizeGB) {
+			return "", errors.Errorf("the %s of vm size %s is too small for an ephemeral os disk of %dGB", placement, ptr.Deref(s.Name, ""), ptr.Deref(diskSizeGB, 0))
+		}
+		return placement, nil
+	}
+
+	for _, p := range ephemeralOSDiskPlacements {
+		if s.supportsEphemeralOSDiskPlacement(p) && s.fitsEphemeralOSDisk(p, diskSizeGB) {
+			return p, nil
+		}
+	}
+	return "", errors.Errorf("the local storage of vm size %s is too small for an ephemeral os disk of %dGB", ptr.Deref(s.Name, ""), ptr.Deref(diskSizeGB, 0))
+}
+
+// reportsLocalStorage returns true if the SKU reports the local storage ephemeral OS disks can be placed on.
+func (s SKU) reportsLocalStorage() bool {
+	for _, capability := range []string{SupportedEphemeralOSDiskPlacements, CachedDiskBytes, MaxResourceVolumeMB} {
+		if _, ok := s.GetCapability(capability); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// supportsEphemeralOSDiskPlacement returns true if the SKU supports placing an ephemeral OS disk on the given local storage.
+// SKUs that don't report their supported placements support the cache and resource disks they have.
+func (s SKU) supportsEphemeralOSDiskPlacement(placement armcompute.DiffDiskPlacement) bool {
+	if supported, ok := s.GetCapability(SupportedEphemeralOSDiskPlacements); ok {
+		for _, p := range strings.Split(supported, ",") {
+			if strings.EqualFold(strings.TrimSpace(p), string(placement)) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if placement == armcompute.DiffDiskPlacementNvmeDisk {
+		return false
+	}
+	size, _ := s.ephemeralOSDiskPlacementSizeBytes(placement)
+	return size > 0
+}
+
+// fitsEphemeralOSDisk returns true if the given local storage is large enough for an ephemeral OS disk of the given size.
+// It also returns true if either size is unknown, leaving the final check to Azure.
+func (s SKU) fitsEphemeralOSDisk(placement armcompute.DiffDiskPlacement, diskSizeGB *int32) bool {
+	if diskSizeGB == nil {
+		return true
+	}
+	size, ok := s.ephemeralOSDiskPlacementSizeBytes(placement)
+	if !ok {
+		return true
+	}
+	return size >= int64(*diskSizeGB)*1024*1024*1024
+}
+
+// ephemeralOSDiskPlacementSizeBytes returns the size in bytes of the local storage for the given placement, if the SKU reports it.
+func (s SKU) ephemeralOSDiskPlacementSizeBytes(placement armcompute.DiffDiskPlacement) (int64, bool) {
+	var capability string
+	var unit int64
+	switch placement {
+	case armcompute.DiffDiskPlacementCacheDisk:
+		capability, unit = CachedDiskBytes, 1
+	case armcompute.DiffDiskPlacementResourceDisk:
+		capability, unit = MaxResourceVolumeMB, 1024*1024
+	case armcompute.DiffDiskPlacementNvmeDisk:
+		capability, unit = NvmeDiskSizeInMiB, 1024*1024
+	default:
+		return 0, false
+	}
+
+	value, ok := s.GetCapability(capability)
+	if !ok {
+		return 0, false
+	}
+	size, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return size * unit, true
+}
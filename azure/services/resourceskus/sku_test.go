@@ -0,0 +1,169 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceskus
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func skuWithCapabilities(capabilities map[string]string) SKU {
+	sku := SKU{Name: ptr.To("Standard_Test")}
+	for name, value := range capabilities {
+		sku.Capabilities = append(sku.Capabilities, &armcompute.ResourceSKUCapabilities{
+			Name:  ptr.To(name),
+			Value: ptr.To(value),
+		})
+	}
+	return sku
+}
+
+func TestEphemeralOSDiskPlacement(t *testing.T) {
+	tests := []struct {
+		name          string
+		sku           SKU
+		placement     armcompute.DiffDiskPlacement
+		diskSizeGB    *int32
+		want          armcompute.DiffDiskPlacement
+		expectedError string
+	}{
+		{
+			name:          "ephemeral os is not supported",
+			sku:           skuWithCapabilities(map[string]string{EphemeralOSDisk: "False"}),
+			expectedError: "vm size Standard_Test does not support ephemeral os",
+		},
+		{
+			name:       "local storage is not reported",
+			sku:        skuWithCapabilities(map[string]string{EphemeralOSDisk: "True"}),
+			diskSizeGB: ptr.To[int32](30),
+			want:       "",
+		},
+		{
+			name: "defaults to the cache disk when it is large enough",
+			sku: skuWithCapabilities(map[string]string{
+				EphemeralOSDisk:                    "True",
+				SupportedEphemeralOSDiskPlacements: "ResourceDisk,CacheDisk",
+				CachedDiskBytes:                    "53687091200",
+				MaxResourceVolumeMB:                "102400",
+			}),
+			diskSizeGB: ptr.To[int32](50),
+			want:       armcompute.DiffDiskPlacementCacheDisk,
+		},
+		{
+			name: "defaults to the resource disk when the cache disk is too small",
+			sku: skuWithCapabilities(map[string]string{
+				EphemeralOSDisk:                    "True",
+				SupportedEphemeralOSDiskPlacements: "ResourceDisk,CacheDisk",
+				CachedDiskBytes:                    "53687091200",
+				MaxResourceVolumeMB:                "102400",
+			}),
+			diskSizeGB: ptr.To[int32](64),
+			want:       armcompute.DiffDiskPlacementResourceDisk,
+		},
+		{
+			name: "defaults to the NVMe disk for sizes without cache or resource disks",
+			sku: skuWithCapabilities(map[string]string{
+				EphemeralOSDisk:                    "True",
+				SupportedEphemeralOSDiskPlacements: "NvmeDisk",
+				NvmeDiskSizeInMiB:                  "225280",
+			}),
+			diskSizeGB: ptr.To[int32](50),
+			want:       armcompute.DiffDiskPlacementNvmeDisk,
+		},
+		{
+			name: "infers supported placements when they are not reported",
+			sku: skuWithCapabilities(map[string]string{
+				EphemeralOSDisk:     "True",
+				CachedDiskBytes:     "0",
+				MaxResourceVolumeMB: "102400",
+			}),
+			diskSizeGB: ptr.To[int32](30),
+			want:       armcompute.DiffDiskPlacementResourceDisk,
+		},
+		{
+			name: "defaults without a disk size",
+			sku: skuWithCapabilities(map[string]string{
+				EphemeralOSDisk:                    "True",
+				SupportedEphemeralOSDiskPlacements: "NvmeDisk,ResourceDisk",
+				MaxResourceVolumeMB:                "102400",
+			}),
+			want: armcompute.DiffDiskPlacementResourceDisk,
+		},
+		{
+			name: "local storage is too small",
+			sku: skuWithCapabilities(map[string]string{
+				EphemeralOSDisk:                    "True",
+				SupportedEphemeralOSDiskPlacements: "ResourceDisk",
+				MaxResourceVolumeMB:                "16384",
+			}),
+			diskSizeGB:    ptr.To[int32](30),
+			expectedError: "the local storage of vm size Standard_Test is too small for an ephemeral os disk of 30GB",
+		},
+		{
+			name: "requested placement is supported",
+			sku: skuWithCapabilities(map[string]string{
+				EphemeralOSDisk:                    "True",
+				SupportedEphemeralOSDiskPlacements: "ResourceDisk,CacheDisk",
+				CachedDiskBytes:                    "53687091200",
+				MaxResourceVolumeMB:                "102400",
+			}),
+			placement:  armcompute.DiffDiskPlacementResourceDisk,
+			diskSizeGB: ptr.To[int32](30),
+			want:       armcompute.DiffDiskPlacementResourceDisk,
+		},
+		{
+			name: "requested placement is not supported",
+			sku: skuWithCapabilities(map[string]string{
+				EphemeralOSDisk:                    "True",
+				SupportedEphemeralOSDiskPlacements: "ResourceDisk,CacheDisk",
+				CachedDiskBytes:                    "53687091200",
+				MaxResourceVolumeMB:                "102400",
+			}),
+			placement:     armcompute.DiffDiskPlacementNvmeDisk,
+			expectedError: "vm size Standard_Test does not support ephemeral os disk placement NvmeDisk",
+		},
+		{
+			name: "requested placement is too small",
+			sku: skuWithCapabilities(map[string]string{
+				EphemeralOSDisk:                    "True",
+				SupportedEphemeralOSDiskPlacements: "ResourceDisk,CacheDisk",
+				CachedDiskBytes:                    "53687091200",
+				MaxResourceVolumeMB:                "102400",
+			}),
+			placement:     armcompute.DiffDiskPlacementCacheDisk,
+			diskSizeGB:    ptr.To[int32](64),
+			expectedError: "the CacheDisk of vm size Standard_Test is too small for an ephemeral os disk of 64GB",
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			got, err := tc.sku.EphemeralOSDiskPlacement(tc.placement, tc.diskSizeGB)
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(tc.expectedError))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got).To(Equal(tc.want))
+		})
+	}
+}
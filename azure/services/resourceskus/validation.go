@@ -0,0 +1,143 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceskus
+
+import (
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	"github.com/pkg/errors"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/utils/ptr"
+)
+
+// VMRequirements describes the features a virtual machine or scale set requires from its VM size.
+type VMRequirements struct {
+	// Location is the location the VMs are created in.
+	Location string
+	// Zones are the availability zones the VMs are created in.
+	Zones []string
+	// AcceleratedNetworking is true if a network interface explicitly enables accelerated networking.
+	AcceleratedNetworking bool
+	// PremiumStorage is true if the OS disk or a data disk uses a premium storage account type.
+	PremiumStorage bool
+	// EphemeralOSDisk is true if the OS disk is ephemeral.
+	EphemeralOSDisk bool
+	// EphemeralOSDiskPlacement is the requested placement of the ephemeral OS disk, if any.
+	EphemeralOSDiskPlacement armcompute.DiffDiskPlacement
+	// OSDiskSizeGB is the size of the OS disk, if known.
+	OSDiskSizeGB *int32
+	// EncryptionAtHost is true if encryption at host is enabled.
+	EncryptionAtHost bool
+	// TrustedLaunch is true if secure boot or vTPM are enabled with the TrustedLaunch security type.
+	TrustedLaunch bool
+	// ConfidentialComputing is true if the VMs are confidential VMs.
+	ConfidentialComputing bool
+	// UltraSSD is true if a data disk uses the UltraSSD_LRS storage account type or ultra disks are enabled.
+	UltraSSD bool
+}
+
+// ValidateVMRequirements returns an error listing every requirement the SKU doesn't meet.
+// Capabilities the SKU doesn't report are assumed to be supported, leaving the final check to Azure.
+func (s SKU) ValidateVMRequirements(req VMRequirements) error {
+	name := ptr.Deref(s.Name, "")
+	var errs []error
+
+	if req.AcceleratedNetworking && s.reportsCapability(AcceleratedNetworking) && !s.HasCapability(AcceleratedNetworking) {
+		errs = append(errs, errors.Errorf("vm size %s does not support accelerated networking", name))
+	}
+
+	if req.PremiumStorage && s.reportsCapability(PremiumIO) && !s.HasCapability(PremiumIO) {
+		errs = append(errs, errors.Errorf("vm size %s does not support premium storage", name))
+	}
+
+	if req.EphemeralOSDisk {
+		if _, err := s.EphemeralOSDiskPlacement(req.EphemeralOSDiskPlacement, req.OSDiskSizeGB); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if req.EncryptionAtHost && !s.HasCapability(EncryptionAtHost) {
+		errs = append(errs, errors.Errorf("vm size %s does not support encryption at host", name))
+	}
+
+	if req.TrustedLaunch && s.HasCapability(TrustedLaunchDisabled) {
+		errs = append(errs, errors.Errorf("vm size %s does not support trusted launch", name))
+	}
+
+	if req.ConfidentialComputing {
+		if _, ok := s.GetCapability(ConfidentialComputingType); !ok {
+			errs = append(errs, errors.Errorf("vm size %s does not support confidential computing", name))
+		}
+	}
+
+	for _, zone := range req.Zones {
+		if !s.supportsZone(req.Location, zone) {
+			errs = append(errs, errors.Errorf("vm size %s is not available in zone %s of location %s", name, zone, req.Location))
+			continue
+		}
+		if req.UltraSSD && !s.HasLocationCapability(UltraSSDAvailable, req.Location, zone) {
+			errs = append(errs, errors.Errorf("vm size %s does not support ultra disks in zone %s of location %s", name, zone, req.Location))
+		}
+	}
+
+	return kerrors.NewAggregate(errs)
+}
+
+// reportsCapability returns true if the SKU reports a value for the capability.
+func (s SKU) reportsCapability(name string) bool {
+	_, ok := s.GetCapability(name)
+	return ok
+}
+
+// supportsZone returns true if the SKU can be deployed to the zone of the location.
+// It also returns true if the SKU doesn't report any zones for the location, as the location may not support
+// availability zones.
+func (s SKU) supportsZone(location, zone string) bool {
+	for _, info := range s.LocationInfo {
+		if info == nil || !strings.EqualFold(ptr.Deref(info.Location, ""), location) {
+			continue
+		}
+		if len(info.Zones) == 0 {
+			return true
+		}
+
+		for _, restriction := range s.Restrictions {
+			if restriction == nil {
+				continue
+			}
+			if ptr.Deref(restriction.Type, "") == armcompute.ResourceSKURestrictionsTypeLocation {
+				return false
+			}
+			if restriction.RestrictionInfo != nil {
+				for _, restrictedZone := range restriction.RestrictionInfo.Zones {
+					if ptr.Deref(restrictedZone, "") == zone {
+						return false
+					}
+				}
+			}
+		}
+
+		for _, z := range info.Zones {
+			if ptr.Deref(z, "") == zone {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
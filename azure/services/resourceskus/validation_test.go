@@ -0,0 +1,163 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceskus
+
+import (
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+)
+
+func TestValidateVMRequirements(t *testing.T) {
+	zonalSKU := func(capabilities map[string]string) SKU {
+		sku := skuWithCapabilities(capabilities)
+		sku.LocationInfo = []*armcompute.ResourceSKULocationInfo{
+			{
+				Location: ptr.To("eastus"),
+				Zones:    []*string{ptr.To("1"), ptr.To("2"), ptr.To("3")},
+				ZoneDetails: []*armcompute.ResourceSKUZoneDetails{
+					{
+						Name: []*string{ptr.To("1")},
+						Capabilities: []*armcompute.ResourceSKUCapabilities{
+							{
+								Name:  ptr.To(UltraSSDAvailable),
+								Value: ptr.To("True"),
+							},
+						},
+					},
+				},
+			},
+		}
+		sku.Restrictions = []*armcompute.ResourceSKURestrictions{
+			{
+				Type: ptr.To(armcompute.ResourceSKURestrictionsTypeZone),
+				RestrictionInfo: &armcompute.ResourceSKURestrictionInfo{
+					Zones: []*string{ptr.To("3")},
+				},
+			},
+		}
+		return sku
+	}
+
+	tests := []struct {
+		name          string
+		sku           SKU
+		req           VMRequirements
+		expectedError string
+	}{
+		{
+			name: "no requirements",
+			sku:  skuWithCapabilities(nil),
+			req:  VMRequirements{Location: "eastus"},
+		},
+		{
+			name: "all requirements are supported",
+			sku: zonalSKU(map[string]string{
+				AcceleratedNetworking:     "True",
+				PremiumIO:                 "True",
+				EphemeralOSDisk:           "True",
+				EncryptionAtHost:          "True",
+				ConfidentialComputingType: "SNP",
+			}),
+			req: VMRequirements{
+				Location:              "eastus",
+				Zones:                 []string{"1"},
+				AcceleratedNetworking: true,
+				PremiumStorage:        true,
+				EphemeralOSDisk:       true,
+				EncryptionAtHost:      true,
+				TrustedLaunch:         true,
+				ConfidentialComputing: true,
+				UltraSSD:              true,
+			},
+		},
+		{
+			name: "capabilities that are not reported are assumed to be supported",
+			sku:  skuWithCapabilities(nil),
+			req: VMRequirements{
+				Location:              "eastus",
+				Zones:                 []string{"1"},
+				AcceleratedNetworking: true,
+				PremiumStorage:        true,
+				TrustedLaunch:         true,
+			},
+		},
+		{
+			name: "every unsupported requirement is reported",
+			sku: skuWithCapabilities(map[string]string{
+				AcceleratedNetworking: "False",
+				PremiumIO:             "False",
+				EphemeralOSDisk:       "False",
+				EncryptionAtHost:      "False",
+				TrustedLaunchDisabled: "True",
+			}),
+			req: VMRequirements{
+				Location:              "eastus",
+				AcceleratedNetworking: true,
+				PremiumStorage:        true,
+				EphemeralOSDisk:       true,
+				EncryptionAtHost:      true,
+				TrustedLaunch:         true,
+				ConfidentialComputing: true,
+			},
+			expectedError: "[vm size Standard_Test does not support accelerated networking, " +
+				"vm size Standard_Test does not support premium storage, " +
+				"vm size Standard_Test does not support ephemeral os, " +
+				"vm size Standard_Test does not support encryption at host, " +
+				"vm size Standard_Test does not support trusted launch, " +
+				"vm size Standard_Test does not support confidential computing]",
+		},
+		{
+			name:          "zone is restricted",
+			sku:           zonalSKU(nil),
+			req:           VMRequirements{Location: "eastus", Zones: []string{"3"}},
+			expectedError: "vm size Standard_Test is not available in zone 3 of location eastus",
+		},
+		{
+			name:          "zone does not exist",
+			sku:           zonalSKU(nil),
+			req:           VMRequirements{Location: "eastus", Zones: []string{"4"}},
+			expectedError: "vm size Standard_Test is not available in zone 4 of location eastus",
+		},
+		{
+			name:          "ultra disks are not supported in zone",
+			sku:           zonalSKU(nil),
+			req:           VMRequirements{Location: "eastus", Zones: []string{"1", "2"}, UltraSSD: true},
+			expectedError: "vm size Standard_Test does not support ultra disks in zone 2 of location eastus",
+		},
+		{
+			name: "zones are not checked in locations without zone information",
+			sku:  zonalSKU(nil),
+			req:  VMRequirements{Location: "westus", Zones: []string{"1"}},
+		},
+	}
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			err := tc.sku.ValidateVMRequirements(tc.req)
+			if tc.expectedError != "" {
+				g.Expect(err).To(MatchError(tc.expectedError))
+				return
+			}
+			g.Expect(err).NotTo(HaveOccurred())
+		})
+	}
+}
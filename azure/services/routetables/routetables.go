@@ -35,6 +35,7 @@ type RouteTableScope interface {
 	azure.AsyncStatusUpdater
 	RouteTableSpecs() []azure.ResourceSpecGetter
 	IsVnetManaged() bool
+	UpdateAnnotationJSON(string, map[string]interface{}) error
 }
 
 // Service provides operations on azure resources.
@@ -86,12 +87,32 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	// We go through the list of route tables to reconcile each one, independently of the result of the previous one.
 	// If multiple errors occur, we return the most pressing one.
 	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
-	for _, rtSpec := range specs {
+	newAnnotation := make(map[string]interface{})
+	for _, resourceSpec := range specs {
+		rtSpec := resourceSpec.(*RouteTableSpec)
+		currentAnnotation := make(map[string]interface{})
+
 		if _, err := s.CreateOrUpdateResource(ctx, rtSpec, serviceName); err != nil {
 			if !azure.IsOperationNotDoneError(err) || resErr == nil {
 				resErr = err
 			}
+			// Keep tracking the previously applied routes until the route table is updated so that removed routes still get deleted.
+			for name, prefix := range rtSpec.LastAppliedRoutes {
+				currentAnnotation[name] = prefix
+			}
+		}
+
+		for _, route := range rtSpec.Routes {
+			currentAnnotation[route.Name] = route.AddressPrefix
 		}
+
+		if len(currentAnnotation) > 0 {
+			newAnnotation[rtSpec.Name] = currentAnnotation
+		}
+	}
+
+	if err := s.Scope.UpdateAnnotationJSON(azure.RouteLastAppliedAnnotation, newAnnotation); err != nil {
+		return err
 	}
 
 	s.Scope.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, resErr)
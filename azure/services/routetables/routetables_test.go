@@ -47,6 +47,23 @@ var (
 		Location:      "fake-location",
 		ClusterName:   "test-cluster",
 	}
+	fakeRTWithRoutes = RouteTableSpec{
+		Name:          "test-rt-3",
+		ResourceGroup: "test-rg",
+		Location:      "fake-location",
+		ClusterName:   "test-cluster",
+		Routes: infrav1.Routes{
+			{
+				Name:             "to-nva",
+				AddressPrefix:    "0.0.0.0/0",
+				NextHopType:      infrav1.RouteNextHopTypeVirtualAppliance,
+				NextHopIPAddress: "10.0.0.4",
+			},
+		},
+		LastAppliedRoutes: map[string]interface{}{
+			"to-onprem": "192.168.0.0/16",
+		},
+	}
 	errFake      = errors.New("this is an error")
 	notDoneError = azure.NewOperationNotDoneError(&infrav1.Future{})
 )
@@ -76,6 +93,7 @@ func TestReconcileRouteTables(t *testing.T) {
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT, &fakeRT2})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT, serviceName).Return(nil, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT2, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.RouteLastAppliedAnnotation, map[string]interface{}{}).Return(nil)
 				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, nil)
 			},
 		},
@@ -88,6 +106,7 @@ func TestReconcileRouteTables(t *testing.T) {
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT, &fakeRT2})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT, serviceName).Return(nil, errFake)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT2, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.RouteLastAppliedAnnotation, map[string]interface{}{}).Return(nil)
 				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, errFake)
 			},
 		},
@@ -100,6 +119,40 @@ func TestReconcileRouteTables(t *testing.T) {
 				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRT, &fakeRT2})
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT, serviceName).Return(nil, errFake)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRT2, serviceName).Return(nil, notDoneError)
+				s.UpdateAnnotationJSON(azure.RouteLastAppliedAnnotation, map[string]interface{}{}).Return(nil)
+				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, errFake)
+			},
+		},
+		{
+			name:          "create route table with routes tracks the applied routes",
+			expectedError: "",
+			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(true)
+				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRTWithRoutes})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRTWithRoutes, serviceName).Return(nil, nil)
+				s.UpdateAnnotationJSON(azure.RouteLastAppliedAnnotation, map[string]interface{}{
+					"test-rt-3": map[string]interface{}{
+						"to-nva": "0.0.0.0/0",
+					},
+				}).Return(nil)
+				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, nil)
+			},
+		},
+		{
+			name:          "failed route table update keeps tracking the previously applied routes",
+			expectedError: errFake.Error(),
+			expect: func(s *mock_routetables.MockRouteTableScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+				s.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				s.IsVnetManaged().Return(true)
+				s.RouteTableSpecs().Return([]azure.ResourceSpecGetter{&fakeRTWithRoutes})
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakeRTWithRoutes, serviceName).Return(nil, errFake)
+				s.UpdateAnnotationJSON(azure.RouteLastAppliedAnnotation, map[string]interface{}{
+					"test-rt-3": map[string]interface{}{
+						"to-nva":    "0.0.0.0/0",
+						"to-onprem": "192.168.0.0/16",
+					},
+				}).Return(nil)
 				s.UpdatePutStatus(infrav1.RouteTablesReadyCondition, serviceName, errFake)
 			},
 		},
@@ -18,6 +18,7 @@ package routetables
 
 import (
 	"context"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/pkg/errors"
@@ -33,6 +34,10 @@ type RouteTableSpec struct {
 	Location       string
 	ClusterName    string
 	AdditionalTags infrav1.Tags
+	Routes         infrav1.Routes
+	// LastAppliedRoutes are the names of the routes applied by CAPZ in the previous reconciliation,
+	// used to tell routes removed from the spec apart from routes that CAPZ doesn't manage.
+	LastAppliedRoutes map[string]interface{}
 }
 
 // ResourceName returns the name of the route table.
@@ -52,17 +57,73 @@ func (s *RouteTableSpec) OwnerResourceName() string {
 
 // Parameters returns the parameters for the route table.
 func (s *RouteTableSpec) Parameters(ctx context.Context, existing interface{}) (params interface{}, err error) {
+	routes := make([]*armnetwork.Route, 0, len(s.Routes))
+	properties := &armnetwork.RouteTablePropertiesFormat{}
+	var etag *string
+
 	if existing != nil {
-		if _, ok := existing.(armnetwork.RouteTable); !ok {
+		existingRT, ok := existing.(armnetwork.RouteTable)
+		if !ok {
 			return nil, errors.Errorf("%T is not an armnetwork.RouteTable", existing)
 		}
 		// route table already exists
-		// currently don't support specifying your own routes via spec.
-		return nil, nil
+		// We append the existing route table etag to the header to ensure we only apply the updates if the route table
+		// has not been modified, e.g. by the cloud provider adding pod routes.
+		etag = existingRT.Etag
+		var existingRoutes []*armnetwork.Route
+		if existingRT.Properties != nil {
+			existingRoutes = existingRT.Properties.Routes
+			properties.DisableBgpRoutePropagation = existingRT.Properties.DisableBgpRoutePropagation
+		}
+
+		// Route names are case-insensitive in Azure.
+		lastApplied := make(map[string]struct{}, len(s.LastAppliedRoutes))
+		for name := range s.LastAppliedRoutes {
+			lastApplied[strings.ToLower(name)] = struct{}{}
+		}
+
+		update := false
+		desired := make(map[string]struct{}, len(s.Routes))
+		for _, route := range s.Routes {
+			sdkRoute := converters.RouteToSDK(route)
+			if !routeExists(existingRoutes, sdkRoute) {
+				update = true
+			}
+			routes = append(routes, sdkRoute)
+			desired[strings.ToLower(route.Name)] = struct{}{}
+		}
+
+		for _, oldRoute := range existingRoutes {
+			name := ptr.Deref(oldRoute.Name, "")
+			if _, ok := desired[strings.ToLower(name)]; ok {
+				// The route is already part of the desired routes.
+				continue
+			}
+			// If the route is owned by CAPZ and was applied last, and is not found in the desired routes, then it has been deleted.
+			if _, tracked := lastApplied[strings.ToLower(name)]; tracked {
+				update = true
+				continue
+			}
+			// Keep routes that CAPZ doesn't manage, such as the ones added by the cloud provider.
+			routes = append(routes, oldRoute)
+		}
+
+		if !update {
+			// Skip update for the route table as the desired routes are present
+			return nil, nil
+		}
+	} else {
+		// new route table
+		for _, route := range s.Routes {
+			routes = append(routes, converters.RouteToSDK(route))
+		}
 	}
+	properties.Routes = routes
+
 	return armnetwork.RouteTable{
 		Location:   ptr.To(s.Location),
-		Properties: &armnetwork.RouteTablePropertiesFormat{},
+		Properties: properties,
+		Etag:       etag,
 		Tags: converters.TagsToMap(infrav1.Build(infrav1.BuildParams{
 			ClusterName: s.ClusterName,
 			Lifecycle:   infrav1.ResourceLifecycleOwned,
@@ -71,3 +132,19 @@ func (s *RouteTableSpec) Parameters(ctx context.Context, existing interface{}) (
 		})),
 	}, nil
 }
+
+// routeExists returns true if a route with the same name and properties is in routes.
+func routeExists(routes []*armnetwork.Route, route *armnetwork.Route) bool {
+	for _, existingRoute := range routes {
+		if !strings.EqualFold(ptr.Deref(existingRoute.Name, ""), ptr.Deref(route.Name, "")) {
+			continue
+		}
+		if existingRoute.Properties == nil {
+			return false
+		}
+		return ptr.Deref(existingRoute.Properties.AddressPrefix, "") == ptr.Deref(route.Properties.AddressPrefix, "") &&
+			strings.EqualFold(string(ptr.Deref(existingRoute.Properties.NextHopType, "")), string(ptr.Deref(route.Properties.NextHopType, ""))) &&
+			ptr.Deref(existingRoute.Properties.NextHopIPAddress, "") == ptr.Deref(route.Properties.NextHopIPAddress, "")
+	}
+	return false
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	. "github.com/onsi/gomega"
 	"k8s.io/utils/ptr"
+	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 )
 
 var (
@@ -39,6 +40,46 @@ var (
 			"foo": "bar",
 		},
 	}
+	fakeRouteTableSpecWithRoutes = RouteTableSpec{
+		Name:        "test-rt-1",
+		Location:    "fake-location",
+		ClusterName: "cluster",
+		Routes: infrav1.Routes{
+			{
+				Name:             "to-nva",
+				AddressPrefix:    "0.0.0.0/0",
+				NextHopType:      infrav1.RouteNextHopTypeVirtualAppliance,
+				NextHopIPAddress: "10.0.0.4",
+			},
+		},
+		LastAppliedRoutes: map[string]interface{}{
+			"to-nva":    "0.0.0.0/0",
+			"to-onprem": "192.168.0.0/16",
+		},
+	}
+	nvaRoute = &armnetwork.Route{
+		Name: ptr.To("to-nva"),
+		Properties: &armnetwork.RoutePropertiesFormat{
+			AddressPrefix:    ptr.To("0.0.0.0/0"),
+			NextHopType:      ptr.To(armnetwork.RouteNextHopTypeVirtualAppliance),
+			NextHopIPAddress: ptr.To("10.0.0.4"),
+		},
+	}
+	onPremRoute = &armnetwork.Route{
+		Name: ptr.To("to-onprem"),
+		Properties: &armnetwork.RoutePropertiesFormat{
+			AddressPrefix: ptr.To("192.168.0.0/16"),
+			NextHopType:   ptr.To(armnetwork.RouteNextHopTypeVirtualNetworkGateway),
+		},
+	}
+	podRoute = &armnetwork.Route{
+		Name: ptr.To("k8s-node-0"),
+		Properties: &armnetwork.RoutePropertiesFormat{
+			AddressPrefix:    ptr.To("10.244.0.0/24"),
+			NextHopType:      ptr.To(armnetwork.RouteNextHopTypeVirtualAppliance),
+			NextHopIPAddress: ptr.To("10.1.0.4"),
+		},
+	}
 	fakeRouteTableTags = map[string]*string{
 		"sigs.k8s.io_cluster-api-provider-azure_cluster_cluster": ptr.To("owned"),
 		"foo":  ptr.To("bar"),
@@ -92,6 +133,96 @@ func TestRouteTableSpec_Parameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name:     "get RouteTable with routes when creating it",
+			spec:     &fakeRouteTableSpecWithRoutes,
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.RouteTable{}))
+				g.Expect(result.(armnetwork.RouteTable).Properties.Routes).To(Equal([]*armnetwork.Route{nvaRoute}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "get result as nil when existing RouteTable has the desired routes",
+			spec: &fakeRouteTableSpecWithRoutes,
+			existing: armnetwork.RouteTable{
+				Properties: &armnetwork.RouteTablePropertiesFormat{
+					Routes: []*armnetwork.Route{podRoute, nvaRoute},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name: "add missing routes and keep routes not managed by CAPZ",
+			spec: &fakeRouteTableSpecWithRoutes,
+			existing: armnetwork.RouteTable{
+				Etag: ptr.To("fake-etag"),
+				Properties: &armnetwork.RouteTablePropertiesFormat{
+					Routes:                     []*armnetwork.Route{podRoute},
+					DisableBgpRoutePropagation: ptr.To(true),
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.RouteTable{}))
+				rt := result.(armnetwork.RouteTable)
+				g.Expect(rt.Etag).To(Equal(ptr.To("fake-etag")))
+				g.Expect(rt.Properties.DisableBgpRoutePropagation).To(Equal(ptr.To(true)))
+				g.Expect(rt.Properties.Routes).To(Equal([]*armnetwork.Route{nvaRoute, podRoute}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "update changed routes and delete routes removed from the spec",
+			spec: &fakeRouteTableSpecWithRoutes,
+			existing: armnetwork.RouteTable{
+				Properties: &armnetwork.RouteTablePropertiesFormat{
+					Routes: []*armnetwork.Route{
+						{
+							Name: ptr.To("to-nva"),
+							Properties: &armnetwork.RoutePropertiesFormat{
+								AddressPrefix:    ptr.To("0.0.0.0/0"),
+								NextHopType:      ptr.To(armnetwork.RouteNextHopTypeVirtualAppliance),
+								NextHopIPAddress: ptr.To("10.0.0.5"),
+							},
+						},
+						onPremRoute,
+						podRoute,
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.RouteTable{}))
+				g.Expect(result.(armnetwork.RouteTable).Properties.Routes).To(Equal([]*armnetwork.Route{nvaRoute, podRoute}))
+			},
+			expectedError: "",
+		},
+		{
+			name: "delete routes removed from the spec regardless of the case of their names",
+			spec: &fakeRouteTableSpecWithRoutes,
+			existing: armnetwork.RouteTable{
+				Etag: ptr.To("fake-etag"),
+				Properties: &armnetwork.RouteTablePropertiesFormat{
+					Routes: []*armnetwork.Route{
+						nvaRoute,
+						{
+							Name:       ptr.To("To-OnPrem"),
+							Properties: onPremRoute.Properties,
+						},
+						podRoute,
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.RouteTable{}))
+				g.Expect(result.(armnetwork.RouteTable).Properties.Routes).To(Equal([]*armnetwork.Route{nvaRoute, podRoute}))
+				g.Expect(result.(armnetwork.RouteTable).Etag).To(Equal(ptr.To("fake-etag")))
+			},
+			expectedError: "",
+		},
 	}
 	for _, tc := range testCases {
 		tc := tc
@@ -22,6 +22,7 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/compute/armcompute/v5"
 	"github.com/pkg/errors"
+	"k8s.io/utils/ptr"
 	azprovider "sigs.k8s.io/cloud-provider-azure/pkg/provider"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
@@ -85,17 +86,20 @@ func (s *Service) Reconcile(ctx context.Context) (retErr error) {
 	ctx, cancel := context.WithTimeout(ctx, s.Scope.DefaultedAzureServiceReconcileTimeout())
 	defer cancel()
 
-	if err := s.validateSpec(ctx); err != nil {
-		// do as much early validation as possible to limit calls to Azure
-		return err
-	}
-
 	spec := s.Scope.ScaleSetSpec(ctx)
 	scaleSetSpec, ok := spec.(*ScaleSetSpec)
 	if !ok {
 		return errors.Errorf("%T is not of type ScaleSetSpec", spec)
 	}
 
+	// The VM size is only validated when the model is updated, as an existing scale set keeps its current model otherwise.
+	if !scaleSetSpec.SkipModelUpdate {
+		if err := s.validateSpec(ctx); err != nil {
+			// do as much early validation as possible to limit calls to Azure
+			return err
+		}
+	}
+
 	_, err := s.Client.Get(ctx, spec)
 	if err == nil {
 		// We can only get the existing instances if the VMSS already exists
@@ -204,6 +208,12 @@ func (s *Service) validateSpec(ctx context.Context) error {
 		return azure.WithTerminalError(fmt.Errorf("vm size %s does not support ephemeral os. select a different vm size or disable ephemeral os", scaleSetSpec.Size))
 	}
 
+	if scaleSetSpec.OSDisk.DiffDiskSettings != nil {
+		if _, err := sku.EphemeralOSDiskPlacement(armcompute.DiffDiskPlacement(ptr.Deref(scaleSetSpec.OSDisk.DiffDiskSettings.Placement, "")), scaleSetSpec.OSDisk.DiskSizeGB); err != nil {
+			return azure.WithTerminalError(errors.Wrap(err, "failed to place the ephemeral os disk"))
+		}
+	}
+
 	if scaleSetSpec.SecurityProfile != nil && !sku.HasCapability(resourceskus.EncryptionAtHost) {
 		return azure.WithTerminalError(errors.Errorf("encryption at host is not supported for VM type %s", scaleSetSpec.Size))
 	}
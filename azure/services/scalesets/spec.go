@@ -66,6 +66,7 @@ type ScaleSetSpec struct {
 	VMSSExtensionSpecs           []azure.ResourceSpecGetter
 	VMImage                      *infrav1.Image
 	BootstrapData                string
+	UserData                     string
 	VMSSInstances                []armcompute.VirtualMachineScaleSetVM
 	MaxSurge                     int
 	ClusterName                  string
@@ -74,6 +75,10 @@ type ScaleSetSpec struct {
 	AdditionalTags               infrav1.Tags
 	PlatformFaultDomainCount     *int32
 	ZoneBalance                  *bool
+	CapacityReservationGroupID   string
+	ProximityPlacementGroupID    string
+	HostGroupID                  string
+	SkipModelUpdate              bool
 }
 
 // ResourceName returns the name of the Scale Set.
@@ -99,6 +104,17 @@ func (s *ScaleSetSpec) existingParameters(ctx context.Context, existing interfac
 
 	existingInfraVMSS := converters.SDKToVMSS(existingVMSS, s.VMSSInstances)
 
+	if s.SkipModelUpdate {
+		// Keep the current model and only scale it out, as the desired model can't be applied.
+		if s.Capacity <= existingInfraVMSS.Capacity {
+			return nil, nil
+		}
+		sku := ptr.Deref(existingVMSS.SKU, armcompute.SKU{})
+		sku.Capacity = ptr.To[int64](s.Capacity)
+		existingVMSS.SKU = &sku
+		return existingVMSS, nil
+	}
+
 	params, err := s.Parameters(ctx, nil)
 	if err != nil {
 		return nil, errors.Wrapf(err, "failed to generate scale set update parameters for %s", s.Name)
@@ -201,6 +217,7 @@ func (s *ScaleSetSpec) Parameters(ctx context.Context, existing interface{}) (pa
 				ExtensionProfile: &armcompute.VirtualMachineScaleSetExtensionProfile{
 					Extensions: azure.PtrSlice(&extensions),
 				},
+				UserData: s.getUserData(),
 			},
 		},
 	}
@@ -259,6 +276,20 @@ func (s *ScaleSetSpec) Parameters(ctx context.Context, existing interface{}) (pa
 		}
 	}
 
+	if s.CapacityReservationGroupID != "" {
+		vmss.Properties.VirtualMachineProfile.CapacityReservation = &armcompute.CapacityReservationProfile{
+			CapacityReservationGroup: &armcompute.SubResource{ID: ptr.To(s.CapacityReservationGroupID)},
+		}
+	}
+
+	if s.ProximityPlacementGroupID != "" {
+		vmss.Properties.ProximityPlacementGroup = &armcompute.SubResource{ID: ptr.To(s.ProximityPlacementGroupID)}
+	}
+
+	if s.HostGroupID != "" {
+		vmss.Properties.HostGroup = &armcompute.SubResource{ID: ptr.To(s.HostGroupID)}
+	}
+
 	if s.TerminateNotificationTimeout != nil {
 		vmss.Properties.VirtualMachineProfile.ScheduledEventsProfile = &armcompute.ScheduledEventsProfile{
 			TerminateNotificationProfile: &armcompute.TerminateNotificationProfile{
@@ -388,9 +419,17 @@ func (s *ScaleSetSpec) generateStorageProfile(ctx context.Context) (*armcompute.
 			return nil, fmt.Errorf("vm size %s does not support ephemeral os. select a different vm size or disable ephemeral os", s.Size)
 		}
 
+		placement, err := s.SKU.EphemeralOSDiskPlacement(armcompute.DiffDiskPlacement(ptr.Deref(s.OSDisk.DiffDiskSettings.Placement, "")), s.OSDisk.DiskSizeGB)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to place the ephemeral os disk")
+		}
+
 		storageProfile.OSDisk.DiffDiskSettings = &armcompute.DiffDiskSettings{
 			Option: ptr.To(armcompute.DiffDiskOptions(s.OSDisk.DiffDiskSettings.Option)),
 		}
+		if placement != "" {
+			storageProfile.OSDisk.DiffDiskSettings.Placement = ptr.To(placement)
+		}
 	}
 
 	if s.OSDisk.ManagedDisk != nil {
@@ -528,3 +567,10 @@ func (s *ScaleSetSpec) getSecurityProfile() (*armcompute.SecurityProfile, error)
 		EncryptionAtHost: ptr.To(*s.SecurityProfile.EncryptionAtHost),
 	}, nil
 }
+
+func (s *ScaleSetSpec) getUserData() *string {
+	if s.UserData == "" {
+		return nil
+	}
+	return ptr.To(s.UserData)
+}
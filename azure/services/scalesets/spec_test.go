@@ -47,10 +47,14 @@ var (
 	hostEncryptionUnsupportedSpec                                                      = getHostEncryptionUnsupportedSpec()
 	ephemeralReadSpec, ephemeralReadVMSS                                               = getEphemeralReadOnlyVMSS()
 	defaultExistingSpec, defaultExistingVMSS, defaultExistingVMSSClone                 = getExistingDefaultVMSS()
+	skipModelUpdateSpec, skipModelUpdateExistingVMSS, skipModelUpdateVMSS              = getSkipModelUpdateVMSS()
 	userManagedStorageAccountDiagnosticsSpec, userManagedStorageAccountDiagnosticsVMSS = getUserManagedAndStorageAcccountDiagnosticsVMSS()
 	managedDiagnosticsSpec, managedDiagnoisticsVMSS                                    = getManagedDiagnosticsVMSS()
 	disabledDiagnosticsSpec, disabledDiagnosticsVMSS                                   = getDisabledDiagnosticsVMSS()
 	nilDiagnosticsProfileSpec, nilDiagnosticsProfileVMSS                               = getNilDiagnosticsProfileVMSS()
+	userDataSpec, userDataVMSS                                                         = getUserDataVMSS()
+	capacityReservationSpec, capacityReservationVMSS                                   = getCapacityReservationVMSS()
+	placementSpec, placementVMSS                                                       = getPlacementVMSS()
 )
 
 func getDefaultVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
@@ -415,6 +419,22 @@ func getExistingDefaultVMSS() (s ScaleSetSpec, existing armcompute.VirtualMachin
 	return spec, existingVMSS, clone
 }
 
+func getSkipModelUpdateVMSS() (s ScaleSetSpec, existing armcompute.VirtualMachineScaleSet, result armcompute.VirtualMachineScaleSet) {
+	spec := newDefaultVMSSSpec()
+	spec.Size = "NEW_VM_SIZE"
+	spec.Capacity = 3
+	spec.MaxSurge = 1
+	spec.SkipModelUpdate = true
+
+	existingVMSS := newDefaultExistingVMSS("VM_SIZE")
+	existingVMSS.SKU.Capacity = ptr.To[int64](2)
+
+	clone := newDefaultExistingVMSS("VM_SIZE")
+	clone.SKU.Capacity = ptr.To[int64](3)
+
+	return spec, existingVMSS, clone
+}
+
 func getUserManagedAndStorageAcccountDiagnosticsVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
 	storageURI := "https://fakeurl"
 	spec := newDefaultVMSSSpec()
@@ -522,6 +542,82 @@ func getNilDiagnosticsProfileVMSS() (ScaleSetSpec, armcompute.VirtualMachineScal
 	return spec, vmss
 }
 
+func getUserDataVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
+	spec := newDefaultVMSSSpec()
+	spec.UserData = "fake-user-data"
+
+	spec.DataDisks = append(spec.DataDisks, infrav1.DataDisk{
+		NameSuffix: "my_disk_with_ultra_disks",
+		DiskSizeGB: 128,
+		Lun:        ptr.To[int32](3),
+		ManagedDisk: &infrav1.ManagedDiskParameters{
+			StorageAccountType: "UltraSSD_LRS",
+		},
+	})
+	spec.VMSSInstances = newDefaultInstances()
+
+	vmss := newDefaultVMSS("VM_SIZE")
+	vmss.Properties.VirtualMachineProfile.UserData = ptr.To("fake-user-data")
+
+	vmss.Properties.AdditionalCapabilities = &armcompute.AdditionalCapabilities{UltraSSDEnabled: ptr.To(true)}
+
+	return spec, vmss
+}
+
+func getCapacityReservationVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
+	spec := newDefaultVMSSSpec()
+	spec.CapacityReservationGroupID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/capacityReservationGroups/my-crg"
+
+	spec.DataDisks = append(spec.DataDisks, infrav1.DataDisk{
+		NameSuffix: "my_disk_with_ultra_disks",
+		DiskSizeGB: 128,
+		Lun:        ptr.To[int32](3),
+		ManagedDisk: &infrav1.ManagedDiskParameters{
+			StorageAccountType: "UltraSSD_LRS",
+		},
+	})
+	spec.VMSSInstances = newDefaultInstances()
+
+	vmss := newDefaultVMSS("VM_SIZE")
+	vmss.Properties.VirtualMachineProfile.CapacityReservation = &armcompute.CapacityReservationProfile{
+		CapacityReservationGroup: &armcompute.SubResource{
+			ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/capacityReservationGroups/my-crg"),
+		},
+	}
+
+	vmss.Properties.AdditionalCapabilities = &armcompute.AdditionalCapabilities{UltraSSDEnabled: ptr.To(true)}
+
+	return spec, vmss
+}
+
+func getPlacementVMSS() (ScaleSetSpec, armcompute.VirtualMachineScaleSet) {
+	spec := newDefaultVMSSSpec()
+	spec.ProximityPlacementGroupID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/proximityPlacementGroups/my-ppg"
+	spec.HostGroupID = "/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/hostGroups/my-hg"
+
+	spec.DataDisks = append(spec.DataDisks, infrav1.DataDisk{
+		NameSuffix: "my_disk_with_ultra_disks",
+		DiskSizeGB: 128,
+		Lun:        ptr.To[int32](3),
+		ManagedDisk: &infrav1.ManagedDiskParameters{
+			StorageAccountType: "UltraSSD_LRS",
+		},
+	})
+	spec.VMSSInstances = newDefaultInstances()
+
+	vmss := newDefaultVMSS("VM_SIZE")
+	vmss.Properties.ProximityPlacementGroup = &armcompute.SubResource{
+		ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/proximityPlacementGroups/my-ppg"),
+	}
+	vmss.Properties.HostGroup = &armcompute.SubResource{
+		ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/hostGroups/my-hg"),
+	}
+
+	vmss.Properties.AdditionalCapabilities = &armcompute.AdditionalCapabilities{UltraSSDEnabled: ptr.To(true)}
+
+	return spec, vmss
+}
+
 func TestScaleSetParameters(t *testing.T) {
 	testcases := []struct {
 		name          string
@@ -649,6 +745,20 @@ func TestScaleSetParameters(t *testing.T) {
 			expected:      defaultExistingVMSSClone,
 			expectedError: "",
 		},
+		{
+			name:          "scale out existing vmss without updating its model",
+			spec:          skipModelUpdateSpec,
+			existing:      skipModelUpdateExistingVMSS,
+			expected:      skipModelUpdateVMSS,
+			expectedError: "",
+		},
+		{
+			name:          "existing vmss is not updated when its model can't be updated",
+			spec:          func() ScaleSetSpec { s := skipModelUpdateSpec; s.Capacity = 2; return s }(),
+			existing:      skipModelUpdateExistingVMSS,
+			expected:      nil,
+			expectedError: "",
+		},
 		{
 			name:          "vm with diagnostics set to User Managed and StorageAccountURI set",
 			spec:          userManagedStorageAccountDiagnosticsSpec,
@@ -677,6 +787,27 @@ func TestScaleSetParameters(t *testing.T) {
 			expected:      nilDiagnosticsProfileVMSS,
 			expectedError: "",
 		},
+		{
+			name:          "vmss with user data",
+			spec:          userDataSpec,
+			existing:      nil,
+			expected:      userDataVMSS,
+			expectedError: "",
+		},
+		{
+			name:          "vmss with capacity reservation group",
+			spec:          capacityReservationSpec,
+			existing:      nil,
+			expected:      capacityReservationVMSS,
+			expectedError: "",
+		},
+		{
+			name:          "vmss in a proximity placement group and dedicated host group",
+			spec:          placementSpec,
+			existing:      nil,
+			expected:      placementVMSS,
+			expectedError: "",
+		},
 	}
 	for _, tc := range testcases {
 		tc := tc
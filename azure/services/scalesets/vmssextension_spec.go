@@ -62,7 +62,7 @@ func (s *VMSSExtensionSpec) Parameters(ctx context.Context, existing interface{}
 		Name: ptr.To(s.Name),
 		Properties: &armcompute.VirtualMachineScaleSetExtensionProperties{
 			Publisher:          ptr.To(s.Publisher),
-			Type:               ptr.To(s.Name),
+			Type:               ptr.To(s.ExtensionType()),
 			TypeHandlerVersion: ptr.To(s.Version),
 			Settings:           s.Settings,
 			ProtectedSettings:  s.ProtectedSettings,
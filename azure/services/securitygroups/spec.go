@@ -70,16 +70,22 @@ func (s *NSGSpec) Parameters(ctx context.Context, existing interface{}) (interfa
 		// Check if the expected rules are present
 		update := false
 
+		updatedRules := make(map[string]struct{})
 		for _, rule := range s.SecurityRules {
 			sdkRule := converters.SecurityRuleToSDK(rule)
 			if !ruleExists(existingNSG.Properties.SecurityRules, sdkRule) {
 				update = true
 				securityRules = append(securityRules, sdkRule)
+				updatedRules[strings.ToLower(rule.Name)] = struct{}{}
 			}
 			newAnnotation[rule.Name] = rule.Description
 		}
 
 		for _, oldRule := range existingNSG.Properties.SecurityRules {
+			if _, ok := updatedRules[strings.ToLower(ptr.Deref(oldRule.Name, ""))]; ok {
+				// Rule is replaced in place by its updated version
+				continue
+			}
 			_, tracked := s.LastAppliedSecurityRules[*oldRule.Name]
 			// If rule is owned by CAPZ and applied last, and not found in the new rules, then it has been deleted
 			if _, ok := newAnnotation[*oldRule.Name]; !ok && tracked {
@@ -137,7 +143,30 @@ func ruleExists(rules []*armnetwork.SecurityRule, rule *armnetwork.SecurityRule)
 			!strings.EqualFold(ptr.Deref(existingRule.Properties.DestinationAddressPrefix, ""), "*") {
 			continue
 		}
+		if !applicationSecurityGroupsEqual(existingRule.Properties.SourceApplicationSecurityGroups, rule.Properties.SourceApplicationSecurityGroups) ||
+			!applicationSecurityGroupsEqual(existingRule.Properties.DestinationApplicationSecurityGroups, rule.Properties.DestinationApplicationSecurityGroups) {
+			continue
+		}
 		return true
 	}
 	return false
 }
+
+// applicationSecurityGroupsEqual returns true if both lists reference the same application security groups, in any order.
+func applicationSecurityGroupsEqual(a, b []*armnetwork.ApplicationSecurityGroup) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	ids := make(map[string]int, len(a))
+	for _, asg := range a {
+		ids[strings.ToLower(ptr.Deref(asg.ID, ""))]++
+	}
+	for _, asg := range b {
+		id := strings.ToLower(ptr.Deref(asg.ID, ""))
+		if ids[id] == 0 {
+			return false
+		}
+		ids[id]--
+	}
+	return true
+}
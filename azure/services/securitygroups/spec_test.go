@@ -76,6 +76,30 @@ var (
 		DestinationPorts: ptr.To("80"),
 		Action:           infrav1.SecurityRuleActionDeny,
 	}
+	asgRule = infrav1.SecurityRule{
+		Name:                            "asg_rule",
+		Description:                     "ASG Rule",
+		Priority:                        520,
+		Protocol:                        infrav1.SecurityGroupProtocolTCP,
+		Direction:                       infrav1.SecurityRuleDirectionInbound,
+		SourceApplicationSecurityGroups: []string{"/subscriptions/123/resourceGroups/test-group/providers/Microsoft.Network/applicationSecurityGroups/control-plane"},
+		SourcePorts:                     ptr.To("*"),
+		Destination:                     ptr.To("*"),
+		DestinationPorts:                ptr.To("10250"),
+		Action:                          infrav1.SecurityRuleActionAllow,
+	}
+	asgRuleModified = infrav1.SecurityRule{
+		Name:                            "asg_rule",
+		Description:                     "ASG Rule",
+		Priority:                        520,
+		Protocol:                        infrav1.SecurityGroupProtocolTCP,
+		Direction:                       infrav1.SecurityRuleDirectionInbound,
+		SourceApplicationSecurityGroups: []string{"/subscriptions/123/resourceGroups/test-group/providers/Microsoft.Network/applicationSecurityGroups/node"},
+		SourcePorts:                     ptr.To("*"),
+		Destination:                     ptr.To("*"),
+		DestinationPorts:                ptr.To("10250"),
+		Action:                          infrav1.SecurityRuleActionAllow,
+	}
 )
 
 func TestParameters(t *testing.T) {
@@ -195,6 +219,51 @@ func TestParameters(t *testing.T) {
 				}))
 			},
 		},
+		{
+			name: "NSG already exists and the application security groups of a rule changed",
+			spec: &NSGSpec{
+				Name:     "test-nsg",
+				Location: "test-location",
+				SecurityRules: infrav1.SecurityRules{
+					sshRule,
+					asgRuleModified,
+				},
+				ResourceGroup: "test-group",
+				ClusterName:   "my-cluster",
+				LastAppliedSecurityRules: map[string]interface{}{
+					"allow_ssh": "Allow SSH",
+					"asg_rule":  "ASG Rule",
+				},
+			},
+			existing: armnetwork.SecurityGroup{
+				Name:     ptr.To("test-nsg"),
+				Location: ptr.To("test-location"),
+				Etag:     ptr.To("fake-etag"),
+				Properties: &armnetwork.SecurityGroupPropertiesFormat{
+					SecurityRules: []*armnetwork.SecurityRule{
+						converters.SecurityRuleToSDK(sshRule),
+						converters.SecurityRuleToSDK(asgRule),
+					},
+				},
+			},
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armnetwork.SecurityGroup{}))
+				g.Expect(result).To(Equal(armnetwork.SecurityGroup{
+					Location: ptr.To("test-location"),
+					Etag:     ptr.To("fake-etag"),
+					Properties: &armnetwork.SecurityGroupPropertiesFormat{
+						SecurityRules: []*armnetwork.SecurityRule{
+							converters.SecurityRuleToSDK(asgRuleModified),
+							converters.SecurityRuleToSDK(sshRule),
+						},
+					},
+					Tags: map[string]*string{
+						"sigs.k8s.io_cluster-api-provider-azure_cluster_my-cluster": ptr.To("owned"),
+						"Name": ptr.To("test-nsg"),
+					},
+				}))
+			},
+		},
 		{
 			name: "NSG already exists and a rule is deleted",
 			spec: &NSGSpec{
@@ -396,6 +465,18 @@ func TestRuleExists(t *testing.T) {
 			rule:     ruleBModified,
 			expected: false,
 		},
+		{
+			name:     "rule exists but its application security groups have been modified",
+			rules:    []*armnetwork.SecurityRule{ruleA, converters.SecurityRuleToSDK(asgRule)},
+			rule:     converters.SecurityRuleToSDK(asgRuleModified),
+			expected: false,
+		},
+		{
+			name:     "rule with application security groups exists",
+			rules:    []*armnetwork.SecurityRule{ruleA, converters.SecurityRuleToSDK(asgRule)},
+			rule:     converters.SecurityRuleToSDK(asgRule),
+			expected: true,
+		},
 	}
 	for _, tc := range testcases {
 		tc := tc
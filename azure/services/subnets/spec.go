@@ -30,17 +30,21 @@ import (
 
 // SubnetSpec defines the specification for a Subnet.
 type SubnetSpec struct {
-	Name              string
-	ResourceGroup     string
-	SubscriptionID    string
-	CIDRs             []string
-	VNetName          string
-	VNetResourceGroup string
-	IsVNetManaged     bool
-	RouteTableName    string
-	SecurityGroupName string
-	NatGatewayName    string
-	ServiceEndpoints  infrav1.ServiceEndpoints
+	Name                              string
+	ResourceGroup                     string
+	SubscriptionID                    string
+	CIDRs                             []string
+	VNetName                          string
+	VNetResourceGroup                 string
+	IsVNetManaged                     bool
+	RouteTableName                    string
+	SecurityGroupName                 string
+	NatGatewayName                    string
+	ServiceEndpoints                  infrav1.ServiceEndpoints
+	Delegations                       infrav1.SubnetDelegations
+	ServiceEndpointPolicyIDs          []string
+	PrivateEndpointNetworkPolicies    infrav1.SubnetNetworkPolicies
+	PrivateLinkServiceNetworkPolicies infrav1.SubnetNetworkPolicies
 }
 
 // ResourceRef implements azure.ASOResourceSpecGetter.
@@ -103,6 +107,30 @@ func (s *SubnetSpec) Parameters(ctx context.Context, existing *asonetworkv1.Virt
 	}
 	subnet.Spec.ServiceEndpoints = serviceEndpoints
 
+	var delegations []asonetworkv1.Delegation
+	for _, delegation := range s.Delegations {
+		delegations = append(delegations, asonetworkv1.Delegation{Name: ptr.To(delegation.Name), ServiceName: ptr.To(delegation.ServiceName)})
+	}
+	subnet.Spec.Delegations = delegations
+
+	var serviceEndpointPolicies []asonetworkv1.ServiceEndpointPolicySpec_VirtualNetworks_Subnet_SubResourceEmbedded
+	for _, id := range s.ServiceEndpointPolicyIDs {
+		serviceEndpointPolicies = append(serviceEndpointPolicies, asonetworkv1.ServiceEndpointPolicySpec_VirtualNetworks_Subnet_SubResourceEmbedded{
+			Reference: &genruntime.ResourceReference{
+				ARMID: id,
+			},
+		})
+	}
+	subnet.Spec.ServiceEndpointPolicies = serviceEndpointPolicies
+
+	if s.PrivateEndpointNetworkPolicies != "" {
+		subnet.Spec.PrivateEndpointNetworkPolicies = ptr.To(asonetworkv1.SubnetPropertiesFormat_PrivateEndpointNetworkPolicies(s.PrivateEndpointNetworkPolicies))
+	}
+
+	if s.PrivateLinkServiceNetworkPolicies != "" {
+		subnet.Spec.PrivateLinkServiceNetworkPolicies = ptr.To(asonetworkv1.SubnetPropertiesFormat_PrivateLinkServiceNetworkPolicies(s.PrivateLinkServiceNetworkPolicies))
+	}
+
 	return subnet, nil
 }
 
@@ -148,6 +148,55 @@ func TestParameters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "subnet with delegations, service endpoint policies and network policies",
+			spec: &SubnetSpec{
+				IsVNetManaged:     true,
+				Name:              "subnet",
+				SubscriptionID:    "sub",
+				ResourceGroup:     "rg",
+				VNetName:          "vnet",
+				VNetResourceGroup: "vnet-rg",
+				CIDRs:             []string{"cidr"},
+				Delegations: infrav1.SubnetDelegations{
+					{
+						Name:        "aci",
+						ServiceName: "Microsoft.ContainerInstance/containerGroups",
+					},
+				},
+				ServiceEndpointPolicyIDs: []string{
+					"/subscriptions/sub/resourceGroups/policy-rg/providers/Microsoft.Network/serviceEndpointPolicies/policy",
+				},
+				PrivateEndpointNetworkPolicies:    infrav1.SubnetNetworkPoliciesEnabled,
+				PrivateLinkServiceNetworkPolicies: infrav1.SubnetNetworkPoliciesDisabled,
+			},
+			existing: nil,
+			expected: &asonetworkv1.VirtualNetworksSubnet{
+				Spec: asonetworkv1.VirtualNetworks_Subnet_Spec{
+					AzureName: "subnet",
+					Owner: &genruntime.KnownResourceReference{
+						Name: "vnet",
+					},
+					AddressPrefixes: []string{"cidr"},
+					AddressPrefix:   ptr.To("cidr"),
+					Delegations: []asonetworkv1.Delegation{
+						{
+							Name:        ptr.To("aci"),
+							ServiceName: ptr.To("Microsoft.ContainerInstance/containerGroups"),
+						},
+					},
+					ServiceEndpointPolicies: []asonetworkv1.ServiceEndpointPolicySpec_VirtualNetworks_Subnet_SubResourceEmbedded{
+						{
+							Reference: &genruntime.ResourceReference{
+								ARMID: "/subscriptions/sub/resourceGroups/policy-rg/providers/Microsoft.Network/serviceEndpointPolicies/policy",
+							},
+						},
+					},
+					PrivateEndpointNetworkPolicies:    ptr.To(asonetworkv1.SubnetPropertiesFormat_PrivateEndpointNetworkPolicies_Enabled),
+					PrivateLinkServiceNetworkPolicies: ptr.To(asonetworkv1.SubnetPropertiesFormat_PrivateLinkServiceNetworkPolicies_Disabled),
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
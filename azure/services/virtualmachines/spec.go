@@ -28,6 +28,7 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/generators"
 )
 
@@ -54,9 +55,13 @@ type VMSpec struct {
 	AdditionalCapabilities     *infrav1.AdditionalCapabilities
 	DiagnosticsProfile         *infrav1.Diagnostics
 	CapacityReservationGroupID string
+	ProximityPlacementGroupID  string
+	HostGroupID                string
+	HostID                     string
 	SKU                        resourceskus.SKU
 	Image                      *infrav1.Image
 	BootstrapData              string
+	UserData                   string
 	ProviderID                 string
 }
 
@@ -138,11 +143,15 @@ func (s *VMSpec) Parameters(ctx context.Context, existing interface{}) (params i
 			NetworkProfile: &armcompute.NetworkProfile{
 				NetworkInterfaces: s.generateNICRefs(),
 			},
-			Priority:            priority,
-			EvictionPolicy:      evictionPolicy,
-			BillingProfile:      billingProfile,
-			DiagnosticsProfile:  converters.GetDiagnosticsProfile(s.DiagnosticsProfile),
-			CapacityReservation: s.getCapacityReservationProfile(),
+			Priority:                priority,
+			EvictionPolicy:          evictionPolicy,
+			BillingProfile:          billingProfile,
+			DiagnosticsProfile:      converters.GetDiagnosticsProfile(s.DiagnosticsProfile),
+			CapacityReservation:     s.getCapacityReservationProfile(),
+			ProximityPlacementGroup: subResource(s.ProximityPlacementGroupID),
+			HostGroup:               subResource(s.HostGroupID),
+			Host:                    subResource(s.HostID),
+			UserData:                s.getUserData(),
 		},
 		Identity: identity,
 		Zones:    s.getZones(),
@@ -188,9 +197,17 @@ func (s *VMSpec) generateStorageProfile() (*armcompute.StorageProfile, error) {
 			return nil, azure.WithTerminalError(fmt.Errorf("VM size %s does not support ephemeral os. Select a different VM size or disable ephemeral os", s.Size))
 		}
 
+		placement, err := s.SKU.EphemeralOSDiskPlacement(armcompute.DiffDiskPlacement(ptr.Deref(s.OSDisk.DiffDiskSettings.Placement, "")), s.OSDisk.DiskSizeGB)
+		if err != nil {
+			return nil, azure.WithTerminalError(errors.Wrap(err, "failed to place the ephemeral os disk"))
+		}
+
 		storageProfile.OSDisk.DiffDiskSettings = &armcompute.DiffDiskSettings{
 			Option: ptr.To(armcompute.DiffDiskOptions(s.OSDisk.DiffDiskSettings.Option)),
 		}
+		if placement != "" {
+			storageProfile.OSDisk.DiffDiskSettings.Placement = ptr.To(placement)
+		}
 	}
 
 	if s.OSDisk.ManagedDisk != nil {
@@ -219,6 +236,15 @@ func (s *VMSpec) generateStorageProfile() (*armcompute.StorageProfile, error) {
 
 	dataDisks := make([]*armcompute.DataDisk, len(s.DataDisks))
 	for i, disk := range s.DataDisks {
+		if disk.ManagedDiskID != nil {
+			dataDisk, err := existingDataDisk(disk)
+			if err != nil {
+				return nil, err
+			}
+			dataDisks[i] = dataDisk
+			continue
+		}
+
 		dataDisks[i] = &armcompute.DataDisk{
 			CreateOption: ptr.To(armcompute.DiskCreateOptionTypesEmpty),
 			DiskSizeGB:   ptr.To[int32](disk.DiskSizeGB),
@@ -256,6 +282,28 @@ func (s *VMSpec) generateStorageProfile() (*armcompute.StorageProfile, error) {
 	return storageProfile, nil
 }
 
+// existingDataDisk returns the data disk that attaches the existing managed disk referenced by the DataDisk.
+func existingDataDisk(disk infrav1.DataDisk) (*armcompute.DataDisk, error) {
+	resourceID, err := azureutil.ParseResourceID(*disk.ManagedDiskID)
+	if err != nil {
+		return nil, azure.WithTerminalError(errors.Wrapf(err, "failed to parse managed disk ID %s", *disk.ManagedDiskID))
+	}
+	dataDisk := &armcompute.DataDisk{
+		CreateOption: ptr.To(armcompute.DiskCreateOptionTypesAttach),
+		Lun:          disk.Lun,
+		Name:         ptr.To(resourceID.Name),
+		ManagedDisk: &armcompute.ManagedDiskParameters{
+			ID: disk.ManagedDiskID,
+		},
+		// Existing disks must survive the VM, so they are only ever detached from it.
+		DeleteOption: ptr.To(armcompute.DiskDeleteOptionTypesDetach),
+	}
+	if disk.CachingType != "" {
+		dataDisk.Caching = ptr.To(armcompute.CachingTypes(disk.CachingType))
+	}
+	return dataDisk, nil
+}
+
 func (s *VMSpec) generateOSProfile() (*armcompute.OSProfile, error) {
 	sshKey, err := base64.StdEncoding.DecodeString(s.SSHKeyData)
 	if err != nil {
@@ -450,3 +498,18 @@ func (s *VMSpec) getCapacityReservationProfile() *armcompute.CapacityReservation
 	}
 	return crf
 }
+
+// subResource returns a reference to the resource with the given ID, or nil if the ID is empty.
+func subResource(id string) *armcompute.SubResource {
+	if id == "" {
+		return nil
+	}
+	return &armcompute.SubResource{ID: ptr.To(id)}
+}
+
+func (s *VMSpec) getUserData() *string {
+	if s.UserData == "" {
+		return nil
+	}
+	return ptr.To(s.UserData)
+}
@@ -138,6 +138,36 @@ var (
 		},
 	}
 
+	validSKUWithEphemeralOSResourceDisk = resourceskus.SKU{
+		Name: ptr.To("Standard_D2ds_v5"),
+		Kind: ptr.To(string(resourceskus.VirtualMachines)),
+		Locations: []*string{
+			ptr.To("test-location"),
+		},
+		Capabilities: []*armcompute.ResourceSKUCapabilities{
+			{
+				Name:  ptr.To(resourceskus.VCPUs),
+				Value: ptr.To("2"),
+			},
+			{
+				Name:  ptr.To(resourceskus.MemoryGB),
+				Value: ptr.To("8"),
+			},
+			{
+				Name:  ptr.To(resourceskus.EphemeralOSDisk),
+				Value: ptr.To("True"),
+			},
+			{
+				Name:  ptr.To(resourceskus.SupportedEphemeralOSDiskPlacements),
+				Value: ptr.To("ResourceDisk"),
+			},
+			{
+				Name:  ptr.To(resourceskus.MaxResourceVolumeMB),
+				Value: ptr.To("76800"),
+			},
+		},
+	}
+
 	validSKUWithUltraSSD = resourceskus.SKU{
 		Name: ptr.To("Standard_D2v3"),
 		Kind: ptr.To(string(resourceskus.VirtualMachines)),
@@ -461,6 +491,62 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "can create a vm with EphemeralOSDisk on the resource disk by default",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2ds_v5",
+				OSDisk: infrav1.OSDisk{
+					OSType:     "Linux",
+					DiskSizeGB: ptr.To[int32](30),
+					ManagedDisk: &infrav1.ManagedDiskParameters{
+						StorageAccountType: string(armcompute.StorageAccountTypesPremiumLRS),
+					},
+					DiffDiskSettings: &infrav1.DiffDiskSettings{
+						Option: string(armcompute.DiffDiskOptionsLocal),
+					},
+				},
+				Image: &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:   validSKUWithEphemeralOSResourceDisk,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				g.Expect(result.(armcompute.VirtualMachine).Properties.StorageProfile.OSDisk.DiffDiskSettings.Placement).To(Equal(ptr.To(armcompute.DiffDiskPlacementResourceDisk)))
+			},
+			expectedError: "",
+		},
+		{
+			name: "cannot create a vm with EphemeralOSDisk on a placement the vm size does not support",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2ds_v5",
+				OSDisk: infrav1.OSDisk{
+					OSType:     "Linux",
+					DiskSizeGB: ptr.To[int32](30),
+					ManagedDisk: &infrav1.ManagedDiskParameters{
+						StorageAccountType: string(armcompute.StorageAccountTypesPremiumLRS),
+					},
+					DiffDiskSettings: &infrav1.DiffDiskSettings{
+						Option:    string(armcompute.DiffDiskOptionsLocal),
+						Placement: ptr.To(infrav1.DiffDiskPlacementCacheDisk),
+					},
+				},
+				Image: &infrav1.Image{ID: ptr.To("fake-image-id")},
+				SKU:   validSKUWithEphemeralOSResourceDisk,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeNil())
+			},
+			expectedError: "reconcile error that cannot be recovered occurred: failed to place the ephemeral os disk: vm size Standard_D2ds_v5 does not support ephemeral os disk placement CacheDisk. Object will not be requeued",
+		},
 		{
 			name: "can create a trusted launch vm",
 			spec: &VMSpec{
@@ -1345,6 +1431,57 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "can create a vm that attaches an existing managed disk",
+			spec: &VMSpec{
+				Name:       "my-vm",
+				Role:       infrav1.Node,
+				NICIDs:     []string{"my-nic"},
+				SSHKeyData: "fakesshpublickey",
+				Size:       "Standard_D2v3",
+				Location:   "test-location",
+				Zone:       "1",
+				Image:      &infrav1.Image{ID: ptr.To("fake-image-id")},
+				DataDisks: []infrav1.DataDisk{
+					{
+						NameSuffix: "mydisk",
+						DiskSizeGB: 64,
+						Lun:        ptr.To[int32](0),
+					},
+					{
+						NameSuffix:    "existing",
+						ManagedDiskID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-existing-disk"),
+						Lun:           ptr.To[int32](1),
+						CachingType:   "None",
+					},
+				},
+				SKU: validSKU,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				expectedDataDisks := []*armcompute.DataDisk{
+					{
+						Lun:          ptr.To[int32](0),
+						Name:         ptr.To("my-vm_mydisk"),
+						CreateOption: ptr.To(armcompute.DiskCreateOptionTypesEmpty),
+						DiskSizeGB:   ptr.To[int32](64),
+					},
+					{
+						Lun:          ptr.To[int32](1),
+						Name:         ptr.To("my-existing-disk"),
+						CreateOption: ptr.To(armcompute.DiskCreateOptionTypesAttach),
+						Caching:      ptr.To(armcompute.CachingTypesNone),
+						DeleteOption: ptr.To(armcompute.DiskDeleteOptionTypesDetach),
+						ManagedDisk: &armcompute.ManagedDiskParameters{
+							ID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-existing-disk"),
+						},
+					},
+				}
+				g.Expect(gomockinternal.DiffEq(expectedDataDisks).Matches(result.(armcompute.VirtualMachine).Properties.StorageProfile.DataDisks)).To(BeTrue(), cmp.Diff(expectedDataDisks, result.(armcompute.VirtualMachine).Properties.StorageProfile.DataDisks))
+			},
+			expectedError: "",
+		},
 		{
 			name: "creates a vm and associate it with a capacity reservation group",
 			spec: &VMSpec{
@@ -1387,6 +1524,78 @@ func TestParameters(t *testing.T) {
 			},
 			expectedError: "",
 		},
+		{
+			name: "creates a vm in a proximity placement group on a dedicated host",
+			spec: &VMSpec{
+				Name:                      "my-vm",
+				Role:                      infrav1.Node,
+				NICIDs:                    []string{"my-nic"},
+				SSHKeyData:                "fakesshpublickey",
+				Size:                      "Standard_D2v3",
+				Location:                  "test-location",
+				Zone:                      "1",
+				Image:                     &infrav1.Image{ID: ptr.To("fake-image-id")},
+				ProximityPlacementGroupID: "my-ppg-id",
+				HostID:                    "my-host-id",
+				SKU:                       validSKU,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				vm := result.(armcompute.VirtualMachine)
+				g.Expect(vm.Properties.ProximityPlacementGroup).To(Equal(&armcompute.SubResource{ID: ptr.To("my-ppg-id")}))
+				g.Expect(vm.Properties.Host).To(Equal(&armcompute.SubResource{ID: ptr.To("my-host-id")}))
+				g.Expect(vm.Properties.HostGroup).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name: "creates a vm in a dedicated host group",
+			spec: &VMSpec{
+				Name:        "my-vm",
+				Role:        infrav1.Node,
+				NICIDs:      []string{"my-nic"},
+				SSHKeyData:  "fakesshpublickey",
+				Size:        "Standard_D2v3",
+				Location:    "test-location",
+				Zone:        "1",
+				Image:       &infrav1.Image{ID: ptr.To("fake-image-id")},
+				HostGroupID: "my-host-group-id",
+				SKU:         validSKU,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				vm := result.(armcompute.VirtualMachine)
+				g.Expect(vm.Properties.HostGroup).To(Equal(&armcompute.SubResource{ID: ptr.To("my-host-group-id")}))
+				g.Expect(vm.Properties.Host).To(BeNil())
+				g.Expect(vm.Properties.ProximityPlacementGroup).To(BeNil())
+			},
+			expectedError: "",
+		},
+		{
+			name: "creates a vm with user data",
+			spec: &VMSpec{
+				Name:          "my-vm",
+				Role:          infrav1.Node,
+				NICIDs:        []string{"my-nic"},
+				SSHKeyData:    "fakesshpublickey",
+				Size:          "Standard_D2v3",
+				Location:      "test-location",
+				Zone:          "1",
+				Image:         &infrav1.Image{ID: ptr.To("fake-image-id")},
+				BootstrapData: "fake-loader",
+				UserData:      "fake-bootstrap-data",
+				SKU:           validSKU,
+			},
+			existing: nil,
+			expect: func(g *WithT, result interface{}) {
+				g.Expect(result).To(BeAssignableToTypeOf(armcompute.VirtualMachine{}))
+				g.Expect(result.(armcompute.VirtualMachine).Properties.OSProfile.CustomData).To(Equal(ptr.To("fake-loader")))
+				g.Expect(result.(armcompute.VirtualMachine).Properties.UserData).To(Equal(ptr.To("fake-bootstrap-data")))
+			},
+			expectedError: "",
+		},
 	}
 	for _, tc := range testcases {
 		tc := tc
@@ -0,0 +1,68 @@
+/*
+Copyright 2024 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package virtualnetworks
+
+import (
+	"context"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/arm"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/cluster-api-provider-azure/azure"
+	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
+)
+
+// ddosProtectionPlanGetter gets a DDoS protection plan by resource ID.
+type ddosProtectionPlanGetter interface {
+	Get(ctx context.Context, id string) (armnetwork.DdosProtectionPlan, error)
+}
+
+// ddosProtectionPlanClient gets DDoS protection plans, which may be in another subscription than the cluster.
+type ddosProtectionPlanClient struct {
+	auth azure.Authorizer
+	opts *arm.ClientOptions
+}
+
+// newDDoSProtectionPlanClient creates a new DDoS protection plan client from an authorizer.
+func newDDoSProtectionPlanClient(auth azure.Authorizer) (*ddosProtectionPlanClient, error) {
+	opts, err := azure.ARMClientOptions(auth.CloudEnvironment())
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create ddos protection plans client options")
+	}
+	return &ddosProtectionPlanClient{auth: auth, opts: opts}, nil
+}
+
+// Get gets the DDoS protection plan with the given resource ID.
+func (c *ddosProtectionPlanClient) Get(ctx context.Context, id string) (armnetwork.DdosProtectionPlan, error) {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "virtualnetworks.ddosProtectionPlanClient.Get")
+	defer done()
+
+	resourceID, err := azureutil.ParseResourceID(id)
+	if err != nil {
+		return armnetwork.DdosProtectionPlan{}, errors.Wrapf(err, "failed to parse DDoS protection plan ID %s", id)
+	}
+	client, err := armnetwork.NewDdosProtectionPlansClient(resourceID.SubscriptionID, c.auth.Token(), c.opts)
+	if err != nil {
+		return armnetwork.DdosProtectionPlan{}, errors.Wrap(err, "failed to create ddos protection plans client")
+	}
+	resp, err := client.Get(ctx, resourceID.ResourceGroupName, resourceID.Name, nil)
+	if err != nil {
+		return armnetwork.DdosProtectionPlan{}, err
+	}
+	return resp.DdosProtectionPlan, nil
+}
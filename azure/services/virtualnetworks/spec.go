@@ -37,6 +37,9 @@ type VNetSpec struct {
 	ExtendedLocation *infrav1.ExtendedLocationSpec
 	ClusterName      string
 	AdditionalTags   infrav1.Tags
+	// DDoSProtectionPlanID is the resource ID of the DDoS protection plan associated with the virtual network.
+	DDoSProtectionPlanID string
+	DNSServers           []string
 }
 
 // ResourceRef implements azure.ASOResourceSpecGetter.
@@ -75,6 +78,24 @@ func (s *VNetSpec) Parameters(ctx context.Context, existing *asonetworkv1.Virtua
 		AddressPrefixes: s.CIDRs,
 	}
 
+	vnet.Spec.DdosProtectionPlan = nil
+	vnet.Spec.EnableDdosProtection = nil
+	if s.DDoSProtectionPlanID != "" {
+		vnet.Spec.DdosProtectionPlan = &asonetworkv1.SubResource{
+			Reference: &genruntime.ResourceReference{
+				ARMID: s.DDoSProtectionPlanID,
+			},
+		}
+		vnet.Spec.EnableDdosProtection = ptr.To(true)
+	}
+
+	vnet.Spec.DhcpOptions = nil
+	if len(s.DNSServers) > 0 {
+		vnet.Spec.DhcpOptions = &asonetworkv1.DhcpOptions{
+			DnsServers: s.DNSServers,
+		}
+	}
+
 	return vnet, nil
 }
 
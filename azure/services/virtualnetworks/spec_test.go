@@ -115,6 +115,84 @@ func TestParameters(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "vnet with DDoS protection plan and DNS servers",
+			spec: VNetSpec{
+				ResourceGroup:        "rg",
+				Name:                 "name",
+				CIDRs:                []string{"cidr"},
+				Location:             "location",
+				ClusterName:          "cluster",
+				DDoSProtectionPlanID: "/subscriptions/sub/resourceGroups/ddos-rg/providers/Microsoft.Network/ddosProtectionPlans/plan",
+				DNSServers:           []string{"10.0.0.4", "10.0.0.5"},
+			},
+			existing: &asonetworkv1.VirtualNetwork{
+				Spec: asonetworkv1.VirtualNetwork_Spec{
+					Tags: map[string]string{
+						"tags": "set",
+					},
+				},
+			},
+			expected: &asonetworkv1.VirtualNetwork{
+				Spec: asonetworkv1.VirtualNetwork_Spec{
+					Tags: map[string]string{
+						"tags": "set",
+					},
+					AzureName: "name",
+					Owner: &genruntime.KnownResourceReference{
+						Name: "rg",
+					},
+					Location: ptr.To("location"),
+					AddressSpace: &asonetworkv1.AddressSpace{
+						AddressPrefixes: []string{"cidr"},
+					},
+					DdosProtectionPlan: &asonetworkv1.SubResource{
+						Reference: &genruntime.ResourceReference{
+							ARMID: "/subscriptions/sub/resourceGroups/ddos-rg/providers/Microsoft.Network/ddosProtectionPlans/plan",
+						},
+					},
+					EnableDdosProtection: ptr.To(true),
+					DhcpOptions: &asonetworkv1.DhcpOptions{
+						DnsServers: []string{"10.0.0.4", "10.0.0.5"},
+					},
+				},
+			},
+		},
+		{
+			name: "DDoS protection plan and DNS servers removed from existing vnet",
+			spec: VNetSpec{
+				ResourceGroup: "rg",
+				Name:          "name",
+				CIDRs:         []string{"cidr"},
+				Location:      "location",
+				ClusterName:   "cluster",
+			},
+			existing: &asonetworkv1.VirtualNetwork{
+				Spec: asonetworkv1.VirtualNetwork_Spec{
+					DdosProtectionPlan: &asonetworkv1.SubResource{
+						Reference: &genruntime.ResourceReference{
+							ARMID: "/subscriptions/sub/resourceGroups/ddos-rg/providers/Microsoft.Network/ddosProtectionPlans/plan",
+						},
+					},
+					EnableDdosProtection: ptr.To(true),
+					DhcpOptions: &asonetworkv1.DhcpOptions{
+						DnsServers: []string{"10.0.0.4"},
+					},
+				},
+			},
+			expected: &asonetworkv1.VirtualNetwork{
+				Spec: asonetworkv1.VirtualNetwork_Spec{
+					AzureName: "name",
+					Owner: &genruntime.KnownResourceReference{
+						Name: "rg",
+					},
+					Location: ptr.To("location"),
+					AddressSpace: &asonetworkv1.AddressSpace{
+						AddressPrefixes: []string{"cidr"},
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -18,15 +18,18 @@ package virtualnetworks
 
 import (
 	"context"
+	"strings"
 
 	asonetworkv1 "github.com/Azure/azure-service-operator/v2/api/network/v1api20201101"
 	"github.com/Azure/azure-service-operator/v2/pkg/common/labels"
 	"github.com/pkg/errors"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/converters"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
+	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -35,18 +38,71 @@ const serviceName = "virtualnetworks"
 // VNetScope defines the scope interface for a virtual network service.
 type VNetScope interface {
 	aso.Scope
+	azure.Authorizer
 	Vnet() *infrav1.VnetSpec
+	IsVnetManaged() bool
 	VNetSpec() azure.ASOResourceSpecGetter[*asonetworkv1.VirtualNetwork]
 	UpdateSubnetCIDRs(string, []string)
 }
 
+// Service provides operations on Azure virtual networks.
+type Service struct {
+	*aso.Service[*asonetworkv1.VirtualNetwork, VNetScope]
+	ddosProtectionPlans ddosProtectionPlanGetter
+}
+
 // New creates a new service.
-func New(scope VNetScope) *aso.Service[*asonetworkv1.VirtualNetwork, VNetScope] {
+func New(scope VNetScope) (*Service, error) {
+	ddosProtectionPlanClient, err := newDDoSProtectionPlanClient(scope)
+	if err != nil {
+		return nil, err
+	}
 	svc := aso.NewService[*asonetworkv1.VirtualNetwork](serviceName, scope)
 	svc.Specs = []azure.ASOResourceSpecGetter[*asonetworkv1.VirtualNetwork]{scope.VNetSpec()}
 	svc.ConditionType = infrav1.VNetReadyCondition
 	svc.PostCreateOrUpdateResourceHook = postCreateOrUpdateResourceHook
-	return svc
+	return &Service{
+		Service:             svc,
+		ddosProtectionPlans: ddosProtectionPlanClient,
+	}, nil
+}
+
+// Reconcile checks that the DDoS protection plan of a managed virtual network, if any, exists and is accessible
+// before reconciling the virtual network, so a bad reference surfaces as a clear error on the VNetReady condition.
+// The plan is only checked when it is first set or changed.
+func (s *Service) Reconcile(ctx context.Context) error {
+	ctx, _, done := tele.StartSpanWithLogger(ctx, "virtualnetworks.Service.Reconcile")
+	defer done()
+
+	if planID := s.Scope.Vnet().DDoSProtectionPlanID; planID != "" && s.Scope.IsVnetManaged() {
+		changed, err := s.ddosProtectionPlanChanged(ctx, planID)
+		if err == nil && changed {
+			if _, err = s.ddosProtectionPlans.Get(ctx, planID); err != nil {
+				err = errors.Wrapf(err, "failed to get DDoS protection plan %s, it must exist in a subscription the cluster identity can access", planID)
+			}
+		}
+		if err != nil {
+			s.Scope.UpdatePutStatus(infrav1.VNetReadyCondition, serviceName, err)
+			return err
+		}
+	}
+
+	return s.Service.Reconcile(ctx)
+}
+
+// ddosProtectionPlanChanged returns whether the DDoS protection plan differs from the one of the existing ASO
+// VirtualNetwork, or whether the VirtualNetwork doesn't exist yet.
+func (s *Service) ddosProtectionPlanChanged(ctx context.Context, planID string) (bool, error) {
+	existing := s.Scope.VNetSpec().ResourceRef()
+	existing.SetNamespace(s.Scope.ASOOwner().GetNamespace())
+	if err := s.Scope.GetClient().Get(ctx, client.ObjectKeyFromObject(existing), existing); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, errors.Wrapf(err, "failed to get existing virtual network %s", existing.GetName())
+	}
+	plan := existing.Spec.DdosProtectionPlan
+	return plan == nil || plan.Reference == nil || !strings.EqualFold(plan.Reference.ARMID, planID), nil
 }
 
 func postCreateOrUpdateResourceHook(ctx context.Context, scope VNetScope, existingVnet *asonetworkv1.VirtualNetwork, err error) error {
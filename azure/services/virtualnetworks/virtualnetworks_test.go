@@ -20,7 +20,9 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	asonetworkv1 "github.com/Azure/azure-service-operator/v2/api/network/v1api20201101"
 	"github.com/Azure/azure-service-operator/v2/pkg/common/labels"
 	"github.com/Azure/azure-service-operator/v2/pkg/genruntime"
@@ -30,11 +32,95 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/aso"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/virtualnetworks/mock_virtualnetworks"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
+type fakeDDoSProtectionPlanGetter struct {
+	err error
+}
+
+func (f fakeDDoSProtectionPlanGetter) Get(_ context.Context, _ string) (armnetwork.DdosProtectionPlan, error) {
+	return armnetwork.DdosProtectionPlan{}, f.err
+}
+
+func TestReconcileDDoSProtectionPlan(t *testing.T) {
+	planID := "/subscriptions/other/resourceGroups/ddos-rg/providers/Microsoft.Network/ddosProtectionPlans/plan"
+	vnetWithPlan := func(id string) *asonetworkv1.VirtualNetwork {
+		return &asonetworkv1.VirtualNetwork{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "vnet",
+				Namespace: "ns",
+			},
+			Spec: asonetworkv1.VirtualNetwork_Spec{
+				DdosProtectionPlan: &asonetworkv1.SubResource{
+					Reference: &genruntime.ResourceReference{ARMID: id},
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name     string
+		existing []client.Object
+		wantErr  bool
+	}{
+		{
+			name:    "inaccessible DDoS protection plan of a new virtual network",
+			wantErr: true,
+		},
+		{
+			name:     "inaccessible DDoS protection plan that replaces another one",
+			existing: []client.Object{vnetWithPlan("/subscriptions/other/resourceGroups/ddos-rg/providers/Microsoft.Network/ddosProtectionPlans/old-plan")},
+			wantErr:  true,
+		},
+		{
+			name:     "DDoS protection plan of the existing virtual network isn't checked again",
+			existing: []client.Object{vnetWithPlan(planID)},
+			wantErr:  false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			mockCtrl := gomock.NewController(t)
+			scope := mock_virtualnetworks.NewMockVNetScope(mockCtrl)
+
+			sch := runtime.NewScheme()
+			g.Expect(asonetworkv1.AddToScheme(sch)).To(Succeed())
+			c := fakeclient.NewClientBuilder().
+				WithScheme(sch).
+				WithObjects(tc.existing...).
+				Build()
+
+			scope.EXPECT().Vnet().Return(&infrav1.VnetSpec{VnetClassSpec: infrav1.VnetClassSpec{DDoSProtectionPlanID: planID}})
+			scope.EXPECT().IsVnetManaged().Return(true)
+			scope.EXPECT().VNetSpec().Return(&VNetSpec{Name: "vnet"})
+			scope.EXPECT().ASOOwner().Return(&infrav1.AzureCluster{ObjectMeta: metav1.ObjectMeta{Namespace: "ns"}})
+			scope.EXPECT().GetClient().Return(c)
+			if tc.wantErr {
+				scope.EXPECT().UpdatePutStatus(infrav1.VNetReadyCondition, serviceName, gomock.Not(gomock.Nil()))
+			} else {
+				scope.EXPECT().DefaultedAzureServiceReconcileTimeout().Return(time.Minute)
+				scope.EXPECT().UpdatePutStatus(gomock.Any(), gomock.Any(), nil)
+			}
+
+			s := &Service{
+				Service:             &aso.Service[*asonetworkv1.VirtualNetwork, VNetScope]{Scope: scope},
+				ddosProtectionPlans: fakeDDoSProtectionPlanGetter{err: errors.New("AuthorizationFailed")},
+			}
+			err := s.Reconcile(context.Background())
+			if tc.wantErr {
+				g.Expect(err).To(MatchError(ContainSubstring("failed to get DDoS protection plan " + planID)))
+				g.Expect(err).To(MatchError(ContainSubstring("AuthorizationFailed")))
+			} else {
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
 func TestPostCreateOrUpdateResourceHook(t *testing.T) {
 	t.Run("failed to create or update", func(t *testing.T) {
 		g := NewGomegaWithT(t)
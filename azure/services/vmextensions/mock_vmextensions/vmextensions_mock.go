@@ -197,6 +197,20 @@ func (mr *MockVMExtensionScopeMockRecorder) HashKey() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HashKey", reflect.TypeOf((*MockVMExtensionScope)(nil).HashKey))
 }
 
+// IsBootstrapCheckDisabled mocks base method.
+func (m *MockVMExtensionScope) IsBootstrapCheckDisabled() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBootstrapCheckDisabled")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsBootstrapCheckDisabled indicates an expected call of IsBootstrapCheckDisabled.
+func (mr *MockVMExtensionScopeMockRecorder) IsBootstrapCheckDisabled() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBootstrapCheckDisabled", reflect.TypeOf((*MockVMExtensionScope)(nil).IsBootstrapCheckDisabled))
+}
+
 // SetLongRunningOperationState mocks base method.
 func (m *MockVMExtensionScope) SetLongRunningOperationState(arg0 *v1beta1.Future) {
 	m.ctrl.T.Helper()
@@ -62,7 +62,7 @@ func (s *VMExtensionSpec) Parameters(ctx context.Context, existing interface{})
 	return armcompute.VirtualMachineExtension{
 		Properties: &armcompute.VirtualMachineExtensionProperties{
 			Publisher:          ptr.To(s.Publisher),
-			Type:               ptr.To(s.Name),
+			Type:               ptr.To(s.ExtensionType()),
 			TypeHandlerVersion: ptr.To(s.Version),
 			Settings:           s.Settings,
 			ProtectedSettings:  s.ProtectedSettings,
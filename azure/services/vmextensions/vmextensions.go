@@ -34,6 +34,7 @@ type VMExtensionScope interface {
 	azure.Authorizer
 	azure.AsyncStatusUpdater
 	VMExtensionSpecs() []azure.ResourceSpecGetter
+	IsBootstrapCheckDisabled() bool
 }
 
 // Service provides operations on Azure resources.
@@ -92,7 +93,10 @@ func (s *Service) Reconcile(ctx context.Context) error {
 		resultErr = errors.Wrapf(resultErr, "extension state failed. This likely means the Kubernetes node bootstrapping process failed or timed out. Check VM boot diagnostics logs to learn more")
 	}
 
-	s.Scope.UpdatePutStatus(infrav1.BootstrapSucceededCondition, serviceName, resultErr)
+	// When the bootstrap check extension is disabled, the BootstrapSucceeded condition is derived from the machine's Node instead.
+	if !s.Scope.IsBootstrapCheckDisabled() {
+		s.Scope.UpdatePutStatus(infrav1.BootstrapSucceededCondition, serviceName, resultErr)
+	}
 	return resultErr
 }
 
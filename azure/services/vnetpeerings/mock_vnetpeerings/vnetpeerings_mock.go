@@ -26,11 +26,13 @@ limitations under the License.
 package mock_vnetpeerings
 
 import (
+	context "context"
 	reflect "reflect"
 	time "time"
 
 	azcore "github.com/Azure/azure-sdk-for-go/sdk/azcore"
 	gomock "go.uber.org/mock/gomock"
+	v1 "k8s.io/api/core/v1"
 	v1beta1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	azure "sigs.k8s.io/cluster-api-provider-azure/azure"
 	v1beta10 "sigs.k8s.io/cluster-api/api/v1beta1"
@@ -209,6 +211,18 @@ func (mr *MockVnetPeeringScopeMockRecorder) SetLongRunningOperationState(arg0 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLongRunningOperationState", reflect.TypeOf((*MockVnetPeeringScope)(nil).SetLongRunningOperationState), arg0)
 }
 
+// SetVnetPeeringSyncStatus mocks base method.
+func (m *MockVnetPeeringScope) SetVnetPeeringSyncStatus(notSynced []string) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetVnetPeeringSyncStatus", notSynced)
+}
+
+// SetVnetPeeringSyncStatus indicates an expected call of SetVnetPeeringSyncStatus.
+func (mr *MockVnetPeeringScopeMockRecorder) SetVnetPeeringSyncStatus(notSynced any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetVnetPeeringSyncStatus", reflect.TypeOf((*MockVnetPeeringScope)(nil).SetVnetPeeringSyncStatus), notSynced)
+}
+
 // SubscriptionID mocks base method.
 func (m *MockVnetPeeringScope) SubscriptionID() string {
 	m.ctrl.T.Helper()
@@ -287,6 +301,21 @@ func (mr *MockVnetPeeringScopeMockRecorder) UpdatePutStatus(arg0, arg1, arg2 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePutStatus", reflect.TypeOf((*MockVnetPeeringScope)(nil).UpdatePutStatus), arg0, arg1, arg2)
 }
 
+// VnetPeeringAuthorizer mocks base method.
+func (m *MockVnetPeeringScope) VnetPeeringAuthorizer(ctx context.Context, subscriptionID string, identityRef *v1.ObjectReference) (azure.Authorizer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VnetPeeringAuthorizer", ctx, subscriptionID, identityRef)
+	ret0, _ := ret[0].(azure.Authorizer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VnetPeeringAuthorizer indicates an expected call of VnetPeeringAuthorizer.
+func (mr *MockVnetPeeringScopeMockRecorder) VnetPeeringAuthorizer(ctx, subscriptionID, identityRef any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VnetPeeringAuthorizer", reflect.TypeOf((*MockVnetPeeringScope)(nil).VnetPeeringAuthorizer), ctx, subscriptionID, identityRef)
+}
+
 // VnetPeeringSpecs mocks base method.
 func (m *MockVnetPeeringScope) VnetPeeringSpecs() []azure.ResourceSpecGetter {
 	m.ctrl.T.Helper()
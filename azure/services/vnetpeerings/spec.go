@@ -21,11 +21,14 @@ import (
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 )
 
 // VnetPeeringSpec defines the specification for a virtual network peering.
+// SubscriptionID is the subscription of the remote virtual network. SourceSubscriptionID and IdentityRef are the
+// subscription the peering is created in and the identity used to create it, and default to the cluster's.
 type VnetPeeringSpec struct {
 	SourceResourceGroup       string
 	SourceVnetName            string
@@ -33,6 +36,8 @@ type VnetPeeringSpec struct {
 	RemoteVnetName            string
 	PeeringName               string
 	SubscriptionID            string
+	SourceSubscriptionID      string
+	IdentityRef               *corev1.ObjectReference
 	AllowForwardedTraffic     *bool
 	AllowGatewayTransit       *bool
 	AllowVirtualNetworkAccess *bool
@@ -18,8 +18,13 @@ package vnetpeerings
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/async"
@@ -34,12 +39,25 @@ type VnetPeeringScope interface {
 	azure.Authorizer
 	azure.AsyncStatusUpdater
 	VnetPeeringSpecs() []azure.ResourceSpecGetter
+	VnetPeeringAuthorizer(ctx context.Context, subscriptionID string, identityRef *corev1.ObjectReference) (azure.Authorizer, error)
+	SetVnetPeeringSyncStatus(notSynced []string)
 }
 
 // Service provides operations on Azure resources.
 type Service struct {
 	Scope VnetPeeringScope
 	async.Reconciler
+	async.Getter
+	// clients caches the clients of peerings created in another subscription or with another identity than the
+	// cluster's, keyed by subscription and identity.
+	clients   map[string]peeringClient
+	newClient func(auth azure.Authorizer) (peeringClient, error)
+}
+
+// peeringClient reconciles and gets the peerings of a subscription with an identity.
+type peeringClient struct {
+	async.Reconciler
+	async.Getter
 }
 
 // New creates a new service.
@@ -52,6 +70,19 @@ func New(scope VnetPeeringScope) (*Service, error) {
 		Scope: scope,
 		Reconciler: async.New[armnetwork.VirtualNetworkPeeringsClientCreateOrUpdateResponse,
 			armnetwork.VirtualNetworkPeeringsClientDeleteResponse](scope, Client, Client),
+		Getter:  Client,
+		clients: make(map[string]peeringClient),
+		newClient: func(auth azure.Authorizer) (peeringClient, error) {
+			client, err := NewClient(auth, scope.DefaultedAzureCallTimeout())
+			if err != nil {
+				return peeringClient{}, err
+			}
+			return peeringClient{
+				Reconciler: async.New[armnetwork.VirtualNetworkPeeringsClientCreateOrUpdateResponse,
+					armnetwork.VirtualNetworkPeeringsClientDeleteResponse](scope, client, client),
+				Getter: client,
+			}, nil
+		},
 	}, nil
 }
 
@@ -78,7 +109,12 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error creating) -> operationNotDoneError (i.e. creating in progress) -> no error (i.e. created)
 	var result error
 	for _, peeringSpec := range specs {
-		if _, err := s.CreateOrUpdateResource(ctx, peeringSpec, ServiceName); err != nil {
+		client, err := s.clientFor(ctx, peeringSpec)
+		if err != nil {
+			result = err
+			continue
+		}
+		if _, err := client.CreateOrUpdateResource(ctx, peeringSpec, ServiceName); err != nil {
 			if !azure.IsOperationNotDoneError(err) || result == nil {
 				result = err
 			}
@@ -86,7 +122,37 @@ func (s *Service) Reconcile(ctx context.Context) error {
 	}
 
 	s.Scope.UpdatePutStatus(infrav1.VnetPeeringReadyCondition, ServiceName, result)
-	return result
+	if result != nil {
+		return result
+	}
+
+	// A peering is only connected once the peering in the other direction exists, so the sync status of the peerings
+	// is read after all of them have been created or updated.
+	notSynced, err := s.notSyncedPeerings(ctx, specs)
+	if err != nil {
+		return err
+	}
+	s.Scope.SetVnetPeeringSyncStatus(notSynced)
+	return nil
+}
+
+// notSyncedPeerings gets the peerings and describes the ones that are not connected or not fully in sync.
+func (s *Service) notSyncedPeerings(ctx context.Context, specs []azure.ResourceSpecGetter) ([]string, error) {
+	var notSynced []string
+	for _, peeringSpec := range specs {
+		client, err := s.clientFor(ctx, peeringSpec)
+		if err != nil {
+			return nil, err
+		}
+		peering, err := client.Get(ctx, peeringSpec)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to get virtual network peering %s", peeringSpec.ResourceName())
+		}
+		if msg := peeringSyncStatus(peering); msg != "" {
+			notSynced = append(notSynced, fmt.Sprintf("%s is %s", peeringSpec.ResourceName(), msg))
+		}
+	}
+	return notSynced, nil
 }
 
 // Delete deletes the peering with the provided name.
@@ -107,7 +173,12 @@ func (s *Service) Delete(ctx context.Context) error {
 	//  Order of precedence (highest -> lowest) is: error that is not an operationNotDoneError (i.e. error deleting) -> operationNotDoneError (i.e. deleting in progress) -> no error (i.e. deleted)
 	var result error
 	for _, peeringSpec := range specs {
-		if err := s.DeleteResource(ctx, peeringSpec, ServiceName); err != nil {
+		client, err := s.clientFor(ctx, peeringSpec)
+		if err != nil {
+			result = err
+			continue
+		}
+		if err := client.DeleteResource(ctx, peeringSpec, ServiceName); err != nil {
 			if !azure.IsOperationNotDoneError(err) || result == nil {
 				result = err
 			}
@@ -121,3 +192,49 @@ func (s *Service) Delete(ctx context.Context) error {
 func (s *Service) IsManaged(ctx context.Context) (bool, error) {
 	return true, nil
 }
+
+// clientFor returns the client for a peering, which uses the subscription and identity the peering needs to be
+// created with when they differ from the cluster's.
+func (s *Service) clientFor(ctx context.Context, spec azure.ResourceSpecGetter) (peeringClient, error) {
+	peeringSpec, ok := spec.(*VnetPeeringSpec)
+	if !ok || peeringSpec.IdentityRef == nil &&
+		(peeringSpec.SourceSubscriptionID == "" || strings.EqualFold(peeringSpec.SourceSubscriptionID, s.Scope.SubscriptionID())) {
+		return peeringClient{Reconciler: s.Reconciler, Getter: s.Getter}, nil
+	}
+
+	key := peeringSpec.SourceSubscriptionID
+	if peeringSpec.IdentityRef != nil {
+		key += "/" + peeringSpec.IdentityRef.Namespace + "/" + peeringSpec.IdentityRef.Name
+	}
+	if client, ok := s.clients[key]; ok {
+		return client, nil
+	}
+
+	auth, err := s.Scope.VnetPeeringAuthorizer(ctx, peeringSpec.SourceSubscriptionID, peeringSpec.IdentityRef)
+	if err != nil {
+		return peeringClient{}, errors.Wrapf(err, "failed to get credentials for virtual network peering %s", peeringSpec.PeeringName)
+	}
+	client, err := s.newClient(auth)
+	if err != nil {
+		return peeringClient{}, errors.Wrapf(err, "failed to create client for virtual network peering %s", peeringSpec.PeeringName)
+	}
+	s.clients[key] = client
+	return client, nil
+}
+
+// peeringSyncStatus describes a peering that is not connected or not fully in sync with the address spaces of the
+// peered virtual networks, and returns an empty string otherwise.
+func peeringSyncStatus(result interface{}) string {
+	peering, ok := result.(armnetwork.VirtualNetworkPeering)
+	if !ok || peering.Properties == nil {
+		return ""
+	}
+	var status []string
+	if state := ptr.Deref(peering.Properties.PeeringState, ""); state != "" && state != armnetwork.VirtualNetworkPeeringStateConnected {
+		status = append(status, string(state))
+	}
+	if syncLevel := ptr.Deref(peering.Properties.PeeringSyncLevel, ""); syncLevel != "" && syncLevel != armnetwork.VirtualNetworkPeeringLevelFullyInSync {
+		status = append(status, string(syncLevel))
+	}
+	return strings.Join(status, ", ")
+}
@@ -25,8 +25,11 @@ import (
 	"testing"
 
 	"github.com/Azure/azure-sdk-for-go/sdk/azcore"
+	"github.com/Azure/azure-sdk-for-go/sdk/resourcemanager/network/armnetwork/v4"
 	. "github.com/onsi/gomega"
+	"github.com/pkg/errors"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
@@ -101,9 +104,36 @@ var (
 		RemoteResourceGroup: "group4",
 		SubscriptionID:      "sub1",
 	}
-	fakePeeringSpecs      = []azure.ResourceSpecGetter{&fakePeering1To2, &fakePeering2To1, &fakePeering1To3, &fakePeering3To1, &fakePeeringHubToSpoke, &fakePeeringSpokeToHub}
-	fakePeeringExtraSpecs = []azure.ResourceSpecGetter{&fakePeering1To2, &fakePeering2To1, &fakePeeringExtra}
-	notDoneError          = azure.NewOperationNotDoneError(&infrav1.Future{})
+	fakePeeringToRemoteHub = VnetPeeringSpec{
+		PeeringName:         "spoke-to-hub",
+		SourceVnetName:      "spoke-vnet",
+		SourceResourceGroup: "spoke-group",
+		RemoteVnetName:      "hub-vnet",
+		RemoteResourceGroup: "hub-group",
+		SubscriptionID:      "sub2",
+		UseRemoteGateways:   ptr.To(true),
+	}
+	fakePeeringFromRemoteHub = VnetPeeringSpec{
+		PeeringName:          "hub-to-spoke",
+		SourceVnetName:       "hub-vnet",
+		SourceResourceGroup:  "hub-group",
+		RemoteVnetName:       "spoke-vnet",
+		RemoteResourceGroup:  "spoke-group",
+		SubscriptionID:       "sub1",
+		SourceSubscriptionID: "sub2",
+		IdentityRef:          &corev1.ObjectReference{Name: "hub-identity", Namespace: "default", Kind: infrav1.AzureClusterIdentityKind},
+		AllowGatewayTransit:  ptr.To(true),
+	}
+	fakeSyncedPeering = armnetwork.VirtualNetworkPeering{
+		Properties: &armnetwork.VirtualNetworkPeeringPropertiesFormat{
+			PeeringState:     ptr.To(armnetwork.VirtualNetworkPeeringStateConnected),
+			PeeringSyncLevel: ptr.To(armnetwork.VirtualNetworkPeeringLevelFullyInSync),
+		},
+	}
+	fakeCrossSubscriptionPeeringSpecs = []azure.ResourceSpecGetter{&fakePeeringToRemoteHub, &fakePeeringFromRemoteHub}
+	fakePeeringSpecs                  = []azure.ResourceSpecGetter{&fakePeering1To2, &fakePeering2To1, &fakePeering1To3, &fakePeering3To1, &fakePeeringHubToSpoke, &fakePeeringSpokeToHub}
+	fakePeeringExtraSpecs             = []azure.ResourceSpecGetter{&fakePeering1To2, &fakePeering2To1, &fakePeeringExtra}
+	notDoneError                      = azure.NewOperationNotDoneError(&infrav1.Future{})
 )
 
 func internalError() *azcore.ResponseError {
@@ -119,22 +149,24 @@ func TestReconcileVnetPeerings(t *testing.T) {
 	testcases := []struct {
 		name          string
 		expectedError string
-		expect        func(s *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder)
+		expect        func(s *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder)
 	}{
 		{
 			name:          "create one peering",
 			expectedError: "",
-			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				p.VnetPeeringSpecs().Return(fakePeeringSpecs[:1])
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering1To2, ServiceName).Return(&fakePeering1To2, nil)
 				p.UpdatePutStatus(infrav1.VnetPeeringReadyCondition, ServiceName, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering1To2).Return(fakeSyncedPeering, nil)
+				p.SetVnetPeeringSyncStatus(nil)
 			},
 		},
 		{
 			name:          "noop if no peering specs are found",
 			expectedError: "",
-			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				p.VnetPeeringSpecs().Return([]azure.ResourceSpecGetter{})
 			},
@@ -142,30 +174,37 @@ func TestReconcileVnetPeerings(t *testing.T) {
 		{
 			name:          "create even number of peerings",
 			expectedError: "",
-			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				p.VnetPeeringSpecs().Return(fakePeeringSpecs[:2])
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering1To2, ServiceName).Return(&fakePeering1To2, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering2To1, ServiceName).Return(&fakePeering2To1, nil)
 				p.UpdatePutStatus(infrav1.VnetPeeringReadyCondition, ServiceName, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering1To2).Return(fakeSyncedPeering, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering2To1).Return(fakeSyncedPeering, nil)
+				p.SetVnetPeeringSyncStatus(nil)
 			},
 		},
 		{
 			name:          "create odd number of peerings",
 			expectedError: "",
-			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				p.VnetPeeringSpecs().Return(fakePeeringExtraSpecs)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering1To2, ServiceName).Return(&fakePeering1To2, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering2To1, ServiceName).Return(&fakePeering2To1, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeeringExtra, ServiceName).Return(&fakePeeringExtra, nil)
 				p.UpdatePutStatus(infrav1.VnetPeeringReadyCondition, ServiceName, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering1To2).Return(fakeSyncedPeering, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering2To1).Return(fakeSyncedPeering, nil)
+				g.Get(gomockinternal.AContext(), &fakePeeringExtra).Return(fakeSyncedPeering, nil)
+				p.SetVnetPeeringSyncStatus(nil)
 			},
 		},
 		{
 			name:          "create multiple peerings on one vnet",
 			expectedError: "",
-			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				p.VnetPeeringSpecs().Return(fakePeeringSpecs)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering1To2, ServiceName).Return(&fakePeering1To2, nil)
@@ -175,12 +214,19 @@ func TestReconcileVnetPeerings(t *testing.T) {
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeeringHubToSpoke, ServiceName).Return(&fakePeeringHubToSpoke, nil)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeeringSpokeToHub, ServiceName).Return(&fakePeeringSpokeToHub, nil)
 				p.UpdatePutStatus(infrav1.VnetPeeringReadyCondition, ServiceName, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering1To2).Return(fakeSyncedPeering, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering2To1).Return(fakeSyncedPeering, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering1To3).Return(fakeSyncedPeering, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering3To1).Return(fakeSyncedPeering, nil)
+				g.Get(gomockinternal.AContext(), &fakePeeringHubToSpoke).Return(fakeSyncedPeering, nil)
+				g.Get(gomockinternal.AContext(), &fakePeeringSpokeToHub).Return(fakeSyncedPeering, nil)
+				p.SetVnetPeeringSyncStatus(nil)
 			},
 		},
 		{
 			name:          "error in creating peering",
 			expectedError: "#: Internal Server Error: StatusCode=500",
-			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				p.VnetPeeringSpecs().Return(fakePeeringSpecs)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering1To2, ServiceName).Return(&fakePeering1To2, nil)
@@ -195,7 +241,7 @@ func TestReconcileVnetPeerings(t *testing.T) {
 		{
 			name:          "not done error in creating is ignored",
 			expectedError: "#: Internal Server Error: StatusCode=500",
-			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				p.VnetPeeringSpecs().Return(fakePeeringSpecs)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering1To2, ServiceName).Return(&fakePeering1To2, nil)
@@ -210,7 +256,7 @@ func TestReconcileVnetPeerings(t *testing.T) {
 		{
 			name:          "not done error in creating is overwritten",
 			expectedError: "#: Internal Server Error: StatusCode=500",
-			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				p.VnetPeeringSpecs().Return(fakePeeringSpecs)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering1To2, ServiceName).Return(&fakePeering1To2, nil)
@@ -225,7 +271,7 @@ func TestReconcileVnetPeerings(t *testing.T) {
 		{
 			name:          "not done error in creating remains",
 			expectedError: "operation type  on Azure resource / is not done",
-			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder) {
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
 				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
 				p.VnetPeeringSpecs().Return(fakePeeringSpecs)
 				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering1To2, ServiceName).Return(&fakePeering1To2, nil)
@@ -237,6 +283,87 @@ func TestReconcileVnetPeerings(t *testing.T) {
 				p.UpdatePutStatus(infrav1.VnetPeeringReadyCondition, ServiceName, notDoneError)
 			},
 		},
+		{
+			name:          "create peerings with a remote vnet in another subscription",
+			expectedError: "",
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
+				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				p.VnetPeeringSpecs().Return(fakeCrossSubscriptionPeeringSpecs)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeeringToRemoteHub, ServiceName).Return(fakeSyncedPeering, nil)
+				p.VnetPeeringAuthorizer(gomockinternal.AContext(), "sub2", fakePeeringFromRemoteHub.IdentityRef).Return(nil, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeeringFromRemoteHub, ServiceName).Return(fakeSyncedPeering, nil)
+				p.UpdatePutStatus(infrav1.VnetPeeringReadyCondition, ServiceName, nil)
+				g.Get(gomockinternal.AContext(), &fakePeeringToRemoteHub).Return(fakeSyncedPeering, nil)
+				g.Get(gomockinternal.AContext(), &fakePeeringFromRemoteHub).Return(fakeSyncedPeering, nil)
+				p.SetVnetPeeringSyncStatus(nil)
+			},
+		},
+		{
+			name:          "peerings that are not in sync are reported",
+			expectedError: "",
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
+				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				p.VnetPeeringSpecs().Return(fakePeeringSpecs[:2])
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering1To2, ServiceName).Return(&fakePeering1To2, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering2To1, ServiceName).Return(&fakePeering2To1, nil)
+				p.UpdatePutStatus(infrav1.VnetPeeringReadyCondition, ServiceName, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering1To2).Return(armnetwork.VirtualNetworkPeering{
+					Properties: &armnetwork.VirtualNetworkPeeringPropertiesFormat{
+						PeeringState:     ptr.To(armnetwork.VirtualNetworkPeeringStateConnected),
+						PeeringSyncLevel: ptr.To(armnetwork.VirtualNetworkPeeringLevelLocalNotInSync),
+					},
+				}, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering2To1).Return(armnetwork.VirtualNetworkPeering{
+					Properties: &armnetwork.VirtualNetworkPeeringPropertiesFormat{
+						PeeringState:     ptr.To(armnetwork.VirtualNetworkPeeringStateDisconnected),
+						PeeringSyncLevel: ptr.To(armnetwork.VirtualNetworkPeeringLevelRemoteNotInSync),
+					},
+				}, nil)
+				p.SetVnetPeeringSyncStatus([]string{"vnet1-to-vnet2 is LocalNotInSync", "vnet2-to-vnet1 is Disconnected, RemoteNotInSync"})
+			},
+		},
+		{
+			name:          "sync status is read after the peerings in both directions are created",
+			expectedError: "",
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
+				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				p.VnetPeeringSpecs().Return(fakePeeringSpecs[:2])
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering1To2, ServiceName).Return(armnetwork.VirtualNetworkPeering{
+					Properties: &armnetwork.VirtualNetworkPeeringPropertiesFormat{
+						PeeringState: ptr.To(armnetwork.VirtualNetworkPeeringStateInitiated),
+					},
+				}, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering2To1, ServiceName).Return(fakeSyncedPeering, nil)
+				p.UpdatePutStatus(infrav1.VnetPeeringReadyCondition, ServiceName, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering1To2).Return(fakeSyncedPeering, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering2To1).Return(fakeSyncedPeering, nil)
+				p.SetVnetPeeringSyncStatus(nil)
+			},
+		},
+		{
+			name:          "error getting a peering to read its sync status",
+			expectedError: "failed to get virtual network peering vnet2-to-vnet1",
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
+				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				p.VnetPeeringSpecs().Return(fakePeeringSpecs[:2])
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering1To2, ServiceName).Return(&fakePeering1To2, nil)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeering2To1, ServiceName).Return(&fakePeering2To1, nil)
+				p.UpdatePutStatus(infrav1.VnetPeeringReadyCondition, ServiceName, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering1To2).Return(fakeSyncedPeering, nil)
+				g.Get(gomockinternal.AContext(), &fakePeering2To1).Return(nil, internalError())
+			},
+		},
+		{
+			name:          "error getting credentials for a peering in another subscription",
+			expectedError: "failed to get credentials for virtual network peering hub-to-spoke: identity not found",
+			expect: func(p *mock_vnetpeerings.MockVnetPeeringScopeMockRecorder, r *mock_async.MockReconcilerMockRecorder, g *mock_async.MockGetterMockRecorder) {
+				p.DefaultedAzureServiceReconcileTimeout().Return(reconciler.DefaultAzureServiceReconcileTimeout)
+				p.VnetPeeringSpecs().Return(fakeCrossSubscriptionPeeringSpecs)
+				r.CreateOrUpdateResource(gomockinternal.AContext(), &fakePeeringToRemoteHub, ServiceName).Return(fakeSyncedPeering, nil)
+				p.VnetPeeringAuthorizer(gomockinternal.AContext(), "sub2", fakePeeringFromRemoteHub.IdentityRef).Return(nil, errors.New("identity not found"))
+				p.UpdatePutStatus(infrav1.VnetPeeringReadyCondition, ServiceName, gomock.Any())
+			},
+		},
 	}
 
 	for _, tc := range testcases {
@@ -249,12 +376,18 @@ func TestReconcileVnetPeerings(t *testing.T) {
 			defer mockCtrl.Finish()
 			scopeMock := mock_vnetpeerings.NewMockVnetPeeringScope(mockCtrl)
 			asyncMock := mock_async.NewMockReconciler(mockCtrl)
+			getterMock := mock_async.NewMockGetter(mockCtrl)
 
-			tc.expect(scopeMock.EXPECT(), asyncMock.EXPECT())
+			tc.expect(scopeMock.EXPECT(), asyncMock.EXPECT(), getterMock.EXPECT())
 
 			s := &Service{
 				Scope:      scopeMock,
 				Reconciler: asyncMock,
+				Getter:     getterMock,
+				clients:    make(map[string]peeringClient),
+				newClient: func(azure.Authorizer) (peeringClient, error) {
+					return peeringClient{Reconciler: asyncMock, Getter: getterMock}, nil
+				},
 			}
 
 			err := s.Reconcile(context.TODO())
@@ -85,14 +85,24 @@ type TagsSpec struct {
 
 // ExtensionSpec defines the specification for a VM or VMSS extension.
 type ExtensionSpec struct {
-	Name              string
-	VMName            string
-	Publisher         string
+	Name      string
+	VMName    string
+	Publisher string
+	// Type is the type of the extension handler. Defaults to Name.
+	Type              string
 	Version           string
 	Settings          map[string]string
 	ProtectedSettings map[string]string
 }
 
+// ExtensionType returns the type of the extension handler.
+func (s ExtensionSpec) ExtensionType() string {
+	if s.Type != "" {
+		return s.Type
+	}
+	return s.Name
+}
+
 type (
 	// VMSSVM defines a VM in a virtual machine scale set.
 	VMSSVM struct {
@@ -191,6 +191,15 @@ func (acr *AzureClusterReconciler) Reconcile(ctx context.Context, req ctrl.Reque
 		acr.Recorder.Eventf(azureCluster, corev1.EventTypeWarning, "AzureClusterIdentity", deprecatedManagerCredsWarning)
 	}
 
+	for _, peering := range azureCluster.Spec.NetworkSpec.Vnet.Peerings {
+		if peering.ReversePeeringIdentityRef != nil {
+			err := EnsureClusterIdentity(ctx, acr.Client, azureCluster, peering.ReversePeeringIdentityRef, infrav1.ClusterFinalizer)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+	}
+
 	// Handle deleted clusters
 	if !azureCluster.DeletionTimestamp.IsZero() {
 		return acr.reconcileDelete(ctx, clusterScope)
@@ -327,5 +336,14 @@ func (acr *AzureClusterReconciler) reconcileDelete(ctx context.Context, clusterS
 		}
 	}
 
+	for _, peering := range azureCluster.Spec.NetworkSpec.Vnet.Peerings {
+		if peering.ReversePeeringIdentityRef != nil {
+			err := RemoveClusterIdentityFinalizer(ctx, acr.Client, azureCluster, peering.ReversePeeringIdentityRef, infrav1.ClusterFinalizer)
+			if err != nil {
+				return reconcile.Result{}, err
+			}
+		}
+	}
+
 	return reconcile.Result{}, nil
 }
@@ -22,7 +22,9 @@ import (
 	"github.com/pkg/errors"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/applicationsecuritygroups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bastionhosts"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/flowlogs"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/groups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/loadbalancers"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/natgateways"
@@ -57,10 +59,22 @@ func newAzureClusterService(scope *scope.ClusterScope) (*azureClusterService, er
 	if err != nil {
 		return nil, errors.Wrap(err, "failed creating a NewCache")
 	}
+	vnetSvc, err := virtualnetworks.New(scope)
+	if err != nil {
+		return nil, err
+	}
+	applicationSecurityGroupsSvc, err := applicationsecuritygroups.New(scope)
+	if err != nil {
+		return nil, err
+	}
 	securityGroupsSvc, err := securitygroups.New(scope)
 	if err != nil {
 		return nil, err
 	}
+	flowLogsSvc, err := flowlogs.New(scope)
+	if err != nil {
+		return nil, err
+	}
 	routeTablesSvc, err := routetables.New(scope)
 	if err != nil {
 		return nil, err
@@ -85,8 +99,10 @@ func newAzureClusterService(scope *scope.ClusterScope) (*azureClusterService, er
 		scope: scope,
 		services: []azure.ServiceReconciler{
 			groups.New(scope),
-			virtualnetworks.New(scope),
+			vnetSvc,
+			applicationSecurityGroupsSvc,
 			securityGroupsSvc,
+			flowLogsSvc,
 			routeTablesSvc,
 			publicIPsSvc,
 			natgateways.New(scope),
@@ -162,6 +178,15 @@ func (s *azureClusterService) delete(ctx context.Context) error {
 			return errors.Wrap(err, "failed to delete peerings")
 		}
 
+		// We need to explicitly delete flow logs, as they are in the resource group of the Network Watcher.
+		flowLogsSvc, err := s.getService(flowlogs.ServiceName)
+		if err != nil {
+			return errors.Wrap(err, "failed to get flow logs service")
+		}
+		if err := flowLogsSvc.Delete(ctx); err != nil {
+			return errors.Wrap(err, "failed to delete flow logs")
+		}
+
 		groupSvc, err := s.getService(groups.ServiceName)
 		if err != nil {
 			return errors.Wrap(err, "failed to get group service")
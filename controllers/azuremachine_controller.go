@@ -129,7 +129,7 @@ func (amr *AzureMachineReconciler) SetupWithManager(ctx context.Context, mgr ctr
 // +kubebuilder:rbac:groups=infrastructure.cluster.x-k8s.io,resources=azuremachines/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=cluster.x-k8s.io,resources=machines;machines/status,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=events,verbs=get;list;watch;create;update;patch
-// +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch
+// +kubebuilder:rbac:groups="",resources=secrets;,verbs=get;list;watch;create;update;patch
 
 // Reconcile idempotently gets, creates, and updates a machine.
 func (amr *AzureMachineReconciler) Reconcile(ctx context.Context, req ctrl.Request) (_ ctrl.Result, reterr error) {
@@ -287,6 +287,23 @@ func (amr *AzureMachineReconciler) reconcileNormal(ctx context.Context, machineS
 		return reconcile.Result{}, errors.Wrap(err, "failed to init machine scope cache")
 	}
 
+	// Reject VM sizes that don't support the requested features before any Azure resource is created for the machine.
+	if machineScope.ProviderID() == "" {
+		if err := machineScope.ValidateVMSize(); err != nil {
+			if errors.As(err, &reconcileError) && reconcileError.IsTerminal() {
+				amr.Recorder.Eventf(machineScope.AzureMachine, corev1.EventTypeWarning, infrav1.VMSizeIncompatibleReason, err.Error())
+				log.Error(err, "VM size is not compatible with the AzureMachine")
+				conditions.MarkFalse(machineScope.AzureMachine, infrav1.VMSizeCompatibleCondition, infrav1.VMSizeIncompatibleReason, clusterv1.ConditionSeverityError, err.Error())
+				machineScope.SetFailureReason(capierrors.InvalidConfigurationMachineError)
+				machineScope.SetFailureMessage(err)
+				machineScope.SetNotReady()
+				return reconcile.Result{}, nil
+			}
+			return reconcile.Result{}, errors.Wrap(err, "failed to validate the VM size")
+		}
+		conditions.MarkTrue(machineScope.AzureMachine, infrav1.VMSizeCompatibleCondition)
+	}
+
 	// Mark the AzureMachine as failed if the identities are not ready.
 	cond := conditions.Get(machineScope.AzureMachine, infrav1.VMIdentitiesReadyCondition)
 	if cond != nil && cond.Status == corev1.ConditionFalse && cond.Reason == infrav1.UserAssignedIdentityMissingReason {
@@ -302,6 +319,8 @@ func (amr *AzureMachineReconciler) reconcileNormal(ctx context.Context, machineS
 	}
 
 	if err := ams.Reconcile(ctx); err != nil {
+		amr.reconcileBootstrapDiagnostics(ctx, machineScope, ams)
+
 		// This means that a VM was created and managed by this controller, but is not present anymore.
 		// In this case, we mark it as failed and leave it to MHC for remediation
 		if errors.As(err, &azure.VMDeletedError{}) {
@@ -338,11 +357,40 @@ func (amr *AzureMachineReconciler) reconcileNormal(ctx context.Context, machineS
 		return reconcile.Result{}, errors.Wrap(err, "failed to reconcile AzureMachine")
 	}
 
+	machineScope.UpdateBootstrapStatusFromNode()
 	machineScope.SetReady()
 
 	return reconcile.Result{}, nil
 }
 
+// reconcileBootstrapDiagnostics collects diagnostics from the VM when its bootstrapping failed. The failure is only
+// reported by the VM extensions during the reconciliation in which they fail, so this needs to happen right after it.
+// Failing to collect diagnostics doesn't fail the reconciliation.
+func (amr *AzureMachineReconciler) reconcileBootstrapDiagnostics(ctx context.Context, machineScope *scope.MachineScope, ams *azureMachineService) {
+	ctx, log, done := tele.StartSpanWithLogger(ctx, "controllers.AzureMachineReconciler.reconcileBootstrapDiagnostics")
+	defer done()
+
+	if !machineScope.NeedsBootstrapDiagnostics() {
+		return
+	}
+
+	diagnostics, err := ams.CollectBootstrapDiagnostics(ctx)
+	if err != nil {
+		log.Error(err, "failed to collect bootstrap diagnostics")
+		return
+	}
+	if diagnostics == nil {
+		return
+	}
+	if err := machineScope.StoreBootstrapDiagnostics(ctx, diagnostics); err != nil {
+		log.Error(err, "failed to store bootstrap diagnostics")
+		return
+	}
+
+	amr.Recorder.Eventf(machineScope.AzureMachine, corev1.EventTypeWarning, infrav1.BootstrapDiagnosticsCollectedReason,
+		"VM bootstrapping failed, the serial console log and VM extension status were saved to Secret %s", machineScope.BootstrapDiagnosticsSecretName())
+}
+
 //nolint:unparam // Always returns an empty struct for reconcile.Result
 func (amr *AzureMachineReconciler) reconcilePause(ctx context.Context, machineScope *scope.MachineScope) (reconcile.Result, error) {
 	ctx, log, done := tele.StartSpanWithLogger(ctx, "controllers.AzureMachine.reconcilePause")
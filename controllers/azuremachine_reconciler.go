@@ -23,9 +23,12 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/availabilitysets"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bootstrapdata"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bootstrapdiagnostics"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/disks"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/inboundnatrules"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/networkinterfaces"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/proximityplacementgroups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/publicips"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/roleassignments"
@@ -45,6 +48,8 @@ type azureMachineService struct {
 	Reconcile func(context.Context) error
 	Pause     func(context.Context) error
 	Delete    func(context.Context) error
+	// CollectBootstrapDiagnostics collects diagnostics from the VM when its bootstrapping failed.
+	CollectBootstrapDiagnostics func(context.Context) (*bootstrapdiagnostics.Diagnostics, error)
 }
 
 // newAzureMachineService populates all the services based on input scope.
@@ -53,6 +58,10 @@ func newAzureMachineService(machineScope *scope.MachineScope) (*azureMachineServ
 	if err != nil {
 		return nil, errors.Wrap(err, "failed creating a NewCache")
 	}
+	proximityPlacementGroupsSvc, err := proximityplacementgroups.New(machineScope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed creating proximityplacementgroups service")
+	}
 	availabilitySetsSvc, err := availabilitysets.New(machineScope, cache)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed creating availabilitysets service")
@@ -89,14 +98,21 @@ func newAzureMachineService(machineScope *scope.MachineScope) (*azureMachineServ
 	if err != nil {
 		return nil, errors.Wrap(err, "failed creating networkinterfaces service")
 	}
+	bootstrapDataSvc := bootstrapdata.New(machineScope)
+	bootstrapDiagnosticsSvc, err := bootstrapdiagnostics.New(machineScope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed creating bootstrapdiagnostics service")
+	}
 	ams := &azureMachineService{
 		scope: machineScope,
 		services: []azure.ServiceReconciler{
 			publicIPsSvc,
 			inboundnatrulesSvc,
 			networkInterfacesSvc,
+			proximityPlacementGroupsSvc,
 			availabilitySetsSvc,
 			disksSvc,
+			bootstrapDataSvc,
 			virtualmachinesSvc,
 			roleAssignmentsSvc,
 			vmextensionsSvc,
@@ -107,6 +123,7 @@ func newAzureMachineService(machineScope *scope.MachineScope) (*azureMachineServ
 	ams.Reconcile = ams.reconcile
 	ams.Pause = ams.pause
 	ams.Delete = ams.delete
+	ams.CollectBootstrapDiagnostics = bootstrapDiagnosticsSvc.Collect
 
 	return ams, nil
 }
@@ -123,6 +140,10 @@ func (s *azureMachineService) reconcile(ctx context.Context) error {
 		return errors.Wrap(err, "failed defaulting subnet name")
 	}
 
+	if err := s.scope.ValidateSubnetRoles(); err != nil {
+		return err
+	}
+
 	for _, service := range s.services {
 		if err := service.Reconcile(ctx); err != nil {
 			return errors.Wrapf(err, "failed to reconcile AzureMachine service %s", service.Name())
@@ -48,6 +48,10 @@ type azureManagedControlPlaneService struct {
 
 // newAzureManagedControlPlaneReconciler populates all the services based on input scope.
 func newAzureManagedControlPlaneReconciler(scope *scope.ManagedControlPlaneScope) (*azureManagedControlPlaneService, error) {
+	vnetSvc, err := virtualnetworks.New(scope)
+	if err != nil {
+		return nil, err
+	}
 	resourceHealthSvc, err := resourcehealth.New(scope)
 	if err != nil {
 		return nil, err
@@ -57,7 +61,7 @@ func newAzureManagedControlPlaneReconciler(scope *scope.ManagedControlPlaneScope
 		scope:      scope,
 		services: []azure.ServiceReconciler{
 			groups.New(scope),
-			virtualnetworks.New(scope),
+			vnetSvc,
 			subnets.New(scope),
 			managedclusters.New(scope),
 			privateendpoints.New(scope),
@@ -53,18 +53,19 @@ func TestAzureManagedMachinePoolReconcile(t *testing.T) {
 
 	cases := []struct {
 		name   string
-		Setup  func(cb *fake.ClientBuilder, reconciler pausingReconciler, agentpools *mock_agentpools.MockAgentPoolScopeMockRecorder, nodelister *MockNodeListerMockRecorder)
+		Setup  func(cb *fake.ClientBuilder, reconciler pausingReconciler, ppgs *mock_azure.MockReconcilerMockRecorder, snapshots *mock_azure.MockReconcilerMockRecorder, agentpools *mock_agentpools.MockAgentPoolScopeMockRecorder, nodelister *MockNodeListerMockRecorder)
 		Verify func(g *WithT, result ctrl.Result, err error)
 	}{
 		{
 			name: "Reconcile succeed",
-			Setup: func(cb *fake.ClientBuilder, reconciler pausingReconciler, agentpools *mock_agentpools.MockAgentPoolScopeMockRecorder, nodelister *MockNodeListerMockRecorder) {
+			Setup: func(cb *fake.ClientBuilder, reconciler pausingReconciler, ppgs *mock_azure.MockReconcilerMockRecorder, snapshots *mock_azure.MockReconcilerMockRecorder, agentpools *mock_agentpools.MockAgentPoolScopeMockRecorder, nodelister *MockNodeListerMockRecorder) {
 				cluster, azManagedCluster, azManagedControlPlane, ammp, mp := newReadyAzureManagedMachinePoolCluster()
 				fakeAgentPoolSpec := fakeAgentPool()
 				providerIDs := []string{"azure:///subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/myresourcegroupname/providers/Microsoft.Compute/virtualMachineScaleSets/myScaleSetName/virtualMachines/156"}
 				fakeVirtualMachineScaleSet := fakeVirtualMachineScaleSet()
 				fakeVirtualMachineScaleSetVM := fakeVirtualMachineScaleSetVM()
 
+				ppgs.Reconcile(gomock2.AContext()).Return(nil)
 				reconciler.MockReconciler.EXPECT().Reconcile(gomock2.AContext()).Return(nil)
 				agentpools.SetSubnetName()
 				agentpools.AgentPoolSpec().Return(&fakeAgentPoolSpec)
@@ -73,6 +74,7 @@ func TestAzureManagedMachinePoolReconcile(t *testing.T) {
 				agentpools.SetAgentPoolReplicas(int32(len(providerIDs))).Return()
 				agentpools.SetAgentPoolReady(true).Return()
 				agentpools.IsPreviewEnabled().Return(false)
+				snapshots.Reconcile(gomock2.AContext()).Return(nil)
 
 				nodelister.List(gomock2.AContext(), "fake-rg").Return(fakeVirtualMachineScaleSet, nil)
 				nodelister.ListInstances(gomock2.AContext(), "fake-rg", "vmssName").Return(fakeVirtualMachineScaleSetVM, nil)
@@ -85,7 +87,7 @@ func TestAzureManagedMachinePoolReconcile(t *testing.T) {
 		},
 		{
 			name: "Reconcile pause",
-			Setup: func(cb *fake.ClientBuilder, reconciler pausingReconciler, agentpools *mock_agentpools.MockAgentPoolScopeMockRecorder, nodelister *MockNodeListerMockRecorder) {
+			Setup: func(cb *fake.ClientBuilder, reconciler pausingReconciler, _ *mock_azure.MockReconcilerMockRecorder, _ *mock_azure.MockReconcilerMockRecorder, agentpools *mock_agentpools.MockAgentPoolScopeMockRecorder, nodelister *MockNodeListerMockRecorder) {
 				cluster, azManagedCluster, azManagedControlPlane, ammp, mp := newReadyAzureManagedMachinePoolCluster()
 				cluster.Spec.Paused = true
 
@@ -99,9 +101,10 @@ func TestAzureManagedMachinePoolReconcile(t *testing.T) {
 		},
 		{
 			name: "Reconcile delete",
-			Setup: func(cb *fake.ClientBuilder, reconciler pausingReconciler, _ *mock_agentpools.MockAgentPoolScopeMockRecorder, _ *MockNodeListerMockRecorder) {
+			Setup: func(cb *fake.ClientBuilder, reconciler pausingReconciler, ppgs *mock_azure.MockReconcilerMockRecorder, _ *mock_azure.MockReconcilerMockRecorder, _ *mock_agentpools.MockAgentPoolScopeMockRecorder, _ *MockNodeListerMockRecorder) {
 				cluster, azManagedCluster, azManagedControlPlane, ammp, mp := newReadyAzureManagedMachinePoolCluster()
 				reconciler.MockReconciler.EXPECT().Delete(gomock2.AContext()).Return(nil)
+				ppgs.Delete(gomock2.AContext()).Return(nil)
 				ammp.DeletionTimestamp = &metav1.Time{
 					Time: time.Now(),
 				}
@@ -113,7 +116,7 @@ func TestAzureManagedMachinePoolReconcile(t *testing.T) {
 		},
 		{
 			name: "Reconcile delete transient error",
-			Setup: func(cb *fake.ClientBuilder, reconciler pausingReconciler, agentpools *mock_agentpools.MockAgentPoolScopeMockRecorder, _ *MockNodeListerMockRecorder) {
+			Setup: func(cb *fake.ClientBuilder, reconciler pausingReconciler, _ *mock_azure.MockReconcilerMockRecorder, _ *mock_azure.MockReconcilerMockRecorder, agentpools *mock_agentpools.MockAgentPoolScopeMockRecorder, _ *MockNodeListerMockRecorder) {
 				cluster, azManagedCluster, azManagedControlPlane, ammp, mp := newReadyAzureManagedMachinePoolCluster()
 				reconciler.MockReconciler.EXPECT().Delete(gomock2.AContext()).Return(azure.WithTransientError(errors.New("transient"), 76*time.Second))
 				agentpools.Name()
@@ -139,6 +142,8 @@ func TestAzureManagedMachinePoolReconcile(t *testing.T) {
 					MockReconciler: mock_azure.NewMockReconciler(mockCtrl),
 					MockPauser:     mock_azure.NewMockPauser(mockCtrl),
 				}
+				ppgs         = mock_azure.NewMockReconciler(mockCtrl)
+				snapshots    = mock_azure.NewMockReconciler(mockCtrl)
 				agentpools   = mock_agentpools.NewMockAgentPoolScope(mockCtrl)
 				nodelister   = NewMockNodeLister(mockCtrl)
 				fakeIdentity = &infrav1.AzureClusterIdentity{
@@ -176,13 +181,15 @@ func TestAzureManagedMachinePoolReconcile(t *testing.T) {
 			)
 			defer mockCtrl.Finish()
 
-			c.Setup(cb, reconciler, agentpools.EXPECT(), nodelister.EXPECT())
+			c.Setup(cb, reconciler, ppgs.EXPECT(), snapshots.EXPECT(), agentpools.EXPECT(), nodelister.EXPECT())
 			controller := NewAzureManagedMachinePoolReconciler(cb.Build(), nil, reconcilerutils.Timeouts{}, "foo")
 			controller.createAzureManagedMachinePoolService = func(_ *scope.ManagedMachinePoolScope, _ time.Duration) (*azureManagedMachinePoolService, error) {
 				return &azureManagedMachinePoolService{
-					scope:         agentpools,
-					agentPoolsSvc: reconciler,
-					scaleSetsSvc:  nodelister,
+					scope:                       agentpools,
+					proximityPlacementGroupsSvc: ppgs,
+					agentPoolsSvc:               reconciler,
+					agentPoolSnapshotsSvc:       snapshots,
+					scaleSetsSvc:                nodelister,
 				}, nil
 			}
 			res, err := controller.Reconcile(context.TODO(), ctrl.Request{
@@ -29,6 +29,8 @@ import (
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/agentpools"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/agentpoolsnapshots"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/proximityplacementgroups"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/scalesets"
 	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/util/tele"
@@ -37,9 +39,11 @@ import (
 type (
 	// azureManagedMachinePoolService contains the services required by the cluster controller.
 	azureManagedMachinePoolService struct {
-		scope         agentpools.AgentPoolScope
-		agentPoolsSvc azure.Reconciler
-		scaleSetsSvc  NodeLister
+		scope                       agentpools.AgentPoolScope
+		proximityPlacementGroupsSvc azure.Reconciler
+		agentPoolsSvc               azure.Reconciler
+		agentPoolSnapshotsSvc       azure.Reconciler
+		scaleSetsSvc                NodeLister
 	}
 
 	// AgentPoolVMSSNotFoundError represents a reconcile error when the VMSS for an agent pool can't be found.
@@ -85,10 +89,20 @@ func newAzureManagedMachinePoolService(scope *scope.ManagedMachinePoolScope, api
 	if err != nil {
 		return nil, err
 	}
+	proximityPlacementGroupsSvc, err := proximityplacementgroups.New(scope)
+	if err != nil {
+		return nil, err
+	}
+	agentPoolSnapshotsSvc, err := agentpoolsnapshots.New(scope)
+	if err != nil {
+		return nil, err
+	}
 	return &azureManagedMachinePoolService{
-		scope:         scope,
-		agentPoolsSvc: agentpools.New(scope),
-		scaleSetsSvc:  scaleSetsClient,
+		scope:                       scope,
+		proximityPlacementGroupsSvc: proximityPlacementGroupsSvc,
+		agentPoolsSvc:               agentpools.New(scope),
+		agentPoolSnapshotsSvc:       agentPoolSnapshotsSvc,
+		scaleSetsSvc:                scaleSetsClient,
 	}, nil
 }
 
@@ -121,6 +135,10 @@ func (s *azureManagedMachinePoolService) Reconcile(ctx context.Context) error {
 		agentPoolName = agentPool.(*asocontainerservicev1.ManagedClustersAgentPool).AzureName()
 	}
 
+	if err := s.proximityPlacementGroupsSvc.Reconcile(ctx); err != nil {
+		return errors.Wrapf(err, "failed to reconcile proximity placement group of machine pool %s", agentPoolName)
+	}
+
 	if err := s.agentPoolsSvc.Reconcile(ctx); err != nil {
 		return errors.Wrapf(err, "failed to reconcile machine pool %s", agentPoolName)
 	}
@@ -168,6 +186,10 @@ func (s *azureManagedMachinePoolService) Reconcile(ctx context.Context) error {
 	s.scope.SetAgentPoolReplicas(int32(len(providerIDs)))
 	s.scope.SetAgentPoolReady(true)
 
+	if err := s.agentPoolSnapshotsSvc.Reconcile(ctx); err != nil {
+		return errors.Wrapf(err, "failed to reconcile snapshot of machine pool %s", agentPoolName)
+	}
+
 	log.Info("reconciled managed machine pool successfully")
 	return nil
 }
@@ -197,5 +219,9 @@ func (s *azureManagedMachinePoolService) Delete(ctx context.Context) error {
 		return errors.Wrapf(err, "failed to delete machine pool %s", s.scope.Name())
 	}
 
+	if err := s.proximityPlacementGroupsSvc.Delete(ctx); err != nil {
+		return errors.Wrapf(err, "failed to delete proximity placement group of machine pool %s", s.scope.Name())
+	}
+
 	return nil
 }
@@ -103,6 +103,36 @@ type (
 		// The primary interface will be the first networkInterface specified (index 0) in the list.
 		// +optional
 		NetworkInterfaces []infrav1.NetworkInterface `json:"networkInterfaces,omitempty"`
+
+		// BootstrapTransport specifies how the bootstrap data is delivered to the scale set's instances.
+		// By default it is passed as custom data. With the UserData transport, the bootstrap data can be read back
+		// from the Instance Metadata Service and is updated on the scale set model without changing its custom data.
+		// The StorageBlob and KeyVaultSecret transports are not supported for machine pools.
+		// +optional
+		BootstrapTransport *infrav1.BootstrapTransport `json:"bootstrapTransport,omitempty"`
+
+		// BootstrapCheck configures the VM extension that reports whether the scale set's instances have bootstrapped
+		// successfully, or disables it for images that report their readiness in another way.
+		// +optional
+		BootstrapCheck *infrav1.BootstrapCheck `json:"bootstrapCheck,omitempty"`
+
+		// CapacityReservationGroupID specifies the capacity reservation group resource id that should be
+		// used for allocating the scale set's instances.
+		// The input for capacityReservationGroupID must be similar to '/subscriptions/{subscriptionId}/resourceGroups/{resourceGroupName}/providers/Microsoft.Compute/capacityReservationGroups/{capacityReservationGroupName}'.
+		// It is optional but may not be changed once set.
+		// +optional
+		CapacityReservationGroupID *string `json:"capacityReservationGroupID,omitempty"`
+
+		// ProximityPlacementGroupID is the resource ID of an existing proximity placement group to place the scale set in.
+		// It is optional but may not be changed once set.
+		// +optional
+		ProximityPlacementGroupID *string `json:"proximityPlacementGroupID,omitempty"`
+
+		// HostGroupID is the resource ID of a dedicated host group to place the scale set's instances in. The host group
+		// must have automatic placement enabled. Spot VMs are not supported on dedicated hosts.
+		// It is optional but may not be changed once set.
+		// +optional
+		HostGroupID *string `json:"hostGroupID,omitempty"`
 	}
 
 	// AzureMachinePoolSpec defines the desired state of AzureMachinePool.
@@ -315,6 +345,21 @@ type (
 		// InfrastructureMachineKind is the kind of the infrastructure resources behind MachinePool Machines.
 		// +optional
 		InfrastructureMachineKind string `json:"infrastructureMachineKind,omitempty"`
+
+		// CapacityReservation reports the utilization of the capacity reserved for the scale set's VM size in its
+		// capacity reservation group.
+		// +optional
+		CapacityReservation *CapacityReservationStatus `json:"capacityReservation,omitempty"`
+	}
+
+	// CapacityReservationStatus reports the utilization of the capacity reserved for a VM size in a capacity reservation group.
+	CapacityReservationStatus struct {
+		// ReservedCapacity is the number of instances of the VM size reserved in the capacity reservation group.
+		ReservedCapacity int32 `json:"reservedCapacity"`
+
+		// AllocatedCapacity is the number of VMs allocated against the reserved capacity, including VMs that don't
+		// belong to the scale set.
+		AllocatedCapacity int32 `json:"allocatedCapacity"`
 	}
 
 	// AzureMachinePoolInstanceStatus provides status information for each instance in the VMSS.
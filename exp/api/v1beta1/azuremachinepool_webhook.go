@@ -32,6 +32,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/feature"
 	azureutil "sigs.k8s.io/cluster-api-provider-azure/util/azure"
+	webhookutils "sigs.k8s.io/cluster-api-provider-azure/util/webhook"
 	capifeature "sigs.k8s.io/cluster-api/feature"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -105,6 +106,11 @@ func (amp *AzureMachinePool) Validate(old runtime.Object, client client.Client)
 		amp.ValidateSSHKey,
 		amp.ValidateUserAssignedIdentity,
 		amp.ValidateDiagnostics,
+		amp.ValidateDataDisks,
+		amp.ValidateBootstrapTransport,
+		amp.ValidateBootstrapCheck,
+		amp.ValidateCapacityReservationGroupID(old),
+		amp.ValidatePlacement(old),
 		amp.ValidateOrchestrationMode(client),
 		amp.ValidateStrategy(),
 		amp.ValidateSystemAssignedIdentity(old),
@@ -294,6 +300,84 @@ func (amp *AzureMachinePool) ValidateDiagnostics() error {
 	return nil
 }
 
+// ValidateDataDisks validates the data disks of an AzureMachinePool.
+func (amp *AzureMachinePool) ValidateDataDisks() error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("template", "dataDisks")
+
+	for i, disk := range amp.Spec.Template.DataDisks {
+		if disk.ManagedDiskID != nil {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Index(i).Child("managedDiskID"),
+				"attaching existing disks is not supported for scale sets"))
+		}
+		if disk.MaxShares != nil {
+			allErrs = append(allErrs, field.Forbidden(fieldPath.Index(i).Child("maxShares"),
+				"attaching existing disks is not supported for scale sets"))
+		}
+		if disk.DeletionPolicy != "" && disk.DeletionPolicy != infrav1.DiskDeletionPolicyDelete {
+			allErrs = append(allErrs, field.NotSupported(fieldPath.Index(i).Child("deletionPolicy"),
+				disk.DeletionPolicy, []string{string(infrav1.DiskDeletionPolicyDelete)}))
+		}
+	}
+
+	if len(allErrs) > 0 {
+		return kerrors.NewAggregate(allErrs.ToAggregate().Errors())
+	}
+
+	return nil
+}
+
+// ValidateBootstrapCheck validates the bootstrap check of an AzureMachinePool.
+func (amp *AzureMachinePool) ValidateBootstrapCheck() error {
+	if errs := infrav1.ValidateBootstrapCheck(amp.Spec.Template.BootstrapCheck, field.NewPath("template", "bootstrapCheck")); len(errs) > 0 {
+		return kerrors.NewAggregate(errs.ToAggregate().Errors())
+	}
+
+	return nil
+}
+
+// ValidateCapacityReservationGroupID validates the capacity reservation group ID of an AzureMachinePool, which may not
+// be changed once set.
+func (amp *AzureMachinePool) ValidateCapacityReservationGroupID(old runtime.Object) func() error {
+	return func() error {
+		fldPath := field.NewPath("template", "capacityReservationGroupID")
+		allErrs := infrav1.ValidateCapacityReservationGroupID(amp.Spec.Template.CapacityReservationGroupID, fldPath)
+
+		if old != nil {
+			oldMachinePool, ok := old.(*AzureMachinePool)
+			if !ok {
+				return fmt.Errorf("unexpected type for old azure machine pool object. Expected: %q, Got: %q",
+					"AzureMachinePool", reflect.TypeOf(old))
+			}
+			if err := webhookutils.ValidateImmutable(fldPath, oldMachinePool.Spec.Template.CapacityReservationGroupID, amp.Spec.Template.CapacityReservationGroupID); err != nil {
+				allErrs = append(allErrs, err)
+			}
+		}
+
+		if len(allErrs) > 0 {
+			return kerrors.NewAggregate(allErrs.ToAggregate().Errors())
+		}
+
+		return nil
+	}
+}
+
+// ValidateBootstrapTransport validates the bootstrap transport of an AzureMachinePool.
+func (amp *AzureMachinePool) ValidateBootstrapTransport() error {
+	transport := amp.Spec.Template.BootstrapTransport
+	if transport == nil {
+		return nil
+	}
+
+	fieldPath := field.NewPath("template", "bootstrapTransport")
+	allErrs := infrav1.ValidateBootstrapTransport(transport, amp.Spec.Template.OSDisk.OSType, amp.Spec.Identity, fieldPath)
+	if len(allErrs) > 0 {
+		return kerrors.NewAggregate(allErrs.ToAggregate().Errors())
+	}
+
+	return nil
+}
+
 // ValidateOrchestrationMode validates requirements for the VMSS orchestration mode.
 func (amp *AzureMachinePool) ValidateOrchestrationMode(c client.Client) func() error {
 	return func() error {
@@ -319,3 +403,33 @@ func (amp *AzureMachinePool) ValidateOrchestrationMode(c client.Client) func() e
 		return nil
 	}
 }
+
+// ValidatePlacement validates the proximity placement group and dedicated host group references of an AzureMachinePool,
+// which may not be changed once set.
+func (amp *AzureMachinePool) ValidatePlacement(old runtime.Object) func() error {
+	return func() error {
+		fldPath := field.NewPath("template")
+		template := amp.Spec.Template
+		allErrs := infrav1.ValidateVMPlacement(template.ProximityPlacementGroupID, nil, template.HostGroupID, nil, template.SpotVMOptions, fldPath)
+
+		if old != nil {
+			oldMachinePool, ok := old.(*AzureMachinePool)
+			if !ok {
+				return fmt.Errorf("unexpected type for old azure machine pool object. Expected: %q, Got: %q",
+					"AzureMachinePool", reflect.TypeOf(old))
+			}
+			if err := webhookutils.ValidateImmutable(fldPath.Child("proximityPlacementGroupID"), oldMachinePool.Spec.Template.ProximityPlacementGroupID, template.ProximityPlacementGroupID); err != nil {
+				allErrs = append(allErrs, err)
+			}
+			if err := webhookutils.ValidateImmutable(fldPath.Child("hostGroupID"), oldMachinePool.Spec.Template.HostGroupID, template.HostGroupID); err != nil {
+				allErrs = append(allErrs, err)
+			}
+		}
+
+		if len(allErrs) > 0 {
+			return kerrors.NewAggregate(allErrs.ToAggregate().Errors())
+		}
+
+		return nil
+	}
+}
@@ -214,6 +214,111 @@ func TestAzureMachinePool_ValidateCreate(t *testing.T) {
 			amp:     createMachinePoolWithNetworkConfig("", []infrav1.NetworkInterface{{SubnetName: "testSubnet"}}),
 			wantErr: false,
 		},
+		{
+			name: "azuremachinepool with data disks",
+			amp: createMachinePoolWithDataDisks([]infrav1.DataDisk{
+				{NameSuffix: "my_disk", DiskSizeGB: 64, Lun: ptr.To[int32](0)},
+			}),
+			wantErr: false,
+		},
+		{
+			name: "azuremachinepool with an existing managed data disk",
+			amp: createMachinePoolWithDataDisks([]infrav1.DataDisk{
+				{
+					NameSuffix:    "my_disk",
+					ManagedDiskID: ptr.To("/subscriptions/123/resourceGroups/my-rg/providers/Microsoft.Compute/disks/my-disk"),
+					Lun:           ptr.To[int32](0),
+				},
+			}),
+			wantErr: true,
+		},
+		{
+			name: "azuremachinepool with a data disk deletion policy",
+			amp: createMachinePoolWithDataDisks([]infrav1.DataDisk{
+				{NameSuffix: "my_disk", DiskSizeGB: 64, Lun: ptr.To[int32](0), DeletionPolicy: infrav1.DiskDeletionPolicyRetain},
+			}),
+			wantErr: true,
+		},
+		{
+			name: "azuremachinepool with a shared data disk",
+			amp: createMachinePoolWithDataDisks([]infrav1.DataDisk{
+				{NameSuffix: "my_disk", DiskSizeGB: 64, MaxShares: ptr.To[int32](2), Lun: ptr.To[int32](0)},
+			}),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with user data bootstrap transport",
+			amp:     createMachinePoolWithBootstrapTransport(&infrav1.BootstrapTransport{Type: infrav1.BootstrapTransportUserData}),
+			wantErr: false,
+		},
+		{
+			name: "azuremachinepool with storage blob bootstrap transport and no identity",
+			amp: createMachinePoolWithBootstrapTransport(&infrav1.BootstrapTransport{
+				Type:        infrav1.BootstrapTransportStorageBlob,
+				StorageBlob: &infrav1.StorageBlobBootstrapTransport{StorageAccountName: "mystorageaccount"},
+			}),
+			wantErr: true,
+		},
+		{
+			name: "azuremachinepool with storage blob bootstrap transport and a user-assigned identity",
+			amp: func() *AzureMachinePool {
+				amp := createMachinePoolWithBootstrapTransport(&infrav1.BootstrapTransport{
+					Type:        infrav1.BootstrapTransportStorageBlob,
+					StorageBlob: &infrav1.StorageBlobBootstrapTransport{StorageAccountName: "mystorageaccount"},
+				})
+				amp.Spec.Identity = infrav1.VMIdentityUserAssigned
+				amp.Spec.UserAssignedIdentities = []infrav1.UserAssignedIdentity{
+					{ProviderID: "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity"},
+				}
+				return amp
+			}(),
+			wantErr: false,
+		},
+		{
+			name: "azuremachinepool with key vault secret bootstrap transport and a user-assigned identity",
+			amp: func() *AzureMachinePool {
+				amp := createMachinePoolWithBootstrapTransport(&infrav1.BootstrapTransport{
+					Type:           infrav1.BootstrapTransportKeyVaultSecret,
+					KeyVaultSecret: &infrav1.KeyVaultSecretBootstrapTransport{VaultName: "my-vault"},
+				})
+				amp.Spec.Identity = infrav1.VMIdentityUserAssigned
+				amp.Spec.UserAssignedIdentities = []infrav1.UserAssignedIdentity{
+					{ProviderID: "azure:///subscriptions/123/resourceGroups/my-rg/providers/Microsoft.ManagedIdentity/userAssignedIdentities/my-identity"},
+				}
+				return amp
+			}(),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with disabled bootstrap check",
+			amp:     createMachinePoolWithBootstrapCheck(&infrav1.BootstrapCheck{Disabled: true}),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with disabled bootstrap check and a command",
+			amp:     createMachinePoolWithBootstrapCheck(&infrav1.BootstrapCheck{Disabled: true, Command: "true"}),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with valid capacity reservation group ID",
+			amp:     createMachinePoolWithCapacityReservationGroupID(ptr.To(validCapacityReservationGroupID)),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with invalid capacity reservation group ID",
+			amp:     createMachinePoolWithCapacityReservationGroupID(ptr.To("invalid-id")),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with valid proximity placement group and host group IDs",
+			amp:     createMachinePoolWithPlacement(ptr.To(validProximityPlacementGroupID), ptr.To(validHostGroupID)),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with invalid host group ID",
+			amp:     createMachinePoolWithPlacement(nil, ptr.To(validProximityPlacementGroupID)),
+			wantErr: true,
+		},
 		{
 			name:    "azuremachinepool with Flexible orchestration mode",
 			amp:     createMachinePoolWithOrchestrationMode(armcompute.OrchestrationModeFlexible),
@@ -367,6 +472,30 @@ func TestAzureMachinePool_ValidateUpdate(t *testing.T) {
 			amp:     createMachinePoolWithNetworkConfig("subnet", []infrav1.NetworkInterface{{SubnetName: "testSubnet2"}}),
 			wantErr: true,
 		},
+		{
+			name:    "azuremachinepool with unchanged capacity reservation group ID",
+			oldAMP:  createMachinePoolWithCapacityReservationGroupID(ptr.To(validCapacityReservationGroupID)),
+			amp:     createMachinePoolWithCapacityReservationGroupID(ptr.To(validCapacityReservationGroupID)),
+			wantErr: false,
+		},
+		{
+			name:    "azuremachinepool with added capacity reservation group ID",
+			oldAMP:  createMachinePoolWithCapacityReservationGroupID(nil),
+			amp:     createMachinePoolWithCapacityReservationGroupID(ptr.To(validCapacityReservationGroupID)),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with changed proximity placement group ID",
+			oldAMP:  createMachinePoolWithPlacement(ptr.To(validProximityPlacementGroupID), nil),
+			amp:     createMachinePoolWithPlacement(ptr.To(validProximityPlacementGroupID+"-2"), nil),
+			wantErr: true,
+		},
+		{
+			name:    "azuremachinepool with removed host group ID",
+			oldAMP:  createMachinePoolWithPlacement(nil, ptr.To(validHostGroupID)),
+			amp:     createMachinePoolWithPlacement(nil, nil),
+			wantErr: true,
+		},
 		{
 			name:    "azuremachinepool with valid network interface config",
 			oldAMP:  createMachinePoolWithNetworkConfig("subnet", []infrav1.NetworkInterface{}),
@@ -625,6 +754,64 @@ func createMachinePoolWithOrchestrationMode(mode armcompute.OrchestrationMode) *
 	}
 }
 
+func createMachinePoolWithDataDisks(dataDisks []infrav1.DataDisk) *AzureMachinePool {
+	return &AzureMachinePool{
+		Spec: AzureMachinePoolSpec{
+			Template: AzureMachinePoolMachineTemplate{
+				DataDisks: dataDisks,
+			},
+		},
+	}
+}
+
+func createMachinePoolWithBootstrapTransport(transport *infrav1.BootstrapTransport) *AzureMachinePool {
+	return &AzureMachinePool{
+		Spec: AzureMachinePoolSpec{
+			Template: AzureMachinePoolMachineTemplate{
+				BootstrapTransport: transport,
+			},
+		},
+	}
+}
+
+func createMachinePoolWithBootstrapCheck(check *infrav1.BootstrapCheck) *AzureMachinePool {
+	return &AzureMachinePool{
+		Spec: AzureMachinePoolSpec{
+			Template: AzureMachinePoolMachineTemplate{
+				BootstrapCheck: check,
+			},
+		},
+	}
+}
+
+const validCapacityReservationGroupID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.Compute/capacityReservationGroups/my-crg"
+
+func createMachinePoolWithCapacityReservationGroupID(id *string) *AzureMachinePool {
+	return &AzureMachinePool{
+		Spec: AzureMachinePoolSpec{
+			Template: AzureMachinePoolMachineTemplate{
+				CapacityReservationGroupID: id,
+			},
+		},
+	}
+}
+
+const (
+	validProximityPlacementGroupID = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.Compute/proximityPlacementGroups/my-ppg"
+	validHostGroupID               = "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/my-rg/providers/Microsoft.Compute/hostGroups/my-hg"
+)
+
+func createMachinePoolWithPlacement(proximityPlacementGroupID, hostGroupID *string) *AzureMachinePool {
+	return &AzureMachinePool{
+		Spec: AzureMachinePoolSpec{
+			Template: AzureMachinePoolMachineTemplate{
+				ProximityPlacementGroupID: proximityPlacementGroupID,
+				HostGroupID:               hostGroupID,
+			},
+		},
+	}
+}
+
 func TestAzureMachinePool_ValidateCreateFailure(t *testing.T) {
 	g := NewWithT(t)
 
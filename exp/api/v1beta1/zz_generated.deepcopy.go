@@ -304,6 +304,31 @@ func (in *AzureMachinePoolMachineTemplate) DeepCopyInto(out *AzureMachinePoolMac
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.BootstrapTransport != nil {
+		in, out := &in.BootstrapTransport, &out.BootstrapTransport
+		*out = new(apiv1beta1.BootstrapTransport)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BootstrapCheck != nil {
+		in, out := &in.BootstrapCheck, &out.BootstrapCheck
+		*out = new(apiv1beta1.BootstrapCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.CapacityReservationGroupID != nil {
+		in, out := &in.CapacityReservationGroupID, &out.CapacityReservationGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProximityPlacementGroupID != nil {
+		in, out := &in.ProximityPlacementGroupID, &out.ProximityPlacementGroupID
+		*out = new(string)
+		**out = **in
+	}
+	if in.HostGroupID != nil {
+		in, out := &in.HostGroupID, &out.HostGroupID
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMachinePoolMachineTemplate.
@@ -411,6 +436,11 @@ func (in *AzureMachinePoolStatus) DeepCopyInto(out *AzureMachinePoolStatus) {
 		*out = make(apiv1beta1.Futures, len(*in))
 		copy(*out, *in)
 	}
+	if in.CapacityReservation != nil {
+		in, out := &in.CapacityReservation, &out.CapacityReservation
+		*out = new(CapacityReservationStatus)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AzureMachinePoolStatus.
@@ -423,6 +453,21 @@ func (in *AzureMachinePoolStatus) DeepCopy() *AzureMachinePoolStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CapacityReservationStatus) DeepCopyInto(out *CapacityReservationStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CapacityReservationStatus.
+func (in *CapacityReservationStatus) DeepCopy() *CapacityReservationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CapacityReservationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineRollingUpdateDeployment) DeepCopyInto(out *MachineRollingUpdateDeployment) {
 	*out = *in
@@ -29,6 +29,7 @@ import (
 	infrav1 "sigs.k8s.io/cluster-api-provider-azure/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/capacityreservations"
 	infracontroller "sigs.k8s.io/cluster-api-provider-azure/controllers"
 	infrav1exp "sigs.k8s.io/cluster-api-provider-azure/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api-provider-azure/pkg/coalescing"
@@ -40,6 +41,7 @@ import (
 	expv1 "sigs.k8s.io/cluster-api/exp/api/v1beta1"
 	"sigs.k8s.io/cluster-api/util"
 	"sigs.k8s.io/cluster-api/util/annotations"
+	"sigs.k8s.io/cluster-api/util/conditions"
 	"sigs.k8s.io/cluster-api/util/predicates"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
@@ -308,12 +310,39 @@ func (ampr *AzureMachinePoolReconciler) reconcileNormal(ctx context.Context, mac
 		return reconcile.Result{}, errors.Wrap(err, "failed to init machinepool scope cache")
 	}
 
+	// Reject VM sizes that don't support the requested features before the scale set is created, or before its
+	// model is updated to a new VM size.
+	if machinePoolScope.NeedsVMSizeValidation() {
+		err := machinePoolScope.ValidateVMSize()
+		switch {
+		case err == nil:
+			machinePoolScope.SetVMSizeValidated()
+		case errors.As(err, &reconcileError) && reconcileError.IsTerminal():
+			ampr.Recorder.Eventf(machinePoolScope.AzureMachinePool, corev1.EventTypeWarning, infrav1.VMSizeIncompatibleReason, err.Error())
+			log.Error(err, "VM size is not compatible with the AzureMachinePool")
+			conditions.MarkFalse(machinePoolScope.AzureMachinePool, infrav1.VMSizeCompatibleCondition, infrav1.VMSizeIncompatibleReason, clusterv1.ConditionSeverityError, err.Error())
+			if machinePoolScope.ProviderID() == "" {
+				machinePoolScope.SetFailureReason(capierrors.InvalidConfigurationMachineError)
+				machinePoolScope.SetFailureMessage(err)
+				machinePoolScope.SetNotReady()
+				return reconcile.Result{}, nil
+			}
+			// An existing scale set keeps reconciling with its current model until the VM size is compatible again.
+		default:
+			return reconcile.Result{}, errors.Wrap(err, "failed to validate the VM size")
+		}
+	}
+
 	ams, err := ampr.createAzureMachinePoolService(machinePoolScope)
 	if err != nil {
 		return reconcile.Result{}, errors.Wrap(err, "failed creating a newAzureMachinePoolService")
 	}
 
 	if err := ams.Reconcile(ctx); err != nil {
+		if machinePoolScope.CapacityReservationGroupID() != "" && capacityreservations.IsAllocationFailure(err) {
+			machinePoolScope.SetCapacityReservationAllocationFailed(err)
+		}
+
 		// Handle transient and terminal errors
 		var reconcileError azure.ReconcileError
 		if errors.As(err, &reconcileError) {
@@ -22,6 +22,8 @@ import (
 	"github.com/pkg/errors"
 	"sigs.k8s.io/cluster-api-provider-azure/azure"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/scope"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/bootstrapdata"
+	"sigs.k8s.io/cluster-api-provider-azure/azure/services/capacityreservations"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/resourceskus"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/roleassignments"
 	"sigs.k8s.io/cluster-api-provider-azure/azure/services/scalesets"
@@ -49,10 +51,17 @@ func newAzureMachinePoolService(machinePoolScope *scope.MachinePoolScope) (*azur
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create a scalesets service")
 	}
+	capacityReservationsSvc, err := capacityreservations.New(machinePoolScope)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create a capacityreservations service")
+	}
+	bootstrapDataSvc := bootstrapdata.New(machinePoolScope)
 
 	return &azureMachinePoolService{
 		scope: machinePoolScope,
 		services: []azure.ServiceReconciler{
+			bootstrapDataSvc,
+			capacityReservationsSvc,
 			scaleSetsSvc,
 			roleAssignmentsSvc,
 		},
@@ -72,6 +81,10 @@ func (s *azureMachinePoolService) Reconcile(ctx context.Context) error {
 		return errors.Wrap(err, "failed defaulting subnet name")
 	}
 
+	if err := s.scope.ValidateSubnetRoles(); err != nil {
+		return err
+	}
+
 	for _, service := range s.services {
 		if err := service.Reconcile(ctx); err != nil {
 			return errors.Wrapf(err, "failed to reconcile AzureMachinePool service %s", service.Name())